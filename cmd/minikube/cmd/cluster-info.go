@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/cni"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+)
+
+var clusterInfoOutput string
+
+// ClusterInfo summarizes a cluster
+type ClusterInfo struct {
+	Name              string
+	APIServer         string
+	Dashboard         string
+	KubernetesVersion string
+	CNI               string
+	Nodes             int
+	ControlPlanes     int
+	Workers           int
+}
+
+const clusterInfoFormat = `Cluster:            {{.Name}}
+API Server:         {{if .APIServer}}{{.APIServer}}{{else}}not running{{end}}
+Dashboard:          {{if .Dashboard}}{{.Dashboard}}{{else}}not available{{end}}
+Kubernetes Version: {{.KubernetesVersion}}
+Nodes:              {{.Nodes}} ({{.ControlPlanes}} control plane, {{.Workers}} worker)
+CNI:                {{.CNI}}
+`
+
+var clusterInfoCmd = &cobra.Command{
+	Use:   "cluster-info",
+	Short: "Display a summary of a cluster",
+	Long:  "Displays the apiserver and dashboard URLs, Kubernetes version, nodes and CNI of a cluster.",
+	Run: func(cmd *cobra.Command, args []string) {
+		output := strings.ToLower(clusterInfoOutput)
+		if output != "text" && output != "json" {
+			exit.WithCodeT(exit.BadUsage, fmt.Sprintf("invalid output format: %s. Valid values: 'text', 'json'", clusterInfoOutput))
+		}
+
+		api, cc := mustload.Partial(ClusterFlagValue())
+		defer api.Close()
+
+		apiserver := ""
+		if _, hostname, port, err := healthyControlPlane(api, *cc, ""); err != nil {
+			glog.Infof("no running apiserver: %v", err)
+		} else {
+			apiserver = "https://" + net.JoinHostPort(hostname, strconv.Itoa(port))
+		}
+
+		info := clusterInfo(*cc, apiserver)
+		var err error
+		if output == "json" {
+			err = clusterInfoJSON(info, os.Stdout)
+		} else {
+			err = clusterInfoText(info, os.Stdout)
+		}
+		if err != nil {
+			exit.WithError("cluster-info failure", err)
+		}
+	},
+}
+
+// clusterInfo summarizes a cluster, apiserver is the URL of a running apiserver, if any
+func clusterInfo(cc config.ClusterConfig, apiserver string) ClusterInfo {
+	info := ClusterInfo{
+		Name:              cc.Name,
+		APIServer:         apiserver,
+		KubernetesVersion: cc.KubernetesConfig.KubernetesVersion,
+		CNI:               cniName(cc),
+		Nodes:             len(cc.Nodes),
+	}
+	for _, n := range cc.Nodes {
+		if n.ControlPlane {
+			info.ControlPlanes++
+		} else {
+			info.Workers++
+		}
+	}
+
+	// The dashboard is reachable through the apiserver proxy, with the credentials of the kubeconfig
+	if apiserver != "" && assets.Addons["dashboard"].IsEnabled(&cc) {
+		info.Dashboard = apiserver + "/api/v1/namespaces/kubernetes-dashboard/services/http:kubernetes-dashboard:/proxy/"
+	}
+	return info
+}
+
+// cniName returns the name of the CNI used by a cluster
+func cniName(cc config.ClusterConfig) string {
+	cnm, err := cni.New(cc)
+	if err != nil {
+		glog.Warningf("unable to create CNI manager: %v", err)
+		return cc.KubernetesConfig.CNI
+	}
+	if _, ok := cnm.(cni.KindNet); ok {
+		return "kindnet"
+	}
+	return cnm.String()
+}
+
+func clusterInfoText(info ClusterInfo, w io.Writer) error {
+	tmpl, err := template.New("cluster-info").Parse(clusterInfoFormat)
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(w, info)
+}
+
+func clusterInfoJSON(info ClusterInfo, w io.Writer) error {
+	js, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(js)
+	return err
+}
+
+func init() {
+	clusterInfoCmd.Flags().StringVarP(&clusterInfoOutput, "output", "o", "text", "Output format. One of: text, json.")
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestClusterInfoOutput(t *testing.T) {
+	var tests = []struct {
+		name     string
+		info     ClusterInfo
+		wantText string
+		wantJSON string
+	}{
+		{
+			name:     "running",
+			info:     ClusterInfo{Name: "p1", APIServer: "https://192.168.39.2:8443", Dashboard: "https://192.168.39.2:8443/api/v1/namespaces/kubernetes-dashboard/services/http:kubernetes-dashboard:/proxy/", KubernetesVersion: "v1.18.3", CNI: "kindnet", Nodes: 3, ControlPlanes: 1, Workers: 2},
+			wantText: "Cluster:            p1\nAPI Server:         https://192.168.39.2:8443\nDashboard:          https://192.168.39.2:8443/api/v1/namespaces/kubernetes-dashboard/services/http:kubernetes-dashboard:/proxy/\nKubernetes Version: v1.18.3\nNodes:              3 (1 control plane, 2 worker)\nCNI:                kindnet\n",
+			wantJSON: `{"Name":"p1","APIServer":"https://192.168.39.2:8443","Dashboard":"https://192.168.39.2:8443/api/v1/namespaces/kubernetes-dashboard/services/http:kubernetes-dashboard:/proxy/","KubernetesVersion":"v1.18.3","CNI":"kindnet","Nodes":3,"ControlPlanes":1,"Workers":2}`,
+		},
+		{
+			name:     "stopped",
+			info:     ClusterInfo{Name: "p2", KubernetesVersion: "v1.18.3", CNI: "Disabled", Nodes: 1, ControlPlanes: 1},
+			wantText: "Cluster:            p2\nAPI Server:         not running\nDashboard:          not available\nKubernetes Version: v1.18.3\nNodes:              1 (1 control plane, 0 worker)\nCNI:                Disabled\n",
+			wantJSON: `{"Name":"p2","APIServer":"","Dashboard":"","KubernetesVersion":"v1.18.3","CNI":"Disabled","Nodes":1,"ControlPlanes":1,"Workers":0}`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := clusterInfoText(tc.info, &b); err != nil {
+				t.Errorf("text(%+v) error: %v", tc.info, err)
+			}
+			if got := b.String(); got != tc.wantText {
+				t.Errorf("text(%+v) = %q, want: %q", tc.info, got, tc.wantText)
+			}
+
+			b.Reset()
+			if err := clusterInfoJSON(tc.info, &b); err != nil {
+				t.Errorf("json(%+v) error: %v", tc.info, err)
+			}
+			if got := b.String(); got != tc.wantJSON {
+				t.Errorf("json(%+v) = %q, want: %q", tc.info, got, tc.wantJSON)
+			}
+		})
+	}
+}
@@ -19,10 +19,18 @@ package config
 import (
 	"github.com/spf13/cobra"
 	"k8s.io/minikube/pkg/addons"
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
 	"k8s.io/minikube/pkg/minikube/out"
 )
 
+// addonNode is the node to pin an addon to
+var addonNode string
+
 var addonsEnableCmd = &cobra.Command{
 	Use:   "enable ADDON_NAME",
 	Short: "Enables the addon w/ADDON_NAME within minikube (example: minikube addons enable dashboard). For a list of available addons use: minikube addons list ",
@@ -37,6 +45,9 @@ var addonsEnableCmd = &cobra.Command{
 			out.T(out.Waiting, "enable metrics-server addon instead of heapster addon because heapster is deprecated")
 			addon = "metrics-server"
 		}
+		if cmd.Flags().Changed("node") {
+			pinAddon(addon, addonNode)
+		}
 		err := addons.SetAndSave(ClusterFlagValue(), addon, "true")
 		if err != nil {
 			exit.WithError("enable failed", err)
@@ -45,6 +56,56 @@ var addonsEnableCmd = &cobra.Command{
 	},
 }
 
+// pinAddon records the node an addon should run on, removing the addon first if it is already running elsewhere
+func pinAddon(addon string, name string) {
+	if addon != "storage-provisioner" {
+		exit.UsageT("The --node flag is only supported by the storage-provisioner addon")
+	}
+
+	profile := ClusterFlagValue()
+	_, cc := mustload.Partial(profile)
+
+	// the pod spec is pinned through nodeName, which holds the Kubernetes node name rather than the machine name
+	pinned := ""
+	switch name {
+	case "":
+	case "control-plane":
+		cp, err := config.PrimaryControlPlane(cc)
+		if err != nil {
+			exit.WithError("Error getting primary control plane", err)
+		}
+		pinned = bsutil.KubeNodeName(*cc, cp)
+	default:
+		// node.Retrieve can not be used here, as the node package depends on this one
+		for _, n := range cc.Nodes {
+			if n.Name == name || driver.MachineName(*cc, n) == name || bsutil.KubeNodeName(*cc, n) == name {
+				pinned = bsutil.KubeNodeName(*cc, n)
+			}
+		}
+		if pinned == "" {
+			exit.WithCodeT(exit.Unavailable, "Node {{.nodeName}} does not exist.", out.V{"nodeName": name})
+		}
+	}
+
+	if pinned == cc.KubernetesConfig.ProvisionerNode {
+		return
+	}
+
+	// the node of a running pod can not be changed, so it has to be deleted first
+	if assets.Addons[addon].IsEnabled(cc) {
+		if err := addons.SetAndSave(profile, addon, "false"); err != nil {
+			exit.WithError("disable failed", err)
+		}
+		_, cc = mustload.Partial(profile)
+	}
+
+	cc.KubernetesConfig.ProvisionerNode = pinned
+	if err := config.Write(profile, cc); err != nil {
+		exit.WithError("failed to save config", err)
+	}
+}
+
 func init() {
+	addonsEnableCmd.Flags().StringVar(&addonNode, "node", "", "The node to run the addon on: 'control-plane' or a node name, empty to let Kubernetes decide. Only supported by the storage-provisioner addon.")
 	AddonsCmd.AddCommand(addonsEnableCmd)
 }
@@ -27,6 +27,9 @@ import (
 	"k8s.io/minikube/pkg/minikube/out"
 )
 
+// switchContext switches the kubectl current-context along with the profile
+var switchContext bool
+
 // ProfileCmd represents the profile command
 var ProfileCmd = &cobra.Command{
 	Use:   "profile [MINIKUBE_PROFILE_NAME].  You can return to the default minikube profile by running `minikube profile default`",
@@ -36,6 +39,7 @@ var ProfileCmd = &cobra.Command{
 		if len(args) == 0 {
 			profile := ClusterFlagValue()
 			out.T(out.Empty, profile)
+			showContext(profile)
 			os.Exit(0)
 		}
 
@@ -82,16 +86,56 @@ var ProfileCmd = &cobra.Command{
 			out.ErrT(out.Sad, `Error loading profile config: {{.error}}`, out.V{"error": err})
 		}
 		if err == nil {
-			if cc.KeepContext {
+			// an explicit --switch-context overrides the --keep-context the cluster was started with
+			switch {
+			case !switchContext:
+				out.SuccessT("Skipped switching kubectl context for {{.profile_name}} because --switch-context=false was set.", out.V{"profile_name": profile})
+				out.SuccessT("To connect to this cluster, use: kubectl --context={{.profile_name}}", out.V{"profile_name": profile})
+			case cc.KeepContext && !cmd.Flags().Changed("switch-context"):
 				out.SuccessT("Skipped switching kubectl context for {{.profile_name}} because --keep-context was set.", out.V{"profile_name": profile})
 				out.SuccessT("To connect to this cluster, use: kubectl --context={{.profile_name}}", out.V{"profile_name": profile})
-			} else {
-				err := kubeconfig.SetCurrentContext(profile, kubeconfig.PathFromEnv())
-				if err != nil {
-					out.ErrT(out.Sad, `Error while setting kubectl current context :  {{.error}}`, out.V{"error": err})
-				}
+			default:
+				switchKubeContext(profile)
 			}
 			out.SuccessT("minikube profile was successfully set to {{.profile_name}}", out.V{"profile_name": profile})
 		}
 	},
 }
+
+// switchKubeContext points the kubectl current-context at the profile
+func switchKubeContext(profile string) {
+	exists, err := kubeconfig.ContextExists(profile, kubeconfig.PathFromEnv())
+	if err != nil {
+		out.ErrT(out.Sad, `Error while setting kubectl current context :  {{.error}}`, out.V{"error": err})
+		return
+	}
+	// kubectl failing on a missing context is better than it silently talking to the previous cluster
+	if !exists {
+		out.WarningT("kubeconfig has no context for {{.profile_name}}, to recreate it run: {{.cmd}}", out.V{"profile_name": profile, "cmd": mustload.ExampleCmd(profile, "update-context")})
+	}
+	if err := kubeconfig.SetCurrentContext(profile, kubeconfig.PathFromEnv()); err != nil {
+		out.ErrT(out.Sad, `Error while setting kubectl current context :  {{.error}}`, out.V{"error": err})
+		return
+	}
+	out.SuccessT("kubectl is now configured to use {{.profile_name}}", out.V{"profile_name": profile})
+}
+
+// showContext reports the kubectl current-context on stderr, so that the profile name on stdout stays scriptable
+func showContext(profile string) {
+	current, err := kubeconfig.CurrentContext(kubeconfig.PathFromEnv())
+	if err != nil {
+		out.ErrT(out.Sad, `Error getting kubectl current context: {{.error}}`, out.V{"error": err})
+		return
+	}
+	if current == "" {
+		current = "<none>"
+	}
+	out.ErrT(out.Empty, "kubectl context: {{.context}}", out.V{"context": current})
+	if current != profile {
+		out.WarningT("kubectl is not pointing at {{.profile_name}}, to switch run: {{.cmd}}", out.V{"profile_name": profile, "cmd": "minikube profile " + profile})
+	}
+}
+
+func init() {
+	ProfileCmd.Flags().BoolVar(&switchContext, "switch-context", true, "If true, also switch the kubectl current-context to the profile. Overrides the --keep-context the cluster was started with when set explicitly.")
+}
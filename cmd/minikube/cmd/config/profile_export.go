@@ -0,0 +1,176 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+// redacted replaces the sensitive values of a sanitized export
+const redacted = "REDACTED"
+
+var (
+	exportOutput         string
+	exportIncludeSecrets bool
+)
+
+// sensitiveWords are the words which mark a setting as sensitive wherever they appear in its name, such as NPM_TOKEN or oidc-client-secret
+var sensitiveWords = []string{"password", "passwd", "secret", "token", "key", "apikey", "credential", "credentials"}
+
+// sensitiveKeys are the names of further settings whose values are redacted, such as certificate files,
+// matched exactly ignoring case and leading dashes
+var sensitiveKeys = []string{
+	// kubeadm and kubernetes component options
+	"token", "certificate-key", "token-auth-file", "basic-auth-file", "encryption-provider-config",
+	"tls-cert-file", "tls-private-key-file", "client-ca-file", "requestheader-client-ca-file",
+	"proxy-client-cert-file", "proxy-client-key-file", "kubelet-client-certificate", "kubelet-client-key",
+	"service-account-key-file", "service-account-private-key-file", "service-account-signing-key-file",
+	"cluster-signing-cert-file", "cluster-signing-key-file", "etcd-cafile", "etcd-certfile", "etcd-keyfile",
+	// docker daemon options
+	"tlscacert", "tlscert", "tlskey",
+	// environment variables
+	"docker_password", "registry_password", "registry_token", "github_token", "gitlab_token",
+	"aws_secret_access_key", "aws_session_token", "google_application_credentials",
+}
+
+var profileExportCmd = &cobra.Command{
+	Use:   "export [MINIKUBE_PROFILE_NAME]",
+	Short: "Print the stored configuration of a profile, to share the definition of the cluster",
+	Long: `Print the stored configuration of a profile and of each of its nodes, to share the definition of the cluster.
+With --include-secrets=false, the values of settings which may hold credentials, tokens or certificates are replaced by REDACTED, as are the passwords of URLs,
+and the machine identity of the nodes is cleared, so that the output is safe to commit. Recreate the cluster with 'minikube profile import'.`,
+	Example: `minikube profile export -p minikube --include-secrets=false > cluster.yaml`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) > 1 {
+			exit.UsageT("usage: minikube profile export [MINIKUBE_PROFILE_NAME]")
+		}
+		name := ClusterFlagValue()
+		if len(args) == 1 {
+			name = args[0]
+		}
+
+		cc := *loadProfile(name)
+		if !exportIncludeSecrets {
+			cc = sanitizeProfile(cc)
+		}
+		if err := writeProfileView(newProfileView(cc), exportOutput, os.Stdout); err != nil {
+			exit.WithCodeT(exit.BadUsage, "profile export failed: {{.error}}", out.V{"error": err})
+		}
+	},
+}
+
+func init() {
+	profileExportCmd.Flags().StringVarP(&exportOutput, "output", "o", "yaml", "The output format. One of 'yaml' or 'json'.")
+	profileExportCmd.Flags().BoolVar(&exportIncludeSecrets, "include-secrets", true, "If false, redact the settings which may hold credentials, tokens or certificates, and clear the machine identity of the nodes.")
+	ProfileCmd.AddCommand(profileExportCmd)
+}
+
+// sanitizeProfile returns a copy of the cluster config without credentials, tokens, certificates or machine identity
+func sanitizeProfile(cc config.ClusterConfig) config.ClusterConfig {
+	cc.DockerEnv = redactKeyValues(cc.DockerEnv)
+	cc.DockerOpt = redactKeyValues(cc.DockerOpt)
+	cc.RegistryMirror = redactURLs(cc.RegistryMirror)
+	cc.InsecureRegistry = redactURLs(cc.InsecureRegistry)
+	cc.KubernetesConfig.BinaryMirror = redactURL(cc.KubernetesConfig.BinaryMirror)
+	// the machine identity is regenerated when the cluster is recreated
+	cc.UUID = ""
+
+	var opts config.ExtraOptionSlice
+	for _, o := range cc.KubernetesConfig.ExtraOptions {
+		if sensitive(o.Key) {
+			o.Value = redacted
+		}
+		opts = append(opts, o)
+	}
+	cc.KubernetesConfig.ExtraOptions = opts
+
+	var nodes []config.Node
+	for _, n := range cc.Nodes {
+		n.IP = ""
+		n.KubeletExtraArgs = redactKeyValues(n.KubeletExtraArgs)
+		n.RuntimeConfig = redactKeyValues(n.RuntimeConfig)
+		nodes = append(nodes, n)
+	}
+	cc.Nodes = nodes
+	return cc
+}
+
+// sensitive returns whether the value of the named setting may hold a secret
+func sensitive(key string) bool {
+	key = strings.ToLower(strings.TrimLeft(key, "-"))
+	for _, s := range sensitiveKeys {
+		if key == s {
+			return true
+		}
+	}
+	// the words of a name are separated by dashes, underscores or dots
+	words := strings.FieldsFunc(key, func(r rune) bool { return r == '-' || r == '_' || r == '.' })
+	for _, w := range words {
+		for _, s := range sensitiveWords {
+			if w == s {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// redactKeyValues redacts the values of KEY=VALUE entries with a sensitive key, and the passwords of URL values
+func redactKeyValues(kvs []string) []string {
+	var res []string
+	for _, kv := range kvs {
+		parts := strings.SplitN(kv, "=", 2)
+		switch {
+		case len(parts) != 2:
+			res = append(res, kv)
+		case sensitive(parts[0]):
+			res = append(res, parts[0]+"="+redacted)
+		default:
+			res = append(res, parts[0]+"="+redactURL(parts[1]))
+		}
+	}
+	return res
+}
+
+// redactURLs removes the passwords of the given URLs
+func redactURLs(us []string) []string {
+	var res []string
+	for _, u := range us {
+		res = append(res, redactURL(u))
+	}
+	return res
+}
+
+// redactURL removes the password of a URL, returning values which aren't URLs with credentials as is
+func redactURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil || u.User == nil {
+		return s
+	}
+	if _, ok := u.User.Password(); !ok {
+		return s
+	}
+	u.User = url.UserPassword(u.User.Username(), redacted)
+	return u.String()
+}
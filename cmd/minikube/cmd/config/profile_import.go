@@ -0,0 +1,197 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+var profileImportCmd = &cobra.Command{
+	Use:   "import FILE [MINIKUBE_PROFILE_NAME]",
+	Short: "Create a profile from the configuration printed by 'minikube profile export'",
+	Long: `Create a profile from the configuration printed by 'minikube profile export', to recreate a shared cluster with 'minikube start'.
+The settings redacted by --include-secrets=false are dropped, so that minikube generates its own certificates and tokens when the cluster starts,
+and the redacted passwords of URLs are removed along with their user. The profile is named as in the file unless a name is given.`,
+	Example: `minikube profile import cluster.yaml dev && minikube start -p dev`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 1 || len(args) > 2 {
+			exit.UsageT("usage: minikube profile import FILE [MINIKUBE_PROFILE_NAME]")
+		}
+
+		f, err := os.Open(args[0])
+		if err != nil {
+			exit.WithCodeT(exit.NoInput, "Unable to open {{.file}}: {{.error}}", out.V{"file": args[0], "error": err})
+		}
+		defer f.Close()
+
+		v, err := readProfileView(f)
+		if err != nil {
+			exit.WithCodeT(exit.Data, "Unable to read {{.file}}: {{.error}}", out.V{"file": args[0], "error": err})
+		}
+
+		cc := v.Cluster
+		cc.Nodes = v.Nodes
+		if len(args) == 2 {
+			cc.Name = args[1]
+		}
+		if !config.ProfileNameValid(cc.Name) || config.ProfileNameInReservedKeywords(cc.Name) {
+			exit.UsageT("Invalid profile name: {{.name}}", out.V{"name": cc.Name})
+		}
+		if config.ProfileExists(cc.Name) {
+			exit.WithCodeT(exit.Config, "Profile {{.name}} already exists, delete it or import under another name", out.V{"name": cc.Name})
+		}
+
+		cc, dropped := dropRedacted(cc)
+		if err := config.SaveProfile(cc.Name, &cc); err != nil {
+			exit.WithError("failed to save config", err)
+		}
+		if len(dropped) > 0 {
+			out.WarningT("Dropped the redacted settings {{.settings}}, set them again if the cluster needs them", out.V{"settings": strings.Join(dropped, ", ")})
+		}
+		out.T(out.Ready, "Imported profile {{.name}}, create its cluster with 'minikube start -p {{.name}}'", out.V{"name": cc.Name})
+	},
+}
+
+func init() {
+	ProfileCmd.AddCommand(profileImportCmd)
+}
+
+// readProfileView reads a profile view written by writeProfileView, in either yaml or json
+func readProfileView(r io.Reader) (ProfileView, error) {
+	var v ProfileView
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return v, errors.Wrap(err, "read")
+	}
+	// yaml is a superset of json, so both formats are decoded as yaml, then converted to json to keep the field names
+	var doc interface{}
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return v, errors.Wrap(err, "unmarshal")
+	}
+	j, err := json.Marshal(jsonValue(doc))
+	if err != nil {
+		return v, errors.Wrap(err, "converting to json")
+	}
+	if err := json.Unmarshal(j, &v); err != nil {
+		return v, errors.Wrap(err, "unmarshal")
+	}
+	return v, nil
+}
+
+// jsonValue converts the maps decoded from yaml, keyed by interface{}, to maps which can be encoded as json
+func jsonValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		m := map[string]interface{}{}
+		for k, e := range t {
+			m[fmt.Sprint(k)] = jsonValue(e)
+		}
+		return m
+	case []interface{}:
+		for i, e := range t {
+			t[i] = jsonValue(e)
+		}
+	}
+	return v
+}
+
+// dropRedacted returns a copy of the cluster config without the settings redacted by sanitizeProfile, and their names
+func dropRedacted(cc config.ClusterConfig) (config.ClusterConfig, []string) {
+	var dropped []string
+
+	var kvs []string
+	cc.DockerEnv, kvs = dropRedactedKeyValues(cc.DockerEnv)
+	dropped = append(dropped, kvs...)
+	cc.DockerOpt, kvs = dropRedactedKeyValues(cc.DockerOpt)
+	dropped = append(dropped, kvs...)
+	cc.RegistryMirror = dropRedactedPasswords(cc.RegistryMirror)
+	cc.InsecureRegistry = dropRedactedPasswords(cc.InsecureRegistry)
+	cc.KubernetesConfig.BinaryMirror = dropRedactedPassword(cc.KubernetesConfig.BinaryMirror)
+
+	var opts config.ExtraOptionSlice
+	for _, o := range cc.KubernetesConfig.ExtraOptions {
+		if o.Value == redacted {
+			dropped = append(dropped, o.Component+"."+o.Key)
+			continue
+		}
+		opts = append(opts, o)
+	}
+	cc.KubernetesConfig.ExtraOptions = opts
+
+	var nodes []config.Node
+	for _, n := range cc.Nodes {
+		n.KubeletExtraArgs, kvs = dropRedactedKeyValues(n.KubeletExtraArgs)
+		dropped = append(dropped, kvs...)
+		n.RuntimeConfig, kvs = dropRedactedKeyValues(n.RuntimeConfig)
+		dropped = append(dropped, kvs...)
+		nodes = append(nodes, n)
+	}
+	cc.Nodes = nodes
+	return cc, dropped
+}
+
+// dropRedactedKeyValues removes the KEY=REDACTED entries, returning their keys, and the redacted passwords of URL values
+func dropRedactedKeyValues(kvs []string) ([]string, []string) {
+	var res, dropped []string
+	for _, kv := range kvs {
+		parts := strings.SplitN(kv, "=", 2)
+		switch {
+		case len(parts) != 2:
+			res = append(res, kv)
+		case parts[1] == redacted:
+			dropped = append(dropped, parts[0])
+		default:
+			res = append(res, parts[0]+"="+dropRedactedPassword(parts[1]))
+		}
+	}
+	return res, dropped
+}
+
+// dropRedactedPasswords removes the redacted passwords of the given URLs
+func dropRedactedPasswords(us []string) []string {
+	var res []string
+	for _, u := range us {
+		res = append(res, dropRedactedPassword(u))
+	}
+	return res
+}
+
+// dropRedactedPassword removes the user of a URL whose password was redacted, returning other values as is
+func dropRedactedPassword(s string) string {
+	u, err := url.Parse(s)
+	if err != nil || u.User == nil {
+		return s
+	}
+	if p, ok := u.User.Password(); !ok || p != redacted {
+		return s
+	}
+	u.User = nil
+	return u.String()
+}
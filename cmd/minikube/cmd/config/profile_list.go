@@ -22,20 +22,32 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/out"
 
+	"github.com/docker/machine/libmachine"
+	"github.com/docker/machine/libmachine/state"
 	"github.com/golang/glog"
 	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 )
 
+// nodesReadyTimeout bounds how long listing a profile waits on an unresponsive apiserver
+const nodesReadyTimeout = 5 * time.Second
+
 var (
-	output string
+	output     string
+	showHealth bool
 )
 
 var profileListCmd = &cobra.Command{
@@ -60,7 +72,11 @@ var printProfilesTable = func() {
 
 	var validData [][]string
 	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Profile", "VM Driver", "Runtime", "IP", "Port", "Version", "Status"})
+	header := []string{"Profile", "VM Driver", "Runtime", "IP", "Port", "Version", "Status"}
+	if showHealth {
+		header = append(header, "Nodes Ready")
+	}
+	table.SetHeader(header)
 	table.SetAutoFormatHeaders(false)
 	table.SetBorders(tablewriter.Border{Left: true, Top: true, Right: true, Bottom: true})
 	table.SetCenterSeparator("|")
@@ -84,7 +100,12 @@ var printProfilesTable = func() {
 		if err != nil {
 			glog.Warningf("error getting host status for %s: %v", p.Name, err)
 		}
-		validData = append(validData, []string{p.Name, p.Config.Driver, p.Config.KubernetesConfig.ContainerRuntime, cp.IP, strconv.Itoa(cp.Port), p.Config.KubernetesConfig.KubernetesVersion, p.Status})
+		row := []string{p.Name, p.Config.Driver, p.Config.KubernetesConfig.ContainerRuntime, cp.IP, strconv.Itoa(cp.Port), p.Config.KubernetesConfig.KubernetesVersion, p.Status}
+		if showHealth {
+			p.NodesReady = profileNodesReady(*p.Config, p.Status)
+			row = append(row, p.NodesReady)
+		}
+		validData = append(validData, row)
 	}
 
 	table.AppendBulk(validData)
@@ -126,6 +147,10 @@ var printProfilesJSON = func() {
 			glog.Warningf("error getting host status for %s: %v", v.Name, err)
 		}
 		v.Status = status
+		v.Nodes = profileNodes(api, *v.Config)
+		if showHealth {
+			v.NodesReady = profileNodesReady(*v.Config, status)
+		}
 	}
 
 	var valid []*config.Profile
@@ -158,7 +183,73 @@ var printProfilesJSON = func() {
 	}
 }
 
+// profileNodes returns the role, IP and host status of every node of a profile
+func profileNodes(api libmachine.API, cc config.ClusterConfig) []config.NodeStatus {
+	nodes := []config.NodeStatus{}
+	for _, n := range cc.Nodes {
+		status, err := machine.Status(api, driver.MachineName(cc, n))
+		if err != nil {
+			glog.Warningf("error getting host status for %s: %v", driver.MachineName(cc, n), err)
+		}
+		nodes = append(nodes, config.NodeStatus{Name: n.Name, Role: nodeRole(n), IP: n.IP, Status: status})
+	}
+	return nodes
+}
+
+// profileNodesReady returns how many nodes of a running profile are Ready according to its apiserver, such as "2/3"
+func profileNodesReady(cc config.ClusterConfig, status string) string {
+	if status != state.Running.String() {
+		return ""
+	}
+	rc, err := kapi.ClientConfig(cc.Name)
+	if err != nil {
+		glog.Warningf("unable to get kubernetes client config for %s: %v", cc.Name, err)
+		return "Unknown"
+	}
+	rc.Timeout = nodesReadyTimeout
+	client, err := kubernetes.NewForConfig(rc)
+	if err != nil {
+		glog.Warningf("unable to get kubernetes client for %s: %v", cc.Name, err)
+		return "Unknown"
+	}
+	nodes, err := client.CoreV1().Nodes().List(meta.ListOptions{})
+	if err != nil {
+		glog.Warningf("unable to list nodes of %s: %v", cc.Name, err)
+		return "Unknown"
+	}
+	var names []string
+	for _, n := range cc.Nodes {
+		names = append(names, bsutil.KubeNodeName(cc, n))
+	}
+	return nodesReady(nodes.Items, names)
+}
+
+// nodesReady formats how many of the named nodes of a profile report the Ready condition, ignoring any other node of the cluster
+func nodesReady(nodes []core.Node, names []string) string {
+	ready := 0
+	for _, n := range nodes {
+		if !containsString(names, n.Name) {
+			continue
+		}
+		for _, c := range n.Status.Conditions {
+			if c.Type == core.NodeReady && c.Status == core.ConditionTrue {
+				ready++
+			}
+		}
+	}
+	return fmt.Sprintf("%d/%d", ready, len(names))
+}
+
+// nodeRole returns the role of a node, as passed to its post-join script in MINIKUBE_NODE_ROLE
+func nodeRole(n config.Node) string {
+	if n.ControlPlane {
+		return "control-plane"
+	}
+	return "worker"
+}
+
 func init() {
-	profileListCmd.Flags().StringVarP(&output, "output", "o", "table", "The output format. One of 'json', 'table'")
+	profileListCmd.Flags().StringVarP(&output, "output", "o", "table", "The output format. One of 'json', 'table'. With json, each profile lists its nodes with their name, role, IP and status.")
+	profileListCmd.Flags().BoolVar(&showHealth, "show-health", false, "If true, show how many nodes of each running profile are Ready according to its apiserver, which is also included in the json output.")
 	ProfileCmd.AddCommand(profileListCmd)
 }
@@ -0,0 +1,69 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestNodeRole(t *testing.T) {
+	var tests = []struct {
+		node config.Node
+		want string
+	}{
+		{config.Node{Name: "", ControlPlane: true, Worker: true}, "control-plane"},
+		{config.Node{Name: "m02", ControlPlane: true}, "control-plane"},
+		{config.Node{Name: "m03", Worker: true}, "worker"},
+	}
+	for _, tc := range tests {
+		if got := nodeRole(tc.node); got != tc.want {
+			t.Errorf("nodeRole(%+v) = %q, want %q", tc.node, got, tc.want)
+		}
+	}
+}
+
+func TestNodesReady(t *testing.T) {
+	node := func(name string, status core.ConditionStatus) core.Node {
+		return core.Node{ObjectMeta: meta.ObjectMeta{Name: name}, Status: core.NodeStatus{Conditions: []core.NodeCondition{
+			{Type: core.NodeMemoryPressure, Status: core.ConditionTrue},
+			{Type: core.NodeReady, Status: status},
+		}}}
+	}
+	var tests = []struct {
+		description string
+		nodes       []core.Node
+		names       []string
+		want        string
+	}{
+		{"all ready", []core.Node{node("minikube", core.ConditionTrue), node("minikube-m02", core.ConditionTrue)}, []string{"minikube", "minikube-m02"}, "2/2"},
+		{"one not ready", []core.Node{node("minikube", core.ConditionTrue), node("minikube-m02", core.ConditionFalse), node("minikube-m03", core.ConditionUnknown)}, []string{"minikube", "minikube-m02", "minikube-m03"}, "1/3"},
+		{"not registered", []core.Node{node("minikube", core.ConditionTrue)}, []string{"minikube", "minikube-m02"}, "1/2"},
+		{"no conditions", []core.Node{{ObjectMeta: meta.ObjectMeta{Name: "minikube"}}}, []string{"minikube"}, "0/1"},
+		{"other nodes of the cluster", []core.Node{node("minikube", core.ConditionTrue), node("external", core.ConditionTrue)}, []string{"minikube"}, "1/1"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			if got := nodesReady(tc.nodes, tc.names); got != tc.want {
+				t.Errorf("nodesReady() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -17,18 +17,27 @@ limitations under the License.
 package config
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
 	"os"
 	"text/template"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/out"
 )
 
 const defaultConfigViewFormat = "- {{.ConfigKey}}: {{.ConfigValue}}\n"
 
-var viewFormat string
+var (
+	viewFormat string
+	viewOutput string
+)
 
 // ViewTemplate represents the view template
 type ViewTemplate struct {
@@ -36,11 +45,25 @@ type ViewTemplate struct {
 	ConfigValue interface{}
 }
 
+// ProfileView is the stored configuration of a profile, with the nodes listed after the cluster-wide settings
+type ProfileView struct {
+	Cluster config.ClusterConfig
+	Nodes   []config.Node
+}
+
 var configViewCmd = &cobra.Command{
 	Use:   "view",
 	Short: "Display values currently set in the minikube config file",
-	Long:  "Display values currently set in the minikube config file.",
+	Long: `Display values currently set in the minikube config file.
+With --profile or --output, display the stored configuration of the profile and of each of its nodes instead.`,
+	Example: `minikube config view
+minikube config view -p minikube -o yaml`,
 	Run: func(cmd *cobra.Command, args []string) {
+		if cmd.Flags().Changed(config.ProfileName) || viewOutput != "" {
+			viewProfile(ClusterFlagValue())
+			return
+		}
+
 		err := View()
 		if err != nil {
 			exit.WithError("config view failed", err)
@@ -52,6 +75,7 @@ func init() {
 	configViewCmd.Flags().StringVar(&viewFormat, "format", defaultConfigViewFormat,
 		`Go template format string for the config view output.  The format for Go templates can be found here: https://golang.org/pkg/text/template/
 For the list of accessible variables for the template, see the struct values here: https://godoc.org/k8s.io/minikube/cmd/minikube/cmd/config#ConfigViewTemplate`)
+	configViewCmd.Flags().StringVarP(&viewOutput, "output", "o", "", "Display the stored configuration of the profile in this format. One of 'yaml' or 'json'. Defaults to yaml with --profile.")
 	ConfigCmd.AddCommand(configViewCmd)
 }
 
@@ -74,3 +98,60 @@ func View() error {
 	}
 	return nil
 }
+
+// viewProfile displays the stored configuration of a profile
+func viewProfile(name string) {
+	cc := loadProfile(name)
+	format := viewOutput
+	if format == "" {
+		format = "yaml"
+	}
+	if err := writeProfileView(newProfileView(*cc), format, os.Stdout); err != nil {
+		exit.WithCodeT(exit.BadUsage, "config view failed: {{.error}}", out.V{"error": err})
+	}
+}
+
+// loadProfile loads the stored configuration of a profile, exiting if it can not be loaded
+func loadProfile(name string) *config.ClusterConfig {
+	cc, err := config.Load(name)
+	if err != nil {
+		if config.IsNotExist(err) {
+			exit.WithCodeT(exit.Config, "Profile {{.name}} not found", out.V{"name": name})
+		}
+		exit.WithError("Error loading profile config", err)
+	}
+	return cc
+}
+
+// newProfileView returns the view of a cluster config, separating its nodes from the cluster-wide settings
+func newProfileView(cc config.ClusterConfig) ProfileView {
+	nodes := cc.Nodes
+	cc.Nodes = nil
+	return ProfileView{Cluster: cc, Nodes: nodes}
+}
+
+// writeProfileView writes a profile view as json or yaml, the yaml keeping the field names and order of the stored json
+func writeProfileView(v ProfileView, format string, w io.Writer) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "marshal")
+	}
+
+	switch format {
+	case "json":
+		_, err := fmt.Fprintln(w, string(b))
+		return err
+	case "yaml":
+		var doc yaml.MapSlice
+		if err := yaml.Unmarshal(b, &doc); err != nil {
+			return errors.Wrap(err, "converting to yaml")
+		}
+		y, err := yaml.Marshal(doc)
+		if err != nil {
+			return errors.Wrap(err, "marshal yaml")
+		}
+		_, err = w.Write(y)
+		return err
+	}
+	return fmt.Errorf("invalid output format: %s. Valid values: 'yaml', 'json'", format)
+}
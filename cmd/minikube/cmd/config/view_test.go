@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestWriteProfileView(t *testing.T) {
+	cc := config.ClusterConfig{
+		Name:   "minikube",
+		Driver: "kvm2",
+		CPUs:   2,
+		Nodes: []config.Node{
+			{Name: "", ControlPlane: true, Worker: true},
+			{Name: "m02", Worker: true, CPUs: 4, Sysctls: []string{"vm.max_map_count=262144"}},
+		},
+	}
+	v := newProfileView(cc)
+	if len(v.Cluster.Nodes) != 0 || len(v.Nodes) != 2 {
+		t.Fatalf("newProfileView() = %d cluster nodes and %d nodes, want: 0 and 2", len(v.Cluster.Nodes), len(v.Nodes))
+	}
+	if len(cc.Nodes) != 2 {
+		t.Errorf("newProfileView() modified the nodes of the cluster config")
+	}
+
+	var b bytes.Buffer
+	if err := writeProfileView(v, "json", &b); err != nil {
+		t.Fatalf("writeProfileView(json): %v", err)
+	}
+	var got ProfileView
+	if err := json.Unmarshal(b.Bytes(), &got); err != nil {
+		t.Fatalf("unmarshal %s: %v", b.String(), err)
+	}
+	if got.Cluster.Name != "minikube" || len(got.Nodes) != 2 || got.Nodes[1].CPUs != 4 {
+		t.Errorf("writeProfileView(json) = %+v, want: the cluster and both nodes", got)
+	}
+
+	b.Reset()
+	if err := writeProfileView(v, "yaml", &b); err != nil {
+		t.Fatalf("writeProfileView(yaml): %v", err)
+	}
+	for _, want := range []string{"Cluster:\n  Name: minikube\n", "\nNodes:\n- Name: \"\"\n", "\n- Name: m02\n", "  Sysctls:\n  - vm.max_map_count=262144\n"} {
+		if !strings.Contains(b.String(), want) {
+			t.Errorf("writeProfileView(yaml) = %s, want it to contain %q", b.String(), want)
+		}
+	}
+
+	if err := writeProfileView(v, "table", &b); err == nil {
+		t.Errorf("writeProfileView(table) expected error but got none")
+	}
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/docker/machine/libmachine"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+var (
+	cpAllNodes  bool
+	cpRecursive bool
+)
+
+// cpFile is a local file and the absolute path it is copied to inside a node
+type cpFile struct {
+	src   string
+	dst   string
+	perms string
+}
+
+// cpCmd represents the cp command
+var cpCmd = &cobra.Command{
+	Use:   "cp <source file> [<node>:]<target path>",
+	Short: "Copy a file into minikube",
+	Long: `Copy a local file to an absolute path inside a node. Use --all-nodes to copy it to every running node.
+The node may also be given as a prefix of the target path. With -r, copy a directory and everything under it.`,
+	Example: `minikube cp ./ca.pem /etc/ssl/certs/ca.pem
+minikube cp ./ca.pem /etc/ssl/certs/ca.pem --all-nodes
+minikube cp -r ./dir m03:/opt/dir`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 2 {
+			exit.UsageT("Usage: minikube cp <source file> [<node>:]<target path>")
+		}
+
+		src := args[0]
+		target, dst := parseCpTarget(args[1])
+		if target != "" {
+			if nodeName != "" || cpAllNodes {
+				exit.UsageT("A node prefix of the target path may not be used with --node or --all-nodes")
+			}
+			nodeName = target
+		}
+		if cpAllNodes && nodeName != "" {
+			exit.UsageT("--node and --all-nodes may not be used together")
+		}
+
+		if !path.IsAbs(dst) {
+			exit.UsageT("Target path {{.path}} must be absolute", out.V{"path": dst})
+		}
+		files, dirs, err := cpFiles(src, dst, cpRecursive)
+		if err != nil {
+			exit.WithCodeT(exit.BadUsage, "Unable to read {{.path}}: {{.error}}", out.V{"path": src, "error": err})
+		}
+
+		api, cc := mustload.Partial(ClusterFlagValue())
+		defer api.Close()
+
+		if !cpAllNodes {
+			n := cpTargetNode(*cc)
+			if !machine.IsRunning(api, driver.MachineName(*cc, n)) {
+				exit.WithCodeT(exit.Unavailable, "Node {{.name}} is not running.", out.V{"name": n.Name})
+			}
+			if err := copyToNode(api, *cc, n, files, dirs); err != nil {
+				exit.WithError("copy failed", err)
+			}
+			out.T(out.Copying, "Copied {{.src}} to {{.name}}:{{.dst}}", out.V{"src": src, "name": n.Name, "dst": dst})
+			return
+		}
+
+		copied, failed := 0, 0
+		for _, n := range cc.Nodes {
+			if !machine.IsRunning(api, driver.MachineName(*cc, n)) {
+				out.T(out.Meh, "Skipping node {{.name}}: not running", out.V{"name": n.Name})
+				continue
+			}
+			if err := copyToNode(api, *cc, n, files, dirs); err != nil {
+				out.FailureT("Failed to copy {{.src}} to {{.name}}:{{.dst}}: {{.error}}", out.V{"src": src, "name": n.Name, "dst": dst, "error": err})
+				failed++
+				continue
+			}
+			out.T(out.Copying, "Copied {{.src}} to {{.name}}:{{.dst}}", out.V{"src": src, "name": n.Name, "dst": dst})
+			copied++
+		}
+
+		if failed > 0 {
+			exit.WithCodeT(exit.Failure, "Copy failed on {{.failed}} of {{.total}} running nodes", out.V{"failed": failed, "total": copied + failed})
+		}
+		if copied == 0 {
+			exit.WithCodeT(exit.Unavailable, "No running nodes to copy {{.src}} to", out.V{"src": src})
+		}
+	},
+}
+
+// cpTargetNode returns the node selected by --node, defaulting to the primary control plane
+func cpTargetNode(cc config.ClusterConfig) config.Node {
+	if nodeName == "" {
+		cp, err := config.PrimaryControlPlane(&cc)
+		if err != nil {
+			exit.WithError("Error getting primary control plane", err)
+		}
+		return cp
+	}
+	n, _, err := node.Retrieve(cc, nodeName)
+	if err != nil {
+		exit.WithCodeT(exit.Unavailable, "Node {{.nodeName}} does not exist.", out.V{"nodeName": nodeName})
+	}
+	return *n
+}
+
+// parseCpTarget splits a target of the form <node>:<path> into the node name and path, the node name being empty without a prefix
+func parseCpTarget(arg string) (string, string) {
+	i := strings.Index(arg, ":")
+	if i <= 0 || strings.Contains(arg[:i], "/") {
+		return "", arg
+	}
+	return arg[:i], arg[i+1:]
+}
+
+// cpFiles returns the files to copy from src to dst, along with the directories to create for them.
+// With recursive, a directory is walked and its contents copied under dst, anything but regular files and directories being skipped.
+func cpFiles(src, dst string, recursive bool) ([]cpFile, []string, error) {
+	info, err := os.Stat(src)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !info.IsDir() {
+		return []cpFile{{src: src, dst: dst, perms: fmt.Sprintf("%04o", info.Mode().Perm())}}, nil, nil
+	}
+	if !recursive {
+		return nil, nil, fmt.Errorf("%s is a directory, use -r to copy it", src)
+	}
+
+	var files []cpFile
+	var dirs []string
+	err = filepath.Walk(src, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		target := path.Join(dst, filepath.ToSlash(rel))
+		switch {
+		case info.IsDir():
+			dirs = append(dirs, target)
+		case info.Mode().IsRegular():
+			files = append(files, cpFile{src: p, dst: target, perms: fmt.Sprintf("%04o", info.Mode().Perm())})
+		default:
+			glog.Warningf("skipping %s: not a regular file", p)
+		}
+		return nil
+	})
+	return files, dirs, err
+}
+
+// copyToNode copies files to a single node, creating dirs first
+func copyToNode(api libmachine.API, cc config.ClusterConfig, n config.Node, files []cpFile, dirs []string) error {
+	h, err := machine.LoadHost(api, driver.MachineName(cc, n))
+	if err != nil {
+		return errors.Wrap(err, "loading host")
+	}
+	r, err := machine.CommandRunner(h)
+	if err != nil {
+		return errors.Wrap(err, "command runner")
+	}
+	if len(dirs) > 0 {
+		if _, err := r.RunCmd(exec.Command("sudo", append([]string{"mkdir", "-p"}, dirs...)...)); err != nil {
+			return errors.Wrap(err, "creating directories")
+		}
+	}
+	for _, cf := range files {
+		// each copy consumes the asset's reader, so create a fresh one per node
+		f, err := assets.NewFileAsset(cf.src, path.Dir(cf.dst), path.Base(cf.dst), cf.perms)
+		if err != nil {
+			return errors.Wrap(err, "file asset")
+		}
+		if err := r.Copy(f); err != nil {
+			return errors.Wrapf(err, "copying %s", cf.src)
+		}
+	}
+	return nil
+}
+
+func init() {
+	cpCmd.Flags().StringVarP(&nodeName, "node", "n", "", "The node to copy the file to. Defaults to the primary control plane.")
+	cpCmd.Flags().BoolVar(&cpAllNodes, "all-nodes", false, "Copy the file to every running node.")
+	cpCmd.Flags().BoolVarP(&cpRecursive, "recursive", "r", false, "Copy a directory and everything under it, creating the target directories as needed.")
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseCpTarget(t *testing.T) {
+	var tests = []struct {
+		arg      string
+		wantNode string
+		wantPath string
+	}{
+		{arg: "/opt/dir", wantPath: "/opt/dir"},
+		{arg: "m03:/opt/dir", wantNode: "m03", wantPath: "/opt/dir"},
+		{arg: ":/opt/dir", wantPath: ":/opt/dir"},
+		{arg: "/opt/a:b", wantPath: "/opt/a:b"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.arg, func(t *testing.T) {
+			n, p := parseCpTarget(tc.arg)
+			if n != tc.wantNode || p != tc.wantPath {
+				t.Errorf("parseCpTarget(%q) = %q, %q, want: %q, %q", tc.arg, n, p, tc.wantNode, tc.wantPath)
+			}
+		})
+	}
+}
+
+func TestCpFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cp")
+	if err != nil {
+		t.Fatalf("tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.MkdirAll(filepath.Join(dir, "conf.d", "empty"), 0755); err != nil {
+		t.Fatalf("mkdir: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "main.conf"), []byte("a"), 0644); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "conf.d", "run.sh"), []byte("b"), 0755); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	files, dirs, err := cpFiles(dir, "/opt/dir", true)
+	if err != nil {
+		t.Fatalf("cpFiles: %v", err)
+	}
+	wantFiles := []cpFile{
+		{src: filepath.Join(dir, "conf.d", "run.sh"), dst: "/opt/dir/conf.d/run.sh", perms: "0755"},
+		{src: filepath.Join(dir, "main.conf"), dst: "/opt/dir/main.conf", perms: "0644"},
+	}
+	if !reflect.DeepEqual(files, wantFiles) {
+		t.Errorf("cpFiles() files = %+v, want: %+v", files, wantFiles)
+	}
+	wantDirs := []string{"/opt/dir", "/opt/dir/conf.d", "/opt/dir/conf.d/empty"}
+	if !reflect.DeepEqual(dirs, wantDirs) {
+		t.Errorf("cpFiles() dirs = %v, want: %v", dirs, wantDirs)
+	}
+
+	if _, _, err := cpFiles(dir, "/opt/dir", false); err == nil {
+		t.Errorf("cpFiles() of a directory without recursive expected error but got none")
+	}
+
+	files, dirs, err = cpFiles(filepath.Join(dir, "main.conf"), "/etc/main.conf", false)
+	if err != nil {
+		t.Fatalf("cpFiles: %v", err)
+	}
+	if len(files) != 1 || files[0].dst != "/etc/main.conf" || len(dirs) != 0 {
+		t.Errorf("cpFiles() of a file = %+v, %v, want a single file and no directories", files, dirs)
+	}
+}
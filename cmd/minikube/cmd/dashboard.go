@@ -90,7 +90,7 @@ var dashboardCmd = &cobra.Command{
 		}
 
 		out.ErrT(out.Launch, "Launching proxy ...")
-		p, hostPort, err := kubectlProxy(kubectlVersion, cname)
+		p, hostPort, err := kubectlProxy(kubectlVersion, co.Config.KubernetesConfig.BinaryMirror, cname)
 		if err != nil {
 			exit.WithError("kubectl proxy", err)
 		}
@@ -124,7 +124,7 @@ var dashboardCmd = &cobra.Command{
 }
 
 // kubectlProxy runs "kubectl proxy", returning host:port
-func kubectlProxy(kubectlVersion string, contextName string) (*exec.Cmd, string, error) {
+func kubectlProxy(kubectlVersion string, binaryMirror string, contextName string) (*exec.Cmd, string, error) {
 	// port=0 picks a random system port
 
 	kubectlArgs := []string{"--context", contextName, "proxy", "--port=0"}
@@ -132,7 +132,7 @@ func kubectlProxy(kubectlVersion string, contextName string) (*exec.Cmd, string,
 	var cmd *exec.Cmd
 	if kubectl, err := exec.LookPath("kubectl"); err == nil {
 		cmd = exec.Command(kubectl, kubectlArgs...)
-	} else if cmd, err = KubectlCommand(kubectlVersion, kubectlArgs...); err != nil {
+	} else if cmd, err = KubectlCommand(kubectlVersion, binaryMirror, kubectlArgs...); err != nil {
 		return nil, "", err
 	}
 
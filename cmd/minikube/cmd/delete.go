@@ -23,6 +23,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/docker/machine/libmachine/mcnerror"
 	"github.com/golang/glog"
@@ -43,6 +44,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/kubeconfig"
 	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/node"
 	"k8s.io/minikube/pkg/minikube/out"
 )
 
@@ -293,6 +295,13 @@ func deleteProfile(profile *config.Profile) error {
 		return DeletionError{Err: delErr, Errtype: MissingProfile}
 	}
 
+	// kept so that the cluster can be recreated with start --preserve-node-config
+	if err == nil {
+		if err := config.SaveNodeConfig(cc); err != nil {
+			glog.Warningf("unable to save node config of %s: %v", profile.Name, err)
+		}
+	}
+
 	if err == nil && driver.BareMetal(cc.Driver) {
 		if err := uninstallKubernetes(api, *cc, cc.Nodes[0], viper.GetString(cmdcfg.Bootstrapper)); err != nil {
 			deletionError, ok := err.(DeletionError)
@@ -305,11 +314,11 @@ func deleteProfile(profile *config.Profile) error {
 		}
 	}
 
-	if err := killMountProcess(); err != nil {
+	if err := killMountProcess(cc); err != nil {
 		out.FailureT("Failed to kill mount process: {{.error}}", out.V{"error": err})
 	}
 
-	deleteHosts(api, cc)
+	deleteHosts(api, profile.Name, cc)
 
 	// In case DeleteHost didn't complete the job.
 	deleteProfileDirectory(profile.Name)
@@ -325,21 +334,90 @@ func deleteProfile(profile *config.Profile) error {
 	return nil
 }
 
-func deleteHosts(api libmachine.API, cc *config.ClusterConfig) {
+// deleteHosts deletes the machines of every node, along with those left over by an interrupted delete, tolerating machines which are already gone
+func deleteHosts(api libmachine.API, cname string, cc *config.ClusterConfig) {
+	var machines []string
 	if cc != nil {
 		for _, n := range cc.Nodes {
-			machineName := driver.MachineName(*cc, n)
-			if err := machine.DeleteHost(api, machineName); err != nil {
-				switch errors.Cause(err).(type) {
-				case mcnerror.ErrHostDoesNotExist:
-					glog.Infof("Host %s does not exist. Proceeding ahead with cleanup.", machineName)
-				default:
-					out.FailureT("Failed to delete cluster: {{.error}}", out.V{"error": err})
-					out.T(out.Notice, `You may need to manually remove the "{{.name}}" VM from your hypervisor`, out.V{"name": machineName})
-				}
+			machines = append(machines, driver.MachineName(*cc, n))
+		}
+	}
+	// without knowing the machines of the other profiles, a leftover can not be told apart from one of theirs
+	if others, err := otherProfileMachines(cname); err != nil {
+		glog.Warningf("unable to list profiles, not looking for leftover machines: %v", err)
+	} else if listed, err := api.List(); err != nil {
+		glog.Warningf("unable to list machines: %v", err)
+	} else {
+		machines = append(machines, leftoverMachines(cname, machines, others, listed)...)
+	}
+
+	var removed, gone []string
+	for _, machineName := range machines {
+		if err := machine.DeleteHost(api, machineName); err != nil {
+			switch errors.Cause(err).(type) {
+			case mcnerror.ErrHostDoesNotExist:
+				glog.Infof("Host %s does not exist. Proceeding ahead with cleanup.", machineName)
+				gone = append(gone, machineName)
+			default:
+				out.FailureT("Failed to delete cluster: {{.error}}", out.V{"error": err})
+				out.T(out.Notice, `You may need to manually remove the "{{.name}}" VM from your hypervisor`, out.V{"name": machineName})
 			}
+		} else {
+			removed = append(removed, machineName)
+		}
+		// the directory of the primary machine is named after the profile, and removed along with it
+		if machineName != cname {
+			deleteProfileDirectory(machineName)
 		}
 	}
+
+	if len(machines) > 1 && len(removed) > 0 {
+		out.T(out.Deleted, "Deleted machines: {{.machines}}", out.V{"machines": strings.Join(removed, ", ")})
+	}
+	if len(gone) > 0 {
+		out.T(out.Meh, "Already deleted machines: {{.machines}}", out.V{"machines": strings.Join(gone, ", ")})
+	}
+}
+
+// leftoverMachines returns the listed machines of a profile which are not known from its config, such as the machines
+// of nodes whose delete was interrupted. Machines of other profiles, whose names may share the prefix, are excluded.
+func leftoverMachines(cname string, known []string, others []string, listed []string) []string {
+	skip := map[string]bool{}
+	for _, m := range append(append([]string{}, known...), others...) {
+		skip[m] = true
+	}
+
+	var leftover []string
+	for _, m := range listed {
+		if skip[m] || (m != cname && !strings.HasPrefix(m, cname+"-")) {
+			continue
+		}
+		leftover = append(leftover, m)
+	}
+	return leftover
+}
+
+// otherProfileMachines returns the names of the other profiles and of their machines
+func otherProfileMachines(cname string) ([]string, error) {
+	valid, invalid, err := config.ListProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	var machines []string
+	for _, p := range append(valid, invalid...) {
+		if p.Name == cname {
+			continue
+		}
+		machines = append(machines, p.Name)
+		if p.Config == nil {
+			continue
+		}
+		for _, n := range p.Config.Nodes {
+			machines = append(machines, driver.MachineName(*p.Config, n))
+		}
+	}
+	return machines, nil
 }
 
 func deleteConfig(cname string) error {
@@ -478,9 +556,24 @@ func deleteProfileDirectory(profile string) {
 	}
 }
 
-// killMountProcess kills the mount process, if it is running
-func killMountProcess() error {
-	pidPath := filepath.Join(localpath.MiniPath(), constants.MountProcessFileName)
+// killMountProcess kills the mount process, and those of the nodes of the cluster added with --mount, if they are running
+func killMountProcess(cc *config.ClusterConfig) error {
+	pidPaths := []string{filepath.Join(localpath.MiniPath(), constants.MountProcessFileName)}
+	if cc != nil {
+		for _, n := range cc.Nodes {
+			pidPaths = append(pidPaths, node.MountPidPath(driver.MachineName(*cc, n)))
+		}
+	}
+	for _, pidPath := range pidPaths {
+		if err := killMountPid(pidPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// killMountPid kills the mount process whose pid is stored in pidPath, if it is running
+func killMountPid(pidPath string) error {
 	if _, err := os.Stat(pidPath); os.IsNotExist(err) {
 		return nil
 	}
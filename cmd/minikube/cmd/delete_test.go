@@ -228,3 +228,26 @@ func TestDeleteAllProfiles(t *testing.T) {
 
 	viper.Set(config.ProfileName, "")
 }
+
+func TestLeftoverMachines(t *testing.T) {
+	var tests = []struct {
+		name   string
+		known  []string
+		others []string
+		listed []string
+		want   []string
+	}{
+		{"none", []string{"p1", "p1-m02"}, nil, []string{"p1", "p1-m02"}, nil},
+		{"interrupted node delete", []string{"p1"}, nil, []string{"p1", "p1-m02", "p1-m03"}, []string{"p1-m02", "p1-m03"}},
+		{"missing config", nil, nil, []string{"p1", "p1-m02"}, []string{"p1", "p1-m02"}},
+		{"other profiles", []string{"p1"}, []string{"p1-dev", "p1-dev-m02", "p2"}, []string{"p1", "p1-dev", "p1-dev-m02", "p10", "p2"}, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := leftoverMachines("p1", tc.known, tc.others, tc.listed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("leftoverMachines() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
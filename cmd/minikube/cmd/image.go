@@ -0,0 +1,165 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+var (
+	imageSaveOutput   string
+	imageLoadAllNodes bool
+)
+
+// imageCmd represents the image command
+var imageCmd = &cobra.Command{
+	Use:   "image",
+	Short: "Manage images in the container runtime of a node",
+	Long:  "Manage images in the container runtime of a node",
+}
+
+// imageSaveCmd represents the image save command
+var imageSaveCmd = &cobra.Command{
+	Use:   "save <image> -o <file>",
+	Short: "Save an image from a node to a tarball",
+	Long:  "Save an image as it exists in the container runtime of a node to a local tarball. Fails if the image is not present on the node.",
+	Example: `minikube image save busybox:1.28 -o busybox.tar
+minikube image save busybox:1.28 -o busybox.tar --node m03`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			exit.UsageT("Usage: minikube image save <image> -o <file>")
+		}
+		if imageSaveOutput == "" {
+			exit.UsageT("The file to save the image to must be set with --output")
+		}
+		img := args[0]
+
+		api, cc := mustload.Partial(ClusterFlagValue())
+		defer api.Close()
+
+		n := cpTargetNode(*cc)
+		machineName := driver.MachineName(*cc, n)
+		if !machine.IsRunning(api, machineName) {
+			exit.WithCodeT(exit.Unavailable, "Node {{.name}} is not running.", out.V{"name": n.Name})
+		}
+		h, err := machine.LoadHost(api, machineName)
+		if err != nil {
+			exit.WithError("Error getting host", err)
+		}
+		r, err := machine.CommandRunner(h)
+		if err != nil {
+			exit.WithError("Failed to get command runner", err)
+		}
+
+		if err := machine.SaveImage(*cc, r, img, imageSaveOutput); err != nil {
+			if _, ok := err.(*machine.ImageNotPresentError); ok {
+				exit.WithCodeT(exit.Unavailable, "Image {{.image}} is not present on node {{.name}}", out.V{"image": img, "name": n.Name})
+			}
+			exit.WithError("Failed to save image", err)
+		}
+		out.T(out.Copying, "Saved {{.image}} from {{.name}} to {{.file}}", out.V{"image": img, "name": n.Name, "file": imageSaveOutput})
+	},
+}
+
+// imageLoadCmd represents the image load command
+var imageLoadCmd = &cobra.Command{
+	Use:   "load <file|->",
+	Short: "Load an image tarball into the container runtime of nodes",
+	Long: `Load an image tarball, such as one written by 'docker save', into the container runtime of a node or of every node.
+The tarball is read from stdin when the file is '-', and streamed to every node at once without a temporary file.`,
+	Example: `minikube image load busybox.tar
+docker save busybox:1.28 | minikube image load - --all-nodes`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			exit.UsageT("Usage: minikube image load <file|->")
+		}
+		if imageLoadAllNodes && nodeName != "" {
+			exit.UsageT("--node and --all-nodes can not be combined")
+		}
+
+		api, cc := mustload.Partial(ClusterFlagValue())
+		defer api.Close()
+
+		nodes := []config.Node{cpTargetNode(*cc)}
+		if imageLoadAllNodes {
+			nodes = cc.Nodes
+		}
+		// every node is checked before reading the tarball, as stdin can only be read once
+		var runners []command.Runner
+		for _, n := range nodes {
+			machineName := driver.MachineName(*cc, n)
+			if !machine.IsRunning(api, machineName) {
+				exit.WithCodeT(exit.Unavailable, "Node {{.name}} is not running.", out.V{"name": n.Name})
+			}
+			h, err := machine.LoadHost(api, machineName)
+			if err != nil {
+				exit.WithError("Error getting host", err)
+			}
+			r, err := machine.CommandRunner(h)
+			if err != nil {
+				exit.WithError("Failed to get command runner", err)
+			}
+			runners = append(runners, r)
+		}
+
+		src := args[0]
+		var in io.Reader = os.Stdin
+		if src == "-" {
+			src = "stdin"
+		} else {
+			f, err := os.Open(src)
+			if err != nil {
+				exit.WithCodeT(exit.BadUsage, "Unable to open {{.file}}: {{.error}}", out.V{"file": src, "error": err})
+			}
+			defer f.Close()
+			in = f
+		}
+
+		var failed []string
+		for i, err := range machine.LoadImageToNodes(*cc, runners, in) {
+			if err != nil {
+				out.FailureT("Failed to load the image from {{.file}} into {{.name}}: {{.error}}", out.V{"file": src, "name": nodes[i].Name, "error": err})
+				failed = append(failed, nodes[i].Name)
+				continue
+			}
+			out.T(out.Copying, "Loaded the image from {{.file}} into {{.name}}", out.V{"file": src, "name": nodes[i].Name})
+		}
+		if len(failed) > 0 {
+			exit.WithCodeT(exit.Failure, "Unable to load the image into: {{.nodes}}", out.V{"nodes": strings.Join(failed, ", ")})
+		}
+	},
+}
+
+func init() {
+	imageLoadCmd.Flags().StringVarP(&nodeName, "node", "n", "", "The node to load the image into. Defaults to the primary control plane.")
+	imageLoadCmd.Flags().BoolVar(&imageLoadAllNodes, "all-nodes", false, "If true, load the image into every node of the cluster, streaming it to all of them at once.")
+	imageCmd.AddCommand(imageLoadCmd)
+	imageSaveCmd.Flags().StringVarP(&imageSaveOutput, "output", "o", "", "The local file to save the image to, as a tarball.")
+	imageSaveCmd.Flags().StringVarP(&nodeName, "node", "n", "", "The node to save the image from. Defaults to the primary control plane.")
+	imageCmd.AddCommand(imageSaveCmd)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/drivers/kic/oci"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+var (
+	kubeconfigNode       string
+	kubeconfigOutput     string
+	kubeconfigEmbedCerts bool
+)
+
+// kubeconfigCmd represents the kubeconfig command
+var kubeconfigCmd = &cobra.Command{
+	Use:   "kubeconfig",
+	Short: "Exports a kubeconfig pointing directly at a control plane",
+	Long: `Exports a standalone kubeconfig whose server is the apiserver of a single control plane node,
+			bypassing any load balancer in front of the cluster. Certificates are embedded unless
+			--embed-certs=false is passed, and the kubeconfig in use by kubectl is left untouched.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cname := ClusterFlagValue()
+		api, cc := mustload.Partial(cname)
+		defer api.Close()
+
+		var n config.Node
+		if kubeconfigNode == "" {
+			cp, err := config.PrimaryControlPlane(cc)
+			if err != nil {
+				exit.WithError("getting primary control plane", err)
+			}
+			n = cp
+		} else {
+			found, _, err := node.Retrieve(*cc, kubeconfigNode)
+			if err != nil {
+				exit.WithCodeT(exit.BadUsage, "Node {{.name}} does not exist.", out.V{"name": kubeconfigNode})
+			}
+			n = *found
+		}
+		if !n.ControlPlane {
+			exit.UsageT("Node {{.name}} is not a control plane, and has no apiserver.", out.V{"name": n.Name})
+		}
+
+		machineName := driver.MachineName(*cc, n)
+		if !machine.IsRunning(api, machineName) {
+			exit.WithCodeT(exit.Unavailable, "Node {{.name}} is not running.", out.V{"name": machineName})
+		}
+
+		addr, err := nodeServerURL(*cc, n)
+		if err != nil {
+			exit.WithError("getting apiserver address", err)
+		}
+
+		data, err := kubeconfig.Marshal(&kubeconfig.Settings{
+			ClusterName:          kubeconfigClusterName(cname, n),
+			ClusterServerAddress: addr,
+			ClientCertificate:    localpath.ClientCert(cname),
+			ClientKey:            localpath.ClientKey(cname),
+			CertificateAuthority: localpath.CACert(),
+			EmbedCerts:           kubeconfigEmbedCerts,
+		})
+		if err != nil {
+			exit.WithError("generating kubeconfig", err)
+		}
+
+		if kubeconfigOutput == "" {
+			if _, err := os.Stdout.Write(data); err != nil {
+				exit.WithError("writing kubeconfig", err)
+			}
+			return
+		}
+		if err := ioutil.WriteFile(kubeconfigOutput, data, 0600); err != nil {
+			exit.WithError("writing kubeconfig", err)
+		}
+		out.T(out.Celebrate, "Wrote a kubeconfig for {{.name}} at {{.address}} to {{.path}}", out.V{"name": machineName, "address": addr, "path": kubeconfigOutput})
+	},
+}
+
+// kubeconfigClusterName returns the name of the cluster entry for a control plane,
+// the primary control plane of older profiles has no name and is named after the profile
+func kubeconfigClusterName(cname string, n config.Node) string {
+	if n.Name == "" {
+		return cname
+	}
+	return fmt.Sprintf("%s-%s", cname, n.Name)
+}
+
+// nodeServerURL returns the host-accessible apiserver URL of a single control plane.
+// Unlike driver.ControlPlaneEndpoint, the --apiserver-name is ignored so that the node itself is reached.
+func nodeServerURL(cc config.ClusterConfig, n config.Node) (string, error) {
+	hostname := n.IP
+	port := n.Port
+	if driver.NeedsPortForward(cc.Driver) {
+		p, err := oci.ForwardedPort(cc.Driver, driver.MachineName(cc, n), n.Port)
+		if err != nil {
+			return "", err
+		}
+		hostname = oci.DefaultBindIPV4
+		port = p
+	}
+	return "https://" + net.JoinHostPort(hostname, strconv.Itoa(port)), nil
+}
+
+func init() {
+	kubeconfigCmd.Flags().StringVarP(&kubeconfigNode, "node", "n", "", "The control plane node whose apiserver the kubeconfig points at. Defaults to the primary control plane.")
+	kubeconfigCmd.Flags().StringVarP(&kubeconfigOutput, "output", "o", "", "File to write the kubeconfig to. Defaults to STDOUT.")
+	kubeconfigCmd.Flags().BoolVar(&kubeconfigEmbedCerts, "embed-certs", true, "If true, embed the certificates in the kubeconfig so that it can be used on another machine. If false, reference the certificate files of this machine.")
+}
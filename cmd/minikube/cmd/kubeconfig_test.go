@@ -0,0 +1,41 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestKubeconfigClusterName(t *testing.T) {
+	var tests = []struct {
+		description string
+		node        config.Node
+		want        string
+	}{
+		{"unnamed primary", config.Node{ControlPlane: true}, "minikube"},
+		{"named control plane", config.Node{Name: "m02", ControlPlane: true}, "minikube-m02"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			if got := kubeconfigClusterName("minikube", tc.node); got != tc.want {
+				t.Errorf("kubeconfigClusterName() = %q, want: %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -43,7 +43,7 @@ minikube kubectl -- get pods --namespace kube-system`,
 		co := mustload.Healthy(ClusterFlagValue())
 
 		version := co.Config.KubernetesConfig.KubernetesVersion
-		c, err := KubectlCommand(version, args...)
+		c, err := KubectlCommand(version, co.Config.KubernetesConfig.BinaryMirror, args...)
 		if err != nil {
 			out.ErrLn("Error caching kubectl: %v", err)
 		}
@@ -66,13 +66,13 @@ minikube kubectl -- get pods --namespace kube-system`,
 	},
 }
 
-// KubectlCommand will return kubectl command with a version matching the cluster
-func KubectlCommand(version string, args ...string) (*exec.Cmd, error) {
+// KubectlCommand will return kubectl command with a version matching the cluster, downloaded from binaryMirror if set
+func KubectlCommand(version string, binaryMirror string, args ...string) (*exec.Cmd, error) {
 	if version == "" {
 		version = constants.DefaultKubernetesVersion
 	}
 
-	path, err := node.CacheKubectlBinary(version)
+	path, err := node.CacheKubectlBinary(version, binaryMirror)
 	if err != nil {
 		return nil, err
 	}
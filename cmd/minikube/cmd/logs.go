@@ -17,16 +17,26 @@ limitations under the License.
 package cmd
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	cmdcfg "k8s.io/minikube/cmd/minikube/cmd/config"
+	"k8s.io/minikube/pkg/minikube/bootstrapper"
 	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/cruntime"
+	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/logs"
+	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
 	"k8s.io/minikube/pkg/minikube/out"
 )
 
@@ -43,6 +53,10 @@ var (
 	numberOfLines int
 	// showProblems only shows lines that match known issues
 	showProblems bool
+	// logsOutput is the output format, text or json
+	logsOutput string
+	// logsFile is the file to write the logs to instead of stdout
+	logsFile string
 )
 
 // logsCmd represents the logs command
@@ -51,30 +65,73 @@ var logsCmd = &cobra.Command{
 	Short: "Returns logs to debug a local Kubernetes cluster",
 	Long:  `Gets the logs of the running instance, used for debugging minikube, not user code.`,
 	Run: func(cmd *cobra.Command, args []string) {
+		output := strings.ToLower(logsOutput)
+		if output != "text" && output != "json" {
+			exit.WithCodeT(exit.BadUsage, fmt.Sprintf("invalid output format: %s. Valid values: 'text', 'json'", logsOutput))
+		}
+		if output == "json" && followLogs {
+			exit.UsageT("--follow is not supported with --output=json")
+		}
+		if logsFile != "" && followLogs {
+			exit.UsageT("--follow is not supported with --file")
+		}
+		if numberOfLines < 1 {
+			exit.UsageT("Invalid --length: {{.length}}, it must be 1 or more", out.V{"length": numberOfLines})
+		}
+
 		co := mustload.Running(ClusterFlagValue())
 
-		bs, err := cluster.Bootstrapper(co.API, viper.GetString(cmdcfg.Bootstrapper), *co.Config, co.CP.Runner)
+		n, runner := co.CP.Node, co.CP.Runner
+		if nodeName != "" {
+			n, runner = logsNode(co, nodeName)
+		}
+
+		bs, err := cluster.Bootstrapper(co.API, viper.GetString(cmdcfg.Bootstrapper), *co.Config, runner)
 		if err != nil {
 			exit.WithError("Error getting cluster bootstrapper", err)
 		}
 
-		cr, err := cruntime.New(cruntime.Config{Type: co.Config.KubernetesConfig.ContainerRuntime, Runner: co.CP.Runner})
+		cr, err := cruntime.New(cruntime.Config{Type: co.Config.KubernetesConfig.ContainerRuntime, Runner: runner})
 		if err != nil {
 			exit.WithError("Unable to get runtime", err)
 		}
+		if logsFile != "" {
+			if err := writeLogsFile(cr, bs, *co.Config, runner, n.Name, output); err != nil {
+				out.WarningT("{{.error}}", out.V{"error": err})
+				os.Exit(exit.Unavailable)
+			}
+			return
+		}
 		if followLogs {
-			err := logs.Follow(cr, bs, *co.Config, co.CP.Runner)
+			err := logs.Follow(cr, bs, *co.Config, runner)
 			if err != nil {
 				exit.WithError("Follow", err)
 			}
 			return
 		}
 		if showProblems {
-			problems := logs.FindProblems(cr, bs, *co.Config, co.CP.Runner)
+			problems := logs.FindProblems(cr, bs, *co.Config, runner)
+			if output == "json" {
+				if err := logsJSON(logs.ProblemEntries(problems, n.Name, numberOfProblems), os.Stdout); err != nil {
+					exit.WithError("logs json failure", err)
+				}
+				return
+			}
 			logs.OutputProblems(problems, numberOfProblems)
 			return
 		}
-		err = logs.Output(cr, bs, *co.Config, co.CP.Runner, numberOfLines)
+		if output == "json" {
+			entries, err := logs.Entries(cr, bs, *co.Config, runner, n.Name, numberOfLines)
+			if jerr := logsJSON(entries, os.Stdout); jerr != nil {
+				exit.WithError("logs json failure", jerr)
+			}
+			// Failed components are reported in the entries, so only the exit code needs to reflect the error
+			if err != nil {
+				os.Exit(exit.Unavailable)
+			}
+			return
+		}
+		err = logs.Output(cr, bs, *co.Config, runner, numberOfLines)
 		if err != nil {
 			out.Ln("")
 			// Avoid exit.WithError, since it outputs the issue URL
@@ -84,9 +141,74 @@ var logsCmd = &cobra.Command{
 	},
 }
 
+// logsNode returns the named node of the cluster along with a runner for it, exiting if it is not running
+func logsNode(co mustload.ClusterController, name string) (*config.Node, command.Runner) {
+	n, _, err := node.Retrieve(*co.Config, name)
+	if err != nil {
+		exit.WithCodeT(exit.Unavailable, "Node {{.nodeName}} does not exist.", out.V{"nodeName": name})
+	}
+
+	machineName := driver.MachineName(*co.Config, *n)
+	if !machine.IsRunning(co.API, machineName) {
+		exit.WithCodeT(exit.Unavailable, `Node {{.name}} is not running, start it with "minikube node start {{.name}}"`, out.V{"name": name})
+	}
+
+	h, err := machine.LoadHost(co.API, machineName)
+	if err != nil {
+		exit.WithError("Error loading host", err)
+	}
+	r, err := machine.CommandRunner(h)
+	if err != nil {
+		exit.WithError("Failed to get command runner", err)
+	}
+	return n, r
+}
+
+// writeLogsFile writes the logs of a node to --file, in the given output format.
+// The logs of the components which could be fetched are written even if others failed.
+func writeLogsFile(cr cruntime.Manager, bs bootstrapper.Bootstrapper, cc config.ClusterConfig, runner command.Runner, name string, output string) error {
+	var entries []logs.Entry
+	var fetchErr error
+	if showProblems {
+		entries = logs.ProblemEntries(logs.FindProblems(cr, bs, cc, runner), name, numberOfProblems)
+	} else {
+		entries, fetchErr = logs.Entries(cr, bs, cc, runner, name, numberOfLines)
+	}
+
+	f, err := os.Create(logsFile)
+	if err != nil {
+		exit.WithError("Error creating logs file", err)
+	}
+	if output == "json" {
+		err = logsJSON(entries, f)
+	} else {
+		err = logs.WriteEntries(f, entries)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		exit.WithError("Error writing logs file", err)
+	}
+
+	out.T(out.Ready, "Wrote the logs of node {{.name}} to {{.path}}", out.V{"name": name, "path": logsFile})
+	return fetchErr
+}
+
+func logsJSON(entries []logs.Entry, w io.Writer) error {
+	js, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(js)
+	return err
+}
+
 func init() {
+	logsCmd.Flags().StringVarP(&logsOutput, "output", "o", "text", "Output format. One of: text, json. With json, the logs of each component are reported as an entry holding its component, node and lines.")
 	logsCmd.Flags().BoolVarP(&followLogs, "follow", "f", false, "Show only the most recent journal entries, and continuously print new entries as they are appended to the journal.")
 	logsCmd.Flags().BoolVar(&showProblems, "problems", false, "Show only log entries which point to known problems")
-	logsCmd.Flags().IntVarP(&numberOfLines, "length", "n", 60, "Number of lines back to go within the log")
+	logsCmd.Flags().IntVarP(&numberOfLines, "length", "n", 60, "Number of lines back to go within the log of each component")
 	logsCmd.Flags().StringVar(&nodeName, "node", "", "The node to get logs from. Defaults to the primary control plane.")
+	logsCmd.Flags().StringVar(&logsFile, "file", "", "Write the logs to this file instead of stdout, such as one file per node with --node.")
 }
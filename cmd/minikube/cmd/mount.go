@@ -31,8 +31,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/mustload"
 	"k8s.io/minikube/pkg/minikube/out"
 	"k8s.io/minikube/third_party/go9p/ufs"
@@ -66,7 +68,11 @@ var mountCmd = &cobra.Command{
 	Long:  `Mounts the specified directory into minikube.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		if isKill {
-			if err := killMountProcess(); err != nil {
+			cc, err := config.Load(ClusterFlagValue())
+			if err != nil && !config.IsNotExist(err) {
+				exit.WithError("Error loading profile config", err)
+			}
+			if err := killMountProcess(cc); err != nil {
 				exit.WithError("Error killing mount process", err)
 			}
 			os.Exit(0)
@@ -103,10 +109,24 @@ var mountCmd = &cobra.Command{
 			exit.UsageT(`'none' driver does not support 'minikube mount' command`)
 		}
 
+		h, r := co.CP.Host, co.CP.Runner
+		if nodeName != "" {
+			n := cpTargetNode(*co.Config)
+			var err error
+			h, err = machine.LoadHost(co.API, driver.MachineName(*co.Config, n))
+			if err != nil {
+				exit.WithError("Error loading host", err)
+			}
+			r, err = machine.CommandRunner(h)
+			if err != nil {
+				exit.WithError("Failed to get command runner", err)
+			}
+		}
+
 		var ip net.IP
 		var err error
 		if mountIP == "" {
-			ip, err = cluster.HostIP(co.CP.Host)
+			ip, err = cluster.HostIP(h)
 			if err != nil {
 				exit.WithError("Error getting the host IP address to use from within the VM", err)
 			}
@@ -147,7 +167,7 @@ var mountCmd = &cobra.Command{
 		}
 
 		bindIP := ip.String() // the ip to listen on the user's host machine
-		if driver.IsKIC(co.CP.Host.Driver.DriverName()) && runtime.GOOS != "linux" {
+		if driver.IsKIC(h.Driver.DriverName()) && runtime.GOOS != "linux" {
 			bindIP = "127.0.0.1"
 		}
 		out.T(out.Mounting, "Mounting host path {{.sourcePath}} into VM as {{.destinationPath}} ...", out.V{"sourcePath": hostPath, "destinationPath": vmPath})
@@ -177,7 +197,7 @@ var mountCmd = &cobra.Command{
 		go func() {
 			for sig := range c {
 				out.T(out.Unmount, "Unmounting {{.path}} ...", out.V{"path": vmPath})
-				err := cluster.Unmount(co.CP.Runner, vmPath)
+				err := cluster.Unmount(r, vmPath)
 				if err != nil {
 					out.FailureT("Failed unmount: {{.error}}", out.V{"error": err})
 				}
@@ -185,7 +205,7 @@ var mountCmd = &cobra.Command{
 			}
 		}()
 
-		err = cluster.Mount(co.CP.Runner, ip.String(), vmPath, cfg)
+		err = cluster.Mount(r, ip.String(), vmPath, cfg)
 		if err != nil {
 			exit.WithError("mount failed", err)
 		}
@@ -206,6 +226,7 @@ func init() {
 	mountCmd.Flags().UintVar(&mode, "mode", 0755, "File permissions used for the mount")
 	mountCmd.Flags().StringSliceVar(&options, "options", []string{}, "Additional mount options, such as cache=fscache")
 	mountCmd.Flags().IntVar(&mSize, "msize", defaultMsize, "The number of bytes to use for 9p packet payload")
+	mountCmd.Flags().StringVarP(&nodeName, "node", "n", "", "The node to mount the directory into. Defaults to the primary control plane.")
 }
 
 // getPort asks the kernel for a free open port that is ready to use
@@ -27,6 +27,6 @@ var nodeCmd = &cobra.Command{
 	Short: "Add, remove, or list additional nodes",
 	Long:  "Operations on nodes",
 	Run: func(cmd *cobra.Command, args []string) {
-		exit.UsageT("Usage: minikube node [add|start|stop|delete|list]")
+		exit.UsageT("Usage: minikube node [add|start|stop|hibernate|resume|delete|drain-and-delete|describe|exec|list|set-label|remove-label|replace|logs|logs-bundle|map]")
 	},
 }
@@ -17,42 +17,347 @@ limitations under the License.
 package cmd
 
 import (
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/machine/libmachine"
+	"github.com/docker/machine/libmachine/host"
+	"github.com/golang/glog"
+	"github.com/google/go-containerregistry/pkg/name"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	cmdcfg "k8s.io/minikube/cmd/minikube/cmd/config"
+	pkgdrivers "k8s.io/minikube/pkg/drivers"
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/bootstrapper"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
+	"k8s.io/minikube/pkg/minikube/cluster"
 	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/cruntime"
+	"k8s.io/minikube/pkg/minikube/download"
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/mustload"
 	"k8s.io/minikube/pkg/minikube/node"
 	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/util"
 )
 
 var (
-	cp     bool
-	worker bool
+	cp               bool
+	worker           bool
+	nodeCgroupDriver string
+	nodeForceSystemd bool
+	nodeSysctls      []string
+	kubeletExtraArgs []string
+	extraDisks       int
+	extraDiskSize    string
+	nodeGPUs         string
+	nodeAddTimeout   time.Duration
+	nodeAddCNIOnly   bool
+	nodeAnnotations  []string
+	nodeExtendedRes  []string
+	nodeDriver       string
+	nodeReadyTimeout time.Duration
+	nodeMount        bool
+	nodeMountString  string
+	nodeRenewCerts   bool
+	nodeFromNode     string
+	nodeRepair       bool
+	nodeAddCount     int
+	runtimeConfig    []string
+	nodeCPUs         string
+	nodeMemory       string
+	nodeWaitForPods  []string
+	nodeShowProgress bool
+	nodeRegisterDNS  bool
+	nodeHostname     string
+	nodeArch         string
+	nodePauseImage   string
+	nodeLogMaxSize   string
+	nodeLogMaxFiles  int
+	nodeISOURL       string
+	nodeListenAddr   string
+	nodeVerbosity    int
+	postJoinScript   string
+	persistPostJoin  bool
 )
+
+// certRenewalWindow is how long before their expiry node add warns about control plane certificates
+const certRenewalWindow = 30 * 24 * time.Hour
+
+// supportedArchs are the architectures Kubernetes binaries are released for
+var supportedArchs = []string{"amd64", "arm64", "ppc64le", "s390x"}
+
+// isSupportedArch returns whether a node can be added with the given architecture
+func isSupportedArch(arch string) bool {
+	for _, a := range supportedArchs {
+		if a == arch {
+			return true
+		}
+	}
+	return false
+}
+
 var nodeAddCmd = &cobra.Command{
 	Use:   "add",
 	Short: "Adds a node to the given cluster.",
 	Long:  "Adds a node to the given cluster config, and starts it.",
 	Run: func(cmd *cobra.Command, args []string) {
+		// expired certificates make the cluster look unhealthy, so they are renewed before checking its health
+		if nodeRenewCerts {
+			renewControlPlaneCerts(mustload.Running(ClusterFlagValue()))
+		}
+
 		co := mustload.Healthy(ClusterFlagValue())
 		cc := co.Config
 
+		if !nodeRenewCerts {
+			warnExpiringCerts(co)
+		}
+
 		if driver.BareMetal(cc.Driver) {
 			out.FailureT("none driver does not support multi-node clusters")
 		}
 
-		name := node.Name(len(cc.Nodes) + 1)
+		opts := nodeAddOptions()
+		defer raiseVerbosity(nodeVerbosity)()
+
+		half := halfJoinedNodes(*cc)
+		if nodeRepair {
+			repairNodes(cc, half, opts)
+			return
+		}
+		for _, n := range half {
+			out.WarningT("Node {{.name}} did not finish joining the cluster", out.V{"name": n.Name})
+			out.T(out.Tip, "Complete its join with 'minikube node add --repair', or remove it with 'minikube node delete {{.name}}'", out.V{"name": n.Name})
+		}
+
+		// minikube clusters have a single control plane, so nodes are always added as workers
+		if cp {
+			exit.UsageT("Adding a control plane is not supported, minikube clusters have a single control plane")
+		}
+		if !worker {
+			exit.UsageT("--worker=false is not supported, nodes are always added as workers")
+		}
+
+		if nodeAddCount < 1 {
+			exit.UsageT("Invalid --count: {{.count}}, at least one node must be added", out.V{"count": nodeAddCount})
+		}
+		if nodeHostname != "" {
+			if nodeAddCount > 1 {
+				exit.UsageT("--hostname can not be used with --count, as every node needs a hostname of its own")
+			}
+			if err := node.ValidateHostname(*cc, nodeHostname); err != nil {
+				exit.UsageT("Unable to add a node with --hostname={{.hostname}}: {{.error}}", out.V{"hostname": nodeHostname, "error": err})
+			}
+		}
+		if cc.MaxNodes > 0 && len(cc.Nodes)+nodeAddCount > cc.MaxNodes {
+			exit.WithCodeT(exit.Config, "Cluster {{.cluster}} has {{.nodes}} nodes, adding {{.count}} would exceed its limit of {{.max}} set by --max-nodes", out.V{"cluster": cc.Name, "nodes": len(cc.Nodes), "count": nodeAddCount, "max": cc.MaxNodes})
+		}
+
+		if err := node.ValidateDriver(*cc, nodeDriver); err != nil {
+			exit.UsageT("Unable to add a node with --driver={{.driver}}: {{.error}}", out.V{"driver": nodeDriver, "error": err})
+		}
+
+		if nodePauseImage != "" {
+			if _, err := name.ParseReference(nodePauseImage, name.WeakValidation); err != nil {
+				exit.UsageT("Invalid --pause-image: {{.error}}", out.V{"error": err})
+			}
+		}
+
+		if err := bsutil.ValidateContainerLogRotation(nodeLogMaxSize, nodeLogMaxFiles); err != nil {
+			exit.UsageT("Invalid container log rotation: {{.error}}", out.V{"error": err})
+		}
+		if (nodeLogMaxSize != "" || nodeLogMaxFiles != 0) && cc.KubernetesConfig.ContainerRuntime == "docker" {
+			exit.UsageT("The docker runtime rotates container logs itself, --container-log-max-size and --container-log-max-files are not supported with it")
+		}
+
+		script := postJoinScriptPath()
+
+		var src *config.Node
+		if nodeFromNode != "" {
+			for _, f := range []string{cgroupDriver, forceSystemd, sysctl, "kubelet-extra-args", "extra-disks", "extra-disk-size", "gpus", "annotations", "extended-resource", "runtime-config", "pause-image", isoURL, "listen-address", "arch", containerLogMaxSize, containerLogMaxFiles, cpus, memory, "post-join-script", "persist-post-join-script", "register-node-dns", createMount, mountString} {
+				if cmd.Flags().Changed(f) {
+					exit.UsageT("--{{.flag}} can not be combined with --from-node, which copies the settings of the node", out.V{"flag": f})
+				}
+			}
+			var err error
+			src, _, err = node.Retrieve(*cc, nodeFromNode)
+			if err != nil {
+				exit.WithCodeT(exit.Unavailable, "Node {{.nodeName}} does not exist.", out.V{"nodeName": nodeFromNode})
+			}
+		}
+
+		name := node.NextName(*cc)
 
-		out.T(out.Happy, "Adding node {{.name}} to cluster {{.cluster}}", out.V{"name": name, "cluster": cc.Name})
+		if nodeAddCount > 1 {
+			out.T(out.Happy, "Adding {{.count}} nodes to cluster {{.cluster}}", out.V{"count": nodeAddCount, "cluster": cc.Name})
+		} else {
+			out.T(out.Happy, "Adding node {{.name}} to cluster {{.cluster}}", out.V{"name": name, "cluster": cc.Name})
+		}
 
 		// TODO: Deal with parameters better. Ideally we should be able to acceot any node-specific minikube start params here.
 		n := config.Node{
-			Name:              name,
-			Worker:            worker,
-			ControlPlane:      cp,
-			KubernetesVersion: cc.KubernetesConfig.KubernetesVersion,
+			Name:                 name,
+			Worker:               true,
+			ControlPlane:         false,
+			KubernetesVersion:    cc.KubernetesConfig.KubernetesVersion,
+			CgroupDriver:         nodeCgroupDriver,
+			Sysctls:              nodeSysctls,
+			KubeletExtraArgs:     kubeletExtraArgs,
+			Annotations:          nodeAnnotations,
+			ExtendedResources:    nodeExtendedRes,
+			RuntimeConfig:        runtimeConfig,
+			RegisterDNS:          nodeRegisterDNS,
+			Hostname:             nodeHostname,
+			PauseImage:           nodePauseImage,
+			ContainerLogMaxSize:  nodeLogMaxSize,
+			ContainerLogMaxFiles: nodeLogMaxFiles,
+		}
+
+		if nodeMount {
+			if err := node.ValidateMount(nodeMountString); err != nil {
+				exit.UsageT("Invalid --mount-string: {{.error}}", out.V{"error": err})
+			}
+			n.Mount = nodeMountString
+		}
+
+		if err := node.ValidateAnnotations(n.Annotations); err != nil {
+			exit.UsageT("Invalid --annotations: {{.error}}", out.V{"error": err})
+		}
+
+		if err := node.ValidateExtendedResources(n.ExtendedResources); err != nil {
+			exit.UsageT("Invalid --extended-resource: {{.error}}", out.V{"error": err})
+		}
+
+		if err := node.ValidateSysctls(n.Sysctls); err != nil {
+			exit.UsageT("Invalid --sysctl: {{.error}}", out.V{"error": err})
+		}
+
+		if err := bsutil.ValidateKubeletExtraArgs(n.KubeletExtraArgs); err != nil {
+			exit.UsageT("Invalid --kubelet-extra-args: {{.error}}", out.V{"error": err})
+		}
+
+		if len(n.RuntimeConfig) > 0 {
+			if _, err := cruntime.ParseOptions(cc.KubernetesConfig.ContainerRuntime, n.RuntimeConfig); err != nil {
+				exit.UsageT("Invalid --runtime-config: {{.error}}", out.V{"error": err})
+			}
+		}
+
+		if persistPostJoin {
+			n.PostJoinScript = script
+		}
+
+		if nodeCPUs != "" || nodeMemory != "" {
+			if !driver.HasResourceLimits(cc.Driver) {
+				exit.UsageT("The '{{.name}}' driver does not support sizing nodes with --cpus or --memory", out.V{"name": cc.Driver})
+			}
+			n.CPUs, n.Memory = nodeResources(cc.Driver)
+		}
+
+		if extraDisks > 0 {
+			if cc.Driver != driver.KVM2 {
+				exit.UsageT("The --extra-disks flag is currently only supported by the kvm2 driver")
+			}
+			if extraDisks > pkgdrivers.MaxExtraDisks {
+				exit.UsageT("At most {{.max}} extra disks can be attached to a node, got --extra-disks={{.disks}}", out.V{"max": pkgdrivers.MaxExtraDisks, "disks": extraDisks})
+			}
+			size, err := util.CalculateSizeInMB(extraDiskSize)
+			if err != nil || size <= 0 {
+				exit.UsageT("Invalid --extra-disk-size: {{.size}}", out.V{"size": extraDiskSize})
+			}
+			n.ExtraDisks = extraDisks
+			n.ExtraDiskSize = size
+		}
+
+		if nodeISOURL != "" && cc.Driver != driver.KVM2 {
+			exit.UsageT("The --iso-url flag is currently only supported by the kvm2 driver")
+		}
+
+		if nodeListenAddr != "" {
+			if cc.Driver != driver.Docker {
+				exit.UsageT("The --listen-address flag is currently only supported by the docker driver")
+			}
+			if err := node.ValidateListenAddress(nodeListenAddr); err != nil {
+				exit.UsageT("Invalid --listen-address: {{.error}}", out.V{"error": err})
+			}
+			if err := node.CheckHostAddress(nodeListenAddr); err != nil {
+				out.WarningT("--listen-address {{.address}} may not be reachable, unless the docker daemon runs on another host: {{.error}}", out.V{"address": nodeListenAddr, "error": err})
+			}
+			if net.ParseIP(nodeListenAddr).IsUnspecified() {
+				out.WarningT("The ports of node {{.name}}, including SSH, will be published on every interface of the host", out.V{"name": n.Name})
+			}
+			n.ListenAddress = nodeListenAddr
+		}
+
+		if nodeGPUs != "" {
+			if nodeGPUs != "all" {
+				exit.UsageT("Invalid --gpus value {{.value}}. Valid values: all", out.V{"value": nodeGPUs})
+			}
+			if cc.Driver != driver.Docker && cc.Driver != driver.KVM2 {
+				exit.UsageT("The --gpus flag is currently only supported by the docker and kvm2 drivers")
+			}
+			n.GPUs = nodeGPUs
+		}
+
+		if nodeArch != "" && nodeArch != runtime.GOARCH {
+			if !isSupportedArch(nodeArch) {
+				exit.UsageT("Invalid --arch value {{.value}}. Valid values: {{.valid}}", out.V{"value": nodeArch, "valid": strings.Join(supportedArchs, ", ")})
+			}
+			if cc.Driver != driver.Docker {
+				exit.UsageT("The --arch flag is currently only supported by the docker driver")
+			}
+			out.WarningT("Node {{.name}} will be emulated as {{.arch}}, which is slower and requires emulation to be set up for docker, as well as a base image built for {{.arch}}", out.V{"name": n.Name, "arch": nodeArch})
+			n.Arch = nodeArch
+		}
+
+		// --force-systemd is stored as the cgroup driver of the node, so that a cluster-wide value does not override it on restart
+		if cmd.Flags().Changed(forceSystemd) {
+			requested := "cgroupfs"
+			if nodeForceSystemd {
+				requested = "systemd"
+			}
+			if n.CgroupDriver != "" && n.CgroupDriver != requested {
+				exit.UsageT("--force-systemd={{.force}} conflicts with --cgroup-driver={{.driver}}", out.V{"force": nodeForceSystemd, "driver": n.CgroupDriver})
+			}
+			n.CgroupDriver = requested
+		}
+
+		// Default to the control plane's cgroup driver so that every node is configured consistently
+		if n.CgroupDriver == "" {
+			n.CgroupDriver = co.CP.Node.CgroupDriver
+		} else {
+			validateCgroupDriver(n.CgroupDriver)
+		}
+
+		// The copy keeps every setting of the node but its role, so that a worker can be cloned from a control plane
+		if src != nil {
+			out.T(out.Copying, "Copying the settings of node {{.name}}", out.V{"name": src.Name})
+			ctrl, work, hostname := n.ControlPlane, n.Worker, n.Hostname
+			n = node.Replacement(*cc, *src)
+			n.Name, n.ControlPlane, n.Worker, n.Hostname = name, ctrl, work, hostname
+			script = n.PostJoinScript
+		}
+
+		if cc.Driver == driver.KVM2 {
+			if n.MinikubeISO == "" {
+				n.MinikubeISO = nodeISO(*cc, co.CP.Host)
+			}
+			if _, err := download.ISO([]string{n.MinikubeISO}, nodeISOURL != ""); err != nil {
+				exit.WithCodeT(exit.Unavailable, "Unable to cache the ISO of node {{.name}}: {{.error}}", out.V{"name": n.Name, "error": err})
+			}
 		}
 
 		// Make sure to decrease the default amount of memory we use per VM if this is the first worker node
@@ -63,7 +368,29 @@ var nodeAddCmd = &cobra.Command{
 			}
 		}
 
-		if err := node.Add(cc, n, false); err != nil {
+		if nodeAddCount > 1 {
+			addNodes(cc, n, src, script, opts)
+			return
+		}
+		if err := node.AddWithOptions(cc, n, false, opts); err != nil {
+			if _, ok := err.(*node.NotReadyError); ok {
+				out.WarningT("Node {{.name}} did not become Ready: {{.error}}", out.V{"name": name, "error": err})
+				showNotReadyDiagnostics(co.API, *cc, n)
+				if viper.GetBool(deleteOnFailure) {
+					deleteFailedNode(cc, n, err)
+				} else {
+					out.T(out.Tip, "Remove the partially added node with 'minikube node delete {{.name}}'", out.V{"name": name})
+				}
+				exit.WithCodeT(exit.Unavailable, "Node {{.name}} was added but is not Ready", out.V{"name": name})
+			}
+			if te, ok := err.(*node.TimeoutError); ok {
+				if viper.GetBool(deleteOnFailure) {
+					deleteFailedNode(cc, n, err)
+				} else {
+					out.T(out.Tip, "Remove the partially added node with 'minikube node delete {{.name}}'", out.V{"name": name})
+				}
+				exit.WithCodeT(exit.Unavailable, "Adding node {{.name}} timed out after {{.timeout}} while {{.phase}}", out.V{"name": name, "timeout": te.Timeout, "phase": te.Phase})
+			}
 			_, err := maybeDeleteAndRetry(*cc, n, nil, err)
 			if err != nil {
 				exit.WithError("failed to add node", err)
@@ -74,15 +401,382 @@ var nodeAddCmd = &cobra.Command{
 			exit.WithError("failed to save config", err)
 		}
 
+		if src != nil {
+			client, err := kapi.Client(cc.Name)
+			if err != nil {
+				exit.WithError("Failed to get kubernetes client", err)
+			}
+			if err := node.CopyTaints(client, bsutil.KubeNodeName(*cc, *src), bsutil.KubeNodeName(*cc, n)); err != nil {
+				out.WarningT("Unable to copy the taints of {{.name}} to {{.new}}: {{.error}}", out.V{"name": src.Name, "new": name, "error": err})
+			}
+		}
+
 		out.T(out.Ready, "Successfully added {{.name}} to {{.cluster}}!", out.V{"name": name, "cluster": cc.Name})
+
+		if script != "" && !runPostJoinScript(*cc, n, script) {
+			exit.WithCodeT(exit.Failure, "Node {{.name}} was added, but its post-join script failed", out.V{"name": name})
+		}
 	},
 }
 
+// nodeISO returns the ISO a kvm node boots from: --iso-url, or else the one the control plane was created from,
+// as restarting the cluster with a newer minikube changes the ISO of the cluster but not of its existing VMs
+func nodeISO(cc config.ClusterConfig, cp *host.Host) string {
+	if nodeISOURL != "" {
+		return nodeISOURL
+	}
+	iso := machine.BootImage(cp)
+	if iso == "" {
+		return cc.MinikubeISO
+	}
+	if u, err := url.Parse(iso); err == nil && u.Scheme == "file" {
+		if _, err := os.Stat(u.Path); err != nil {
+			out.WarningT("The ISO of the control plane is no longer cached, the new node boots from {{.iso}} instead", out.V{"iso": cc.MinikubeISO})
+			return cc.MinikubeISO
+		}
+	}
+	return iso
+}
+
+// postJoinScriptPath returns the absolute path of --post-join-script, exiting if it can not be run
+func postJoinScriptPath() string {
+	if postJoinScript == "" {
+		if persistPostJoin {
+			exit.UsageT("--persist-post-join-script requires --post-join-script")
+		}
+		return ""
+	}
+	p, err := filepath.Abs(postJoinScript)
+	if err != nil {
+		exit.UsageT("Invalid --post-join-script {{.path}}: {{.error}}", out.V{"path": postJoinScript, "error": err})
+	}
+	info, err := os.Stat(p)
+	if err != nil {
+		exit.WithCodeT(exit.BadUsage, "Unable to read {{.path}}: {{.error}}", out.V{"path": p, "error": err})
+	}
+	if info.IsDir() {
+		exit.UsageT("{{.path}} is a directory, the post-join script must be a file", out.V{"path": p})
+	}
+	return p
+}
+
+// runPostJoinScript runs the post-join script on a node which was just added, returning whether it succeeded
+func runPostJoinScript(cc config.ClusterConfig, n config.Node, script string) bool {
+	out.T(out.Running, "Running post-join script {{.script}} on {{.name}} ...", out.V{"script": script, "name": n.Name})
+	if err := node.PostJoin(cc, n, script); err != nil {
+		out.FailureT("The post-join script of {{.name}} failed: {{.error}}", out.V{"name": n.Name, "error": err})
+		return false
+	}
+	return true
+}
+
+// addNodes adds --count copies of a node in parallel, reporting each of them, and exits if any failed
+func addNodes(cc *config.ClusterConfig, tmpl config.Node, src *config.Node, script string, opts node.AddOptions) {
+	// names are picked one after the other, as none of the nodes are part of the config yet
+	named := *cc
+	named.Nodes = append([]config.Node{}, cc.Nodes...)
+	var ns []config.Node
+	for i := 0; i < nodeAddCount; i++ {
+		n := tmpl
+		n.Name = node.NextName(named)
+		named.Nodes = append(named.Nodes, n)
+		ns = append(ns, n)
+	}
+
+	results, err := node.AddConcurrently(cc, ns, false, opts)
+	if err != nil {
+		exit.WithError("failed to add nodes", err)
+	}
+
+	var failed []string
+	for _, r := range results {
+		if r.Err == nil {
+			out.T(out.Ready, "Successfully added {{.name}} to {{.cluster}}!", out.V{"name": r.Node.Name, "cluster": cc.Name})
+			continue
+		}
+		failed = append(failed, r.Node.Name)
+		out.FailureT("Failed to add node {{.name}}: {{.error}}", out.V{"name": r.Node.Name, "error": r.Err})
+		if viper.GetBool(deleteOnFailure) {
+			deleteFailedNode(cc, r.Node, r.Err)
+		} else {
+			out.T(out.Tip, "Remove the partially added node with 'minikube node delete {{.name}}'", out.V{"name": r.Node.Name})
+		}
+	}
+
+	if err := config.SaveProfile(cc.Name, cc); err != nil {
+		exit.WithError("failed to save config", err)
+	}
+
+	if src != nil {
+		client, err := kapi.Client(cc.Name)
+		if err != nil {
+			exit.WithError("Failed to get kubernetes client", err)
+		}
+		for _, r := range results {
+			if r.Err != nil {
+				continue
+			}
+			if err := node.CopyTaints(client, bsutil.KubeNodeName(*cc, *src), bsutil.KubeNodeName(*cc, r.Node)); err != nil {
+				out.WarningT("Unable to copy the taints of {{.name}} to {{.new}}: {{.error}}", out.V{"name": src.Name, "new": r.Node.Name, "error": err})
+			}
+		}
+	}
+
+	var scriptFailed []string
+	for _, r := range results {
+		if script != "" && r.Err == nil && !runPostJoinScript(*cc, r.Node, script) {
+			scriptFailed = append(scriptFailed, r.Node.Name)
+		}
+	}
+
+	if len(failed) > 0 {
+		exit.WithCodeT(exit.Unavailable, "{{.failed}} of {{.count}} nodes could not be added: {{.nodes}}", out.V{"failed": len(failed), "count": len(results), "nodes": strings.Join(failed, ", ")})
+	}
+	if len(scriptFailed) > 0 {
+		exit.WithCodeT(exit.Failure, "The post-join script failed on {{.nodes}}", out.V{"nodes": strings.Join(scriptFailed, ", ")})
+	}
+}
+
+// warnExpiringCerts warns about control plane certificates close to expiry, and exits if some have expired,
+// as joining nodes would then fail with TLS errors
+func warnExpiringCerts(co mustload.ClusterController) {
+	expiring, err := bootstrapper.ExpiringCerts(co.CP.Runner, co.Config.KubernetesConfig, certRenewalWindow)
+	if err != nil {
+		glog.Warningf("unable to check the expiry of certificates: %v", err)
+		return
+	}
+	if len(expiring) == 0 {
+		return
+	}
+
+	expired, expiredCA, renewable := false, false, false
+	for _, c := range expiring {
+		if c.CA {
+			out.WarningT("Certificate authority {{.path}} expires on {{.date}}, renewing it requires recreating the cluster with 'minikube delete' and 'minikube start'", out.V{"path": c.Path, "date": c.NotAfter.Format(time.RFC1123)})
+		} else {
+			out.WarningT("Certificate {{.path}} expires on {{.date}}", out.V{"path": c.Path, "date": c.NotAfter.Format(time.RFC1123)})
+			renewable = true
+		}
+		if c.NotAfter.Before(time.Now()) {
+			expired = true
+			expiredCA = expiredCA || c.CA
+		}
+	}
+	if expiredCA {
+		exit.WithCodeT(exit.Config, "Nodes cannot join a cluster with an expired certificate authority, recreate the cluster with 'minikube delete' and 'minikube start'")
+	}
+	if expired {
+		exit.WithCodeT(exit.Config, "Nodes cannot join a cluster with expired certificates, renew them with 'minikube node add --renew-certs'")
+	}
+	if renewable {
+		out.T(out.Tip, "Renew the certificates with 'minikube node add --renew-certs'")
+	}
+}
+
+// renewControlPlaneCerts renews the certificates of every control plane of a cluster
+func renewControlPlaneCerts(co mustload.ClusterController) {
+	for _, n := range co.Config.Nodes {
+		if !n.ControlPlane {
+			continue
+		}
+		out.T(out.Resetting, "Renewing the certificates of {{.name}} ...", out.V{"name": n.Name})
+		h, err := machine.LoadHost(co.API, driver.MachineName(*co.Config, n))
+		if err != nil {
+			exit.WithError("Error loading host", err)
+		}
+		r, err := machine.CommandRunner(h)
+		if err != nil {
+			exit.WithError("Failed to get command runner", err)
+		}
+		bs, err := cluster.Bootstrapper(co.API, viper.GetString(cmdcfg.Bootstrapper), *co.Config, r)
+		if err != nil {
+			exit.WithError("Error getting cluster bootstrapper", err)
+		}
+		if err := bs.RenewCerts(*co.Config, n); err != nil {
+			exit.WithError("Failed to renew certificates", err)
+		}
+	}
+}
+
+// nodeResources returns the CPUs and memory in MB requested for the new node, resolving relative sizes on the host.
+// 0 means the cluster-wide value.
+func nodeResources(drvName string) (int, int) {
+	var ncpus, mem int
+	if nodeCPUs != "" {
+		n, err := parseCPUs(drvName, nodeCPUs)
+		if err != nil {
+			exit.UsageT("Invalid --cpus {{.cpus}}: {{.error}}", out.V{"cpus": nodeCPUs, "error": err})
+		}
+		if n < minimumCPUS {
+			exit.UsageT("Requested cpu count {{.requested_cpus}} is less than the minimum allowed of {{.minimum_cpus}}", out.V{"requested_cpus": n, "minimum_cpus": minimumCPUS})
+		}
+		ncpus = n
+	}
+	if nodeMemory != "" {
+		m, err := parseMemory(drvName, nodeMemory)
+		if err != nil {
+			exit.UsageT("Invalid --memory {{.memory}}: {{.error}}", out.V{"memory": nodeMemory, "error": err})
+		}
+		if m < minUsableMem {
+			exit.UsageT("Requested memory allocation {{.requested}}MB is less than the usable minimum of {{.minimum}}MB", out.V{"requested": m, "minimum": minUsableMem})
+		}
+		mem = m
+	}
+	if isRelativeSize(nodeCPUs) || isRelativeSize(nodeMemory) {
+		glog.Infof("resolved --cpus=%q --memory=%q to %d CPUs and %dMB", nodeCPUs, nodeMemory, ncpus, mem)
+	}
+	return ncpus, mem
+}
+
+// halfJoinedNodes returns the nodes of a cluster whose join was interrupted, warning if they can not be determined
+func halfJoinedNodes(cc config.ClusterConfig) []config.Node {
+	client, err := kapi.Client(cc.Name)
+	if err != nil {
+		glog.Warningf("unable to get kubernetes client: %v", err)
+		return nil
+	}
+	half, err := node.HalfJoined(cc, client)
+	if err != nil {
+		glog.Warningf("unable to find half joined nodes: %v", err)
+		return nil
+	}
+	return half
+}
+
+// repairNodes completes the join of half joined nodes instead of adding a new node
+func repairNodes(cc *config.ClusterConfig, half []config.Node, opts node.AddOptions) {
+	if len(half) == 0 {
+		out.T(out.Happy, "Every node of cluster {{.cluster}} has joined, there is nothing to repair", out.V{"cluster": cc.Name})
+		return
+	}
+
+	for _, n := range half {
+		out.T(out.Resetting, "Repairing node {{.name}} ...", out.V{"name": n.Name})
+		if err := node.Repair(cc, n, opts); err != nil {
+			out.T(out.Tip, "Remove the node with 'minikube node delete {{.name}}' and add it again", out.V{"name": n.Name})
+			exit.WithCodeT(exit.Unavailable, "Failed to repair node {{.name}}: {{.error}}", out.V{"name": n.Name, "error": err})
+		}
+		out.T(out.Ready, "Successfully repaired {{.name}} in {{.cluster}}!", out.V{"name": n.Name, "cluster": cc.Name})
+	}
+
+	if err := config.SaveProfile(cc.Name, cc); err != nil {
+		exit.WithError("failed to save config", err)
+	}
+}
+
+// raiseVerbosity raises the log verbosity for the rest of the add, so that loading and checking the health of the
+// cluster beforehand are still logged at the global -v. The glog verbosity is process-wide, so it applies to everything
+// logged until the returned func restores the previous verbosity, which does not happen when the add exits early.
+func raiseVerbosity(level int) func() {
+	if level < 0 {
+		exit.UsageT("Invalid --node-verbosity: {{.level}} is negative", out.V{"level": level})
+	}
+	noop := func() {}
+	v := pflag.Lookup("v")
+	if v == nil {
+		return noop
+	}
+	previous := v.Value.String()
+	current, err := strconv.Atoi(previous)
+	if err != nil {
+		glog.Warningf("unable to parse log verbosity %q: %v", previous, err)
+		return noop
+	}
+	if level <= current {
+		return noop
+	}
+	if err := v.Value.Set(strconv.Itoa(level)); err != nil {
+		glog.Warningf("unable to set log verbosity to %d: %v", level, err)
+		return noop
+	}
+	glog.Infof("raised log verbosity from %d to %d to provision the node", current, level)
+	return func() {
+		if err := v.Value.Set(previous); err != nil {
+			glog.Warningf("unable to restore log verbosity to %s: %v", previous, err)
+		}
+	}
+}
+
+// nodeAddOptions returns the settings of the add which are not persisted with the node
+func nodeAddOptions() node.AddOptions {
+	opts := node.AddOptions{Timeout: nodeAddTimeout, CNIOnly: nodeAddCNIOnly, ReadyTimeout: nodeReadyTimeout, Progress: nodeShowProgress}
+	for _, s := range nodeWaitForPods {
+		sel, err := kverify.ParsePodSelector(s)
+		if err != nil {
+			exit.UsageT("Invalid --wait-for-pods: {{.error}}", out.V{"error": err})
+		}
+		opts.WaitForPods = append(opts.WaitForPods, sel)
+	}
+	return opts
+}
+
+// showNotReadyDiagnostics prints the conditions and kubelet logs of a node which did not become Ready
+func showNotReadyDiagnostics(api libmachine.API, cc config.ClusterConfig, n config.Node) {
+	client, err := kapi.Client(cc.Name)
+	if err != nil {
+		glog.Warningf("unable to get kubernetes client: %v", err)
+	} else if conds, err := node.Conditions(client, bsutil.KubeNodeName(cc, n)); err != nil {
+		glog.Warningf("unable to get node conditions: %v", err)
+	} else {
+		out.T(out.Issues, "Conditions of node {{.name}}:", out.V{"name": n.Name})
+		out.String("%s", conds)
+	}
+
+	logs, err := node.KubeletLogs(api, cc, n, 50)
+	if err != nil {
+		glog.Warningf("unable to get kubelet logs: %v", err)
+		return
+	}
+	out.T(out.LogEntry, "Last kubelet logs of node {{.name}}:", out.V{"name": n.Name})
+	out.String("%s", logs)
+}
+
 func init() {
 	// TODO(https://github.com/kubernetes/minikube/issues/7366): We should figure out which minikube start flags to actually import
+	nodeAddCmd.Flags().StringArrayVar(&nodeExtendedRes, "extended-resource", nil, "Extended resources to advertise in the capacity of the new node, such as example.com/widget=4, reapplied whenever the node starts. (format: name=quantity)")
+	nodeAddCmd.Flags().StringArrayVar(&nodeAnnotations, "annotations", nil, "Annotations to set on the new node, reapplied whenever the node starts. (format: key=value)")
+	nodeAddCmd.Flags().IntVar(&nodeAddCount, "count", 1, "The number of workers to add, provisioned in parallel. The cluster may not grow past the --max-nodes given to 'minikube start'.")
+	nodeAddCmd.Flags().StringVar(&nodeFromNode, "from-node", "", "The node to copy the settings of, such as its labels, taints, annotations, sysctls, kubelet args and cgroup driver. The new node is still added as a worker.")
+	nodeAddCmd.Flags().StringVar(&nodeDriver, "driver", "", "The driver of the new node, which must be the driver of the cluster. Defaults to the cluster's driver.")
+	nodeAddCmd.Flags().BoolVar(&nodeRepair, "repair", false, "If set, complete the join of nodes whose 'minikube node add' was interrupted, instead of adding a node. Their machines are started or recreated as needed.")
+	nodeAddCmd.Flags().BoolVar(&nodeRenewCerts, "renew-certs", false, "If set, renew the certificates of the control planes before adding the node, keeping the certificate authorities. Otherwise certificates expiring within 30 days are reported.")
 	nodeAddCmd.Flags().BoolVar(&cp, "control-plane", false, "If true, the node added will also be a control plane in addition to a worker.")
 	nodeAddCmd.Flags().BoolVar(&worker, "worker", true, "If true, the added node will be marked for work. Defaults to true.")
-	nodeAddCmd.Flags().Bool(deleteOnFailure, false, "If set, delete the current cluster if start fails and try again. Defaults to false.")
+	if err := nodeAddCmd.Flags().MarkDeprecated("control-plane", "minikube clusters have a single control plane, nodes are always added as workers"); err != nil {
+		exit.WithError("unable to mark flag as deprecated", err)
+	}
+	if err := nodeAddCmd.Flags().MarkDeprecated("worker", "nodes are always added as workers"); err != nil {
+		exit.WithError("unable to mark flag as deprecated", err)
+	}
+	nodeAddCmd.Flags().Bool(deleteOnFailure, false, "If set, delete the current cluster if start fails and try again. If --timeout is exceeded, only the new node is deleted. Defaults to false.")
+	nodeAddCmd.Flags().BoolVar(&nodeAddCNIOnly, "wait-for-cni-only", false, "If set, return as soon as the node is registered and its CNI pod is Ready, without waiting for the other system pods on the node.")
+	nodeAddCmd.Flags().StringArrayVar(&nodeWaitForPods, "wait-for-pods", nil, "Pods which must be Ready on the new node before returning, on top of its system pods. May be repeated. (format: namespace=kube-system,k8s-app=kube-proxy)")
+	nodeAddCmd.Flags().DurationVar(&nodeReadyTimeout, "ready-timeout", 0, "Maximum time to wait for the new node and its system pods to be Ready, printing its conditions and kubelet logs when exceeded. Defaults to --wait-timeout.")
+	nodeAddCmd.Flags().BoolVar(&nodeShowProgress, "show-progress", true, "If true, report each step of the add along with its progress, such as 'node m03: 35% - joining the cluster', as text.")
+	nodeAddCmd.Flags().DurationVar(&nodeAddTimeout, "timeout", 0, "Maximum time to wait for the node to be added, naming the step which stalled when exceeded. 0 waits forever.")
+	nodeAddCmd.Flags().StringVar(&nodeCgroupDriver, cgroupDriver, "", "The cgroup driver shared by the kubelet and container runtime on the new node. Defaults to the control plane's driver.")
+	nodeAddCmd.Flags().BoolVar(&nodeForceSystemd, forceSystemd, false, "If true, force the container runtime on the new node to use systemd as cgroup manager, if false to use cgroupfs. Defaults to the control plane's cgroup driver.")
+	nodeAddCmd.Flags().IntVar(&extraDisks, "extra-disks", 0, "Number of additional raw disks to attach to the new node, at most 25 (kvm2 driver only).")
+	nodeAddCmd.Flags().StringVar(&extraDiskSize, "extra-disk-size", "20000mb", "Size of each additional disk (format: <number>[<unit>], where unit = b, k, m or g).")
+	nodeAddCmd.Flags().StringVar(&nodeISOURL, isoURL, "", "The location of the ISO the new node boots from (kvm2 driver only). Defaults to the ISO the control plane was created from.")
+	nodeAddCmd.Flags().StringVar(&nodeListenAddr, "listen-address", "", "The host address the ports of the new node, such as SSH, are published on (docker driver only). Defaults to 127.0.0.1.")
+	nodeAddCmd.Flags().StringVar(&nodeArch, "arch", "", fmt.Sprintf("The CPU architecture of the new node, emulated if it differs from the host. The kubelet labels the node with kubernetes.io/arch. Valid values: %s (docker driver only)", strings.Join(supportedArchs, ", ")))
+	nodeAddCmd.Flags().StringVar(&nodePauseImage, "pause-image", "", "The pause (sandbox) image of the new node, set in the configuration of its container runtime and kubelet. Defaults to the pause image of the Kubernetes version.")
+	nodeAddCmd.Flags().StringVar(&nodeLogMaxSize, containerLogMaxSize, "", "The size a container log is rotated at by the kubelet of the new node, e.g. 10Mi. Reapplied whenever the node starts. Defaults to the cluster-wide value. Not supported with the docker runtime.")
+	nodeAddCmd.Flags().IntVar(&nodeLogMaxFiles, containerLogMaxFiles, 0, "The number of log files kept per container by the kubelet of the new node, at least 2. Reapplied whenever the node starts. Defaults to the cluster-wide value. Not supported with the docker runtime.")
+	nodeAddCmd.Flags().StringVar(&nodeHostname, "hostname", "", "The hostname of the new node, which is also its Kubernetes node name. Defaults to the machine name.")
+	nodeAddCmd.Flags().BoolVar(&nodeRegisterDNS, "register-node-dns", false, "If true, make the hostname of the new node resolvable from pods through CoreDNS, updated whenever the node starts and removed when it is deleted.")
+	nodeAddCmd.Flags().BoolVar(&nodeMount, createMount, false, "If true, mount --mount-string into the new node whenever it starts.")
+	nodeAddCmd.Flags().StringVar(&nodeMountString, mountString, constants.DefaultMountDir+":/minikube-host", "The directory to mount into the new node with --mount. (format: <source directory>:<target directory>)")
+	nodeAddCmd.Flags().StringVar(&nodeGPUs, "gpus", "", "GPUs to pass through to the new node, which is labelled and gets the nvidia-gpu-device-plugin addon scheduled on it. Valid values: all (docker and kvm2 drivers only)")
+	nodeAddCmd.Flags().StringArrayVar(&kubeletExtraArgs, "kubelet-extra-args", nil, "Kubelet flags to set on the new node, taking precedence over --extra-config. Reapplied whenever the node starts. (format: --flag=value)")
+	nodeAddCmd.Flags().StringArrayVar(&runtimeConfig, "runtime-config", nil, "Container runtime settings of the new node, reapplied whenever it starts. Supported by containerd and cri-o. (format: runtime.key=value, such as containerd.max_concurrent_downloads=10)")
+	nodeAddCmd.Flags().StringVar(&nodeCPUs, cpus, "", "Number of CPUs of the new node. Use \"max\" or \"no-limit\" for every CPU available to the driver, or a percentage of them such as \"50%\". Defaults to the cluster-wide value.")
+	nodeAddCmd.Flags().StringVar(&nodeMemory, memory, "", "Amount of RAM of the new node (format: <number>[<unit>], where unit = b, k, m or g). Use \"max\" or \"no-limit\" for all the memory available to the driver, or a percentage of it such as \"50%\". Defaults to the cluster-wide value.")
+	nodeAddCmd.Flags().IntVar(&nodeVerbosity, "node-verbosity", 0, "Log verbosity from the start of provisioning the new node, such as 8 for the most detail, leaving loading and checking the cluster at -v. The verbosity is process-wide, so every component logging during the rest of the add logs at this level. Only raises the verbosity above -v.")
+	nodeAddCmd.Flags().StringVar(&postJoinScript, "post-join-script", "", "A local script to run as root on the new node once it has joined. The profile, node name and role are passed as MINIKUBE_PROFILE, MINIKUBE_NODE_NAME and MINIKUBE_NODE_ROLE.")
+	nodeAddCmd.Flags().BoolVar(&persistPostJoin, "persist-post-join-script", false, "If true, save --post-join-script with the node and run it again whenever the node restarts.")
+	nodeAddCmd.Flags().StringArrayVar(&nodeSysctls, sysctl, nil, "Kernel parameters to set on the new node, in addition to the cluster-wide ones. (format: key=value)")
 
 	nodeCmd.AddCommand(nodeAddCmd)
 }
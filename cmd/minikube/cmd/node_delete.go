@@ -17,18 +17,26 @@ limitations under the License.
 package cmd
 
 import (
+	"os"
+
+	"github.com/golang/glog"
 	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/drivers/kic/oci"
+	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/mustload"
 	"k8s.io/minikube/pkg/minikube/node"
 	"k8s.io/minikube/pkg/minikube/out"
 )
 
+var nodePurge bool
+
 var nodeDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Deletes a node from a cluster.",
-	Long:  "Deletes a node from a cluster.",
+	Long:  "Deletes a node from a cluster. With --purge, also removes any volumes and machine files left behind by the driver for that node.",
 	Run: func(cmd *cobra.Command, args []string) {
 
 		if len(args) == 0 {
@@ -44,15 +52,57 @@ var nodeDeleteCmd = &cobra.Command{
 			exit.WithError("deleting node", err)
 		}
 
+		machineName := driver.MachineName(*co.Config, *n)
+		if n.Mount != "" {
+			if err := killMountPid(node.MountPidPath(machineName)); err != nil {
+				out.WarningT("Unable to kill mount process: {{.error}}", out.V{"error": err})
+			}
+		}
 		if driver.IsKIC(co.Config.Driver) {
-			machineName := driver.MachineName(*co.Config, *n)
 			deletePossibleKicLeftOver(machineName, co.Config.Driver)
 		}
 
+		if nodePurge {
+			purgeNodeArtifacts(*co.Config, machineName)
+		}
+
 		out.T(out.Deleted, "Node {{.name}} was successfully deleted.", out.V{"name": name})
 	},
 }
 
+// purgeNodeArtifacts removes driver artifacts of a deleted node which may outlive the machine itself
+func purgeNodeArtifacts(cc config.ClusterConfig, machineName string) {
+	var ociBin string
+	switch cc.Driver {
+	case driver.Docker:
+		ociBin = oci.Docker
+	case driver.Podman:
+		ociBin = oci.Podman
+	}
+
+	// volumes which existed before the node was created are not labelled, so delete the volume by name
+	if ociBin != "" {
+		deleted, err := oci.DeleteVolume(ociBin, machineName)
+		if err != nil {
+			out.WarningT("Unable to delete volume {{.name}}: {{.error}}", out.V{"name": machineName, "error": err})
+		} else if deleted {
+			out.T(out.Deleted, "Removed volume {{.name}}", out.V{"name": machineName})
+		}
+	}
+
+	dir := localpath.MachinePath(machineName)
+	if _, err := os.Stat(dir); err != nil {
+		glog.Infof("no machine directory to purge for %s: %v", machineName, err)
+		return
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		out.WarningT("Unable to remove machine directory {{.path}}: {{.error}}", out.V{"path": dir, "error": err})
+		return
+	}
+	out.T(out.Deleted, "Removed machine directory {{.path}}", out.V{"path": dir})
+}
+
 func init() {
+	nodeDeleteCmd.Flags().BoolVar(&nodePurge, "purge", false, "Also remove any volumes and machine files left behind by the driver for the deleted node.")
 	nodeCmd.AddCommand(nodeDeleteCmd)
 }
@@ -0,0 +1,219 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"text/template"
+
+	"github.com/docker/machine/libmachine"
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/cruntime"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+)
+
+// NodeDescription holds the effective configuration of a node
+type NodeDescription struct {
+	Name              string
+	Machine           string
+	IP                string
+	ControlPlane      bool
+	Worker            bool
+	KubernetesVersion string
+	Arch              string
+	CPUs              int
+	Memory            int
+	CgroupDriver      string
+	RegistryMirrors   []string
+	RuntimeConfig     []string
+	Sysctls           []string
+	ExtraDisks        int
+	ExtraDiskSize     int
+	Eviction          []string
+	KubeletArgs       []string
+	GPUs              string
+	Mount             string
+	Annotations       []string
+	Labels            []string
+}
+
+const nodeDescribeFormat = `Name:               {{.Name}}
+Machine:            {{.Machine}}
+IP:                 {{.IP}}
+Control Plane:      {{.ControlPlane}}
+Worker:             {{.Worker}}
+Kubernetes Version: {{.KubernetesVersion}}
+Architecture:       {{.Arch}}
+CPUs:               {{.CPUs}}
+Memory:             {{.Memory}}MB
+Cgroup Driver:      {{.CgroupDriver}}
+Registry Mirrors:   {{range $i, $m := .RegistryMirrors}}{{if $i}}, {{end}}{{$m}}{{else}}none{{end}}
+Runtime Config:     {{range $i, $c := .RuntimeConfig}}{{if $i}}, {{end}}{{$c}}{{else}}none{{end}}
+Sysctls:            {{range $i, $s := .Sysctls}}{{if $i}}, {{end}}{{$s}}{{else}}none{{end}}
+Extra Disks:        {{if .ExtraDisks}}{{.ExtraDisks}} x {{.ExtraDiskSize}}MB{{else}}none{{end}}
+Eviction:           {{range $i, $e := .Eviction}}{{if $i}}, {{end}}{{$e}}{{else}}default{{end}}
+Kubelet Args:       {{range $i, $a := .KubeletArgs}}{{if $i}} {{end}}{{$a}}{{else}}none{{end}}
+GPUs:               {{if .GPUs}}{{.GPUs}}{{else}}none{{end}}
+Mount:              {{if .Mount}}{{.Mount}}{{else}}none{{end}}
+Annotations:        {{range $i, $a := .Annotations}}{{if $i}}, {{end}}{{$a}}{{else}}none{{end}}
+Labels:             {{range $i, $l := .Labels}}{{if $i}}, {{end}}{{$l}}{{else}}none{{end}}
+`
+
+var nodeDescribeCmd = &cobra.Command{
+	Use:   "describe",
+	Short: "Describes a node in a cluster.",
+	Long:  "Describes the effective configuration of a node in a cluster.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			exit.UsageT("Usage: minikube node describe [name]")
+		}
+
+		api, cc := mustload.Partial(ClusterFlagValue())
+		name := args[0]
+
+		n, _, err := node.Retrieve(*cc, name)
+		if err != nil {
+			exit.WithError("retrieving node", err)
+		}
+
+		if err := nodeDescribeText(describeNode(api, *cc, *n), os.Stdout); err != nil {
+			exit.WithError("node describe failure", err)
+		}
+	},
+}
+
+// describeNode returns the effective configuration of a node, querying the node itself if it is running
+func describeNode(api libmachine.API, cc config.ClusterConfig, n config.Node) *NodeDescription {
+	machineName := driver.MachineName(cc, n)
+	d := &NodeDescription{
+		Name:              n.Name,
+		Machine:           machineName,
+		IP:                n.IP,
+		ControlPlane:      n.ControlPlane,
+		Worker:            n.Worker,
+		KubernetesVersion: n.KubernetesVersion,
+		Arch:              bsutil.NodeArch(n),
+		CPUs:              cc.CPUs,
+		Memory:            cc.Memory,
+		CgroupDriver:      n.CgroupDriver,
+		RegistryMirrors:   cc.RegistryMirror,
+		RuntimeConfig:     n.RuntimeConfig,
+		Sysctls:           node.Sysctls(cc, n),
+		ExtraDisks:        n.ExtraDisks,
+		ExtraDiskSize:     n.ExtraDiskSize,
+		Eviction:          evictionOptions(cc),
+		KubeletArgs:       n.KubeletExtraArgs,
+		GPUs:              n.GPUs,
+		Mount:             n.Mount,
+		Annotations:       n.Annotations,
+		Labels:            n.Labels,
+	}
+
+	if n.CPUs > 0 {
+		d.CPUs = n.CPUs
+	}
+	if n.Memory > 0 {
+		d.Memory = n.Memory
+	}
+
+	if !machine.IsRunning(api, machineName) {
+		glog.Infof("%s is not running, describing configured values only", machineName)
+		return d
+	}
+
+	h, err := machine.LoadHost(api, machineName)
+	if err != nil {
+		glog.Warningf("unable to load host %s: %v", machineName, err)
+		return d
+	}
+
+	r, err := machine.CommandRunner(h)
+	if err != nil {
+		glog.Warningf("unable to get command runner for %s: %v", machineName, err)
+		return d
+	}
+
+	describeRuntime(d, cc, r)
+	describeSysctls(d, r)
+	return d
+}
+
+// evictionOptions returns the kubelet eviction options which apply to every node of a cluster
+func evictionOptions(cc config.ClusterConfig) []string {
+	var opts []string
+	for _, eo := range cc.KubernetesConfig.ExtraOptions {
+		if bsutil.IsKubeletEvictionOption(eo) {
+			opts = append(opts, eo.Key+"="+eo.Value)
+		}
+	}
+	return opts
+}
+
+// describeRuntime fills in the values reported by the container runtime on the node
+func describeRuntime(d *NodeDescription, cc config.ClusterConfig, r command.Runner) {
+	cr, err := cruntime.New(cruntime.Config{Type: cc.KubernetesConfig.ContainerRuntime, Runner: r})
+	if err != nil {
+		glog.Warningf("unable to create runtime for %s: %v", d.Machine, err)
+		return
+	}
+
+	cg, err := cr.CGroupDriver()
+	if err != nil {
+		glog.Warningf("unable to detect cgroup driver for %s: %v", d.Machine, err)
+		return
+	}
+	d.CgroupDriver = cg
+}
+
+// describeSysctls replaces the configured sysctl values with the ones in effect on the node
+func describeSysctls(d *NodeDescription, r command.Runner) {
+	for i, s := range d.Sysctls {
+		key := strings.SplitN(s, "=", 2)[0]
+		rr, err := r.RunCmd(exec.Command("sysctl", "-n", key))
+		if err != nil {
+			glog.Warningf("unable to read sysctl %s on %s: %v", key, d.Machine, err)
+			continue
+		}
+		d.Sysctls[i] = key + "=" + strings.TrimSpace(rr.Stdout.String())
+	}
+}
+
+func nodeDescribeText(d *NodeDescription, w io.Writer) error {
+	if d.CgroupDriver == "" {
+		d.CgroupDriver = "default"
+	}
+	tmpl, err := template.New("node-describe").Parse(nodeDescribeFormat)
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(w, d)
+}
+
+func init() {
+	nodeCmd.AddCommand(nodeDescribeCmd)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestNodeDescribeText(t *testing.T) {
+	var tests = []struct {
+		name string
+		desc *NodeDescription
+		want string
+	}{
+		{
+			name: "worker",
+			desc: &NodeDescription{Name: "m02", Machine: "minikube-m02", IP: "192.168.39.3", Worker: true, KubernetesVersion: "v1.18.3", Arch: "arm64", CPUs: 4, Memory: 8000, CgroupDriver: "systemd", RegistryMirrors: []string{"https://mirror.gcr.io", "http://localhost:5000"}, RuntimeConfig: []string{"containerd.max_concurrent_downloads=10"}, Sysctls: []string{"vm.max_map_count=262144", "fs.file-max=100000"}, ExtraDisks: 2, ExtraDiskSize: 10240, Eviction: []string{"eviction-hard=memory.available<200Mi"}, KubeletArgs: []string{"--system-reserved=cpu=500m", "--max-pods=50"}, GPUs: "all", Mount: "/home/user:/minikube-host", Annotations: []string{"example.com/zone=a"}, Labels: []string{"disktype=ssd", "rack=1"}},
+			want: "Name:               m02\nMachine:            minikube-m02\nIP:                 192.168.39.3\nControl Plane:      false\nWorker:             true\nKubernetes Version: v1.18.3\nArchitecture:       arm64\nCPUs:               4\nMemory:             8000MB\nCgroup Driver:      systemd\nRegistry Mirrors:   https://mirror.gcr.io, http://localhost:5000\nRuntime Config:     containerd.max_concurrent_downloads=10\nSysctls:            vm.max_map_count=262144, fs.file-max=100000\nExtra Disks:        2 x 10240MB\nEviction:           eviction-hard=memory.available<200Mi\nKubelet Args:       --system-reserved=cpu=500m --max-pods=50\nGPUs:               all\nMount:              /home/user:/minikube-host\nAnnotations:        example.com/zone=a\nLabels:             disktype=ssd, rack=1\n",
+		},
+		{
+			name: "unknown cgroup driver",
+			desc: &NodeDescription{Name: "m01", Machine: "minikube", IP: "192.168.39.2", ControlPlane: true, Worker: true, KubernetesVersion: "v1.18.3", Arch: "amd64", CPUs: 2, Memory: 2200},
+			want: "Name:               m01\nMachine:            minikube\nIP:                 192.168.39.2\nControl Plane:      true\nWorker:             true\nKubernetes Version: v1.18.3\nArchitecture:       amd64\nCPUs:               2\nMemory:             2200MB\nCgroup Driver:      default\nRegistry Mirrors:   none\nRuntime Config:     none\nSysctls:            none\nExtra Disks:        none\nEviction:           default\nKubelet Args:       none\nGPUs:               none\nMount:              none\nAnnotations:        none\nLabels:             none\n",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := nodeDescribeText(tc.desc, &b); err != nil {
+				t.Errorf("text(%+v) error: %v", tc.desc, err)
+			}
+
+			got := b.String()
+			if got != tc.want {
+				t.Errorf("text(%+v) = %q, want: %q", tc.desc, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEvictionOptions(t *testing.T) {
+	cc := config.ClusterConfig{KubernetesConfig: config.KubernetesConfig{ExtraOptions: config.ExtraOptionSlice{
+		{Component: "kubelet", Key: "eviction-hard", Value: "memory.available<200Mi"},
+		{Component: "kubelet", Key: "max-pods", Value: "50"},
+		{Component: "apiserver", Key: "eviction-hard", Value: "ignored"},
+	}}}
+
+	got := evictionOptions(cc)
+	want := []string{"eviction-hard=memory.available<200Mi"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("evictionOptions() = %v, want: %v", got, want)
+	}
+}
+
+func TestDescribeSysctls(t *testing.T) {
+	r := command.NewFakeCommandRunner()
+	r.SetCommandToOutput(map[string]string{
+		"sysctl -n vm.max_map_count": "262144\n",
+	})
+
+	d := &NodeDescription{Machine: "minikube", Sysctls: []string{"vm.max_map_count=65530", "fs.file-max=100000"}}
+	describeSysctls(d, r)
+
+	want := []string{"vm.max_map_count=262144", "fs.file-max=100000"}
+	if !reflect.DeepEqual(d.Sysctls, want) {
+		t.Errorf("describeSysctls() = %v, want: %v", d.Sysctls, want)
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+var (
+	drainGracePeriod time.Duration
+	drainForce       bool
+	drainEmptyDir    bool
+)
+
+var nodeDrainAndDeleteCmd = &cobra.Command{
+	Use:   "drain-and-delete",
+	Short: "Drains a node, then deletes it from a cluster.",
+	Long:  "Cordons a node and evicts its pods, respecting pod disruption budgets, then deletes the node and its machine from the cluster.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			exit.UsageT("Usage: minikube node drain-and-delete [name]")
+		}
+		name := args[0]
+
+		cname := ClusterFlagValue()
+		co := mustload.Healthy(cname)
+
+		n, _, err := node.Retrieve(*co.Config, name)
+		if err != nil {
+			exit.WithError("retrieving node", err)
+		}
+		if n.ControlPlane {
+			exit.UsageT("Node {{.name}} is a control plane and can not be drained and deleted", out.V{"name": name})
+		}
+
+		client, err := kapi.Client(cname)
+		if err != nil {
+			exit.WithError("Failed to get kubernetes client", err)
+		}
+
+		machineName := driver.MachineName(*co.Config, *n)
+		kubeName := bsutil.KubeNodeName(*co.Config, *n)
+		out.T(out.Pause, "Cordoning node {{.name}} ...", out.V{"name": name})
+		if err := node.Cordon(client, kubeName); err != nil {
+			exit.WithError("cordoning node", err)
+		}
+
+		out.T(out.Waiting, "Draining node {{.name}}, waiting up to {{.grace}} ...", out.V{"name": name, "grace": drainGracePeriod})
+		stuck, err := node.DrainWithOptions(client, kubeName, drainGracePeriod, node.DrainOptions{DeleteEmptyDir: drainEmptyDir, Force: drainForce})
+		if err != nil {
+			exit.WithError("draining node", err)
+		}
+		if len(stuck) > 0 {
+			out.WarningT("These pods are still running on {{.name}}: {{.pods}}", out.V{"name": name, "pods": strings.Join(stuck, ", ")})
+			if !drainForce {
+				exit.WithCodeT(exit.Unavailable, "Node {{.name}} was cordoned but not deleted. Use --force to delete it anyway.", out.V{"name": name})
+			}
+		}
+
+		if err := client.CoreV1().Nodes().Delete(kubeName, &meta.DeleteOptions{}); err != nil {
+			glog.Warningf("unable to delete Kubernetes node %s: %v", kubeName, err)
+		}
+
+		out.T(out.DeletingHost, "Deleting node {{.name}} from cluster {{.cluster}}", out.V{"name": name, "cluster": co.Config.Name})
+		if _, err := node.Delete(*co.Config, name); err != nil {
+			exit.WithError("deleting node", err)
+		}
+
+		if driver.IsKIC(co.Config.Driver) {
+			deletePossibleKicLeftOver(machineName, co.Config.Driver)
+		}
+
+		out.T(out.Deleted, "Node {{.name}} was successfully drained and deleted.", out.V{"name": name})
+	},
+}
+
+func init() {
+	nodeDrainAndDeleteCmd.Flags().DurationVar(&drainGracePeriod, "grace-period", 60*time.Second, "How long to wait for pods to be evicted and to terminate.")
+	nodeDrainAndDeleteCmd.Flags().BoolVar(&drainForce, "force", false, "Also evict pods not managed by a controller, and delete the node even if some pods could not be evicted.")
+	nodeDrainAndDeleteCmd.Flags().BoolVar(&drainEmptyDir, "delete-emptydir-data", false, "Also evict pods using emptyDir volumes, deleting their data.")
+	nodeCmd.AddCommand(nodeDrainAndDeleteCmd)
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/docker/machine/libmachine"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+var (
+	nodeExecOutput   string
+	nodeExecAllNodes bool
+)
+
+// ExecResult is the structured result of a command run on a node
+type ExecResult struct {
+	Node     string
+	Command  []string
+	Stdout   string
+	Stderr   string
+	ExitCode int
+}
+
+var nodeExecCmd = &cobra.Command{
+	Use:   "exec",
+	Short: "Runs a command on a node.",
+	Long:  "Runs a non-interactive command on a node, exiting with the exit code of the remote command. With --all-nodes the command runs on every running node in parallel, and each line of output is prefixed with the node name.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if nodeExecAllNodes && len(args) < 1 {
+			exit.UsageT("Usage: minikube node exec --all-nodes -- [command]")
+		}
+		if !nodeExecAllNodes && len(args) < 2 {
+			exit.UsageT("Usage: minikube node exec [name] -- [command]")
+		}
+
+		output := strings.ToLower(nodeExecOutput)
+		if output != "text" && output != "json" {
+			exit.WithCodeT(exit.BadUsage, fmt.Sprintf("invalid output format: %s. Valid values: 'text', 'json'", nodeExecOutput))
+		}
+
+		api, cc := mustload.Partial(ClusterFlagValue())
+		if nodeExecAllNodes {
+			os.Exit(execAllNodes(api, *cc, args, output))
+		}
+		name := args[0]
+
+		n, _, err := node.Retrieve(*cc, name)
+		if err != nil {
+			exit.WithError("retrieving node", err)
+		}
+
+		machineName := driver.MachineName(*cc, *n)
+		if !machine.IsRunning(api, machineName) {
+			exit.WithCodeT(exit.Unavailable, "Node {{.name}} is not running.", out.V{"name": name})
+		}
+
+		h, err := machine.LoadHost(api, machineName)
+		if err != nil {
+			exit.WithError("loading host", err)
+		}
+
+		r, err := machine.CommandRunner(h)
+		if err != nil {
+			exit.WithError("getting command runner", err)
+		}
+
+		c := exec.Command(args[1], args[2:]...)
+		if output == "text" {
+			c.Stdout = os.Stdout
+			c.Stderr = os.Stderr
+		}
+
+		rr, err := r.RunCmd(c)
+		// A zero exit code with an error means the command never ran on the node
+		if err != nil && rr.ExitCode == 0 {
+			exit.WithError("running command", err)
+		}
+
+		if output == "json" {
+			if err := nodeExecJSON(execResult(name, rr), os.Stdout); err != nil {
+				exit.WithError("node exec json failure", err)
+			}
+		}
+		os.Exit(rr.ExitCode)
+	},
+}
+
+// execAllNodes runs a command on every running node in parallel, returning the aggregate exit code
+func execAllNodes(api libmachine.API, cc config.ClusterConfig, args []string, output string) int {
+	var nodes []config.Node
+	for _, n := range cc.Nodes {
+		if !machine.IsRunning(api, driver.MachineName(cc, n)) {
+			out.WarningT("Skipping node {{.name}}: not running", out.V{"name": n.Name})
+			continue
+		}
+		nodes = append(nodes, n)
+	}
+	if len(nodes) == 0 {
+		exit.WithCodeT(exit.Unavailable, "No running nodes to run the command on")
+	}
+
+	results := make([]ExecResult, len(nodes))
+	var wg sync.WaitGroup
+	for i := range nodes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = execOnNode(api, cc, nodes[i], args)
+		}(i)
+	}
+	wg.Wait()
+
+	if output == "json" {
+		js, err := json.Marshal(results)
+		if err != nil {
+			exit.WithError("node exec json failure", err)
+		}
+		if _, err := os.Stdout.Write(js); err != nil {
+			exit.WithError("node exec json failure", err)
+		}
+	} else {
+		for _, res := range results {
+			fmt.Fprint(os.Stdout, prefixLines(res.Node, res.Stdout))
+			fmt.Fprint(os.Stderr, prefixLines(res.Node, res.Stderr))
+		}
+	}
+	return aggregateExitCode(results)
+}
+
+// execOnNode runs a command on a single node, capturing its output
+func execOnNode(api libmachine.API, cc config.ClusterConfig, n config.Node, args []string) ExecResult {
+	failed := func(err error) ExecResult {
+		return ExecResult{Node: n.Name, Command: args, Stderr: err.Error() + "\n", ExitCode: exit.Failure}
+	}
+	h, err := machine.LoadHost(api, driver.MachineName(cc, n))
+	if err != nil {
+		return failed(errors.Wrap(err, "loading host"))
+	}
+	r, err := machine.CommandRunner(h)
+	if err != nil {
+		return failed(errors.Wrap(err, "getting command runner"))
+	}
+	rr, err := r.RunCmd(exec.Command(args[0], args[1:]...))
+	// A zero exit code with an error means the command never ran on the node
+	if err != nil && rr.ExitCode == 0 {
+		return failed(errors.Wrap(err, "running command"))
+	}
+	return execResult(n.Name, rr)
+}
+
+// prefixLines prefixes every line of s with the node name
+func prefixLines(name string, s string) string {
+	if s == "" {
+		return ""
+	}
+	var b strings.Builder
+	for _, l := range strings.Split(strings.TrimSuffix(s, "\n"), "\n") {
+		fmt.Fprintf(&b, "[%s] %s\n", name, l)
+	}
+	return b.String()
+}
+
+// aggregateExitCode returns the highest exit code of all results, so any failure is reported
+func aggregateExitCode(results []ExecResult) int {
+	code := 0
+	for _, res := range results {
+		if res.ExitCode > code {
+			code = res.ExitCode
+		}
+	}
+	return code
+}
+
+// execResult converts the result of a runner into an ExecResult
+func execResult(name string, rr *command.RunResult) ExecResult {
+	return ExecResult{
+		Node:     name,
+		Command:  rr.Args,
+		Stdout:   rr.Stdout.String(),
+		Stderr:   rr.Stderr.String(),
+		ExitCode: rr.ExitCode,
+	}
+}
+
+func nodeExecJSON(res ExecResult, w io.Writer) error {
+	js, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(js)
+	return err
+}
+
+func init() {
+	nodeExecCmd.Flags().StringVarP(&nodeExecOutput, "output", "o", "text", "Output format. One of: text, json. With json, stdout, stderr and the exit code are reported as a single object.")
+	nodeExecCmd.Flags().BoolVar(&nodeExecAllNodes, "all-nodes", false, "Run the command on every running node in parallel, exiting with the highest exit code. With json output, an array of results is printed.")
+	nodeCmd.AddCommand(nodeExecCmd)
+}
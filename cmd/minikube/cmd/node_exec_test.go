@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/command"
+)
+
+func TestNodeExecJSON(t *testing.T) {
+	var tests = []struct {
+		name     string
+		stdout   string
+		stderr   string
+		exitCode int
+		want     string
+	}{
+		{
+			name:   "success",
+			stdout: "active\n",
+			want:   `{"Node":"m02","Command":["systemctl","is-active","kubelet"],"Stdout":"active\n","Stderr":"","ExitCode":0}`,
+		},
+		{
+			name:     "failure",
+			stdout:   "inactive\n",
+			stderr:   "unit not found\n",
+			exitCode: 3,
+			want:     `{"Node":"m02","Command":["systemctl","is-active","kubelet"],"Stdout":"inactive\n","Stderr":"unit not found\n","ExitCode":3}`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := &command.RunResult{Args: []string{"systemctl", "is-active", "kubelet"}, ExitCode: tc.exitCode}
+			rr.Stdout.WriteString(tc.stdout)
+			rr.Stderr.WriteString(tc.stderr)
+
+			var b bytes.Buffer
+			if err := nodeExecJSON(execResult("m02", rr), &b); err != nil {
+				t.Errorf("json(%+v) error: %v", rr, err)
+			}
+
+			got := b.String()
+			if got != tc.want {
+				t.Errorf("json(%+v) = %q, want: %q", rr, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPrefixLines(t *testing.T) {
+	var tests = []struct {
+		in   string
+		want string
+	}{
+		{"", ""},
+		{"5.4.40\n", "[m02] 5.4.40\n"},
+		{"a\nb", "[m02] a\n[m02] b\n"},
+		{"a\n\nb\n", "[m02] a\n[m02] \n[m02] b\n"},
+	}
+	for _, tc := range tests {
+		got := prefixLines("m02", tc.in)
+		if got != tc.want {
+			t.Errorf("prefixLines(%q) = %q, want: %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestAggregateExitCode(t *testing.T) {
+	var tests = []struct {
+		codes []int
+		want  int
+	}{
+		{[]int{0}, 0},
+		{[]int{0, 0, 0}, 0},
+		{[]int{0, 3, 1}, 3},
+		{[]int{1, 0}, 1},
+	}
+	for _, tc := range tests {
+		var results []ExecResult
+		for _, c := range tc.codes {
+			results = append(results, ExecResult{ExitCode: c})
+		}
+		got := aggregateExitCode(results)
+		if got != tc.want {
+			t.Errorf("aggregateExitCode(%v) = %d, want: %d", tc.codes, got, tc.want)
+		}
+	}
+}
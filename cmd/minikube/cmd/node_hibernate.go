@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+var nodeHibernateCmd = &cobra.Command{
+	Use:   "hibernate",
+	Short: "Suspends a node, freeing its resources.",
+	Long:  "Suspends a node without shutting it down. With the kvm2 and virtualbox drivers the memory of the node is saved to disk and released, with the docker and podman drivers the node is frozen in place. Use 'minikube node resume' to bring it back.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			exit.UsageT("Usage: minikube node hibernate [name]")
+		}
+
+		api, cc := mustload.Partial(ClusterFlagValue())
+		name := args[0]
+
+		n, _, err := node.Retrieve(*cc, name)
+		if err != nil {
+			exit.WithError("retrieving node", err)
+		}
+
+		machineName := driver.MachineName(*cc, *n)
+		if !machine.IsRunning(api, machineName) {
+			exit.WithCodeT(exit.Unavailable, "Node {{.name}} is not running.", out.V{"name": name})
+		}
+		if n.ControlPlane {
+			out.WarningT("{{.name}} is a control plane, the cluster will be unreachable until it is resumed", out.V{"name": name})
+		}
+
+		out.T(out.Pause, "Hibernating node {{.name}} ...", out.V{"name": machineName})
+		if err := machine.Hibernate(*cc, machineName); err != nil {
+			exit.WithError("hibernating node", err)
+		}
+		out.T(out.Pause, "Node {{.name}} hibernated, run 'minikube node resume {{.name}}' to bring it back", out.V{"name": name})
+	},
+}
+
+func init() {
+	nodeCmd.AddCommand(nodeHibernateCmd)
+}
@@ -17,27 +17,72 @@ limitations under the License.
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/docker/machine/libmachine"
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
 )
 
+var (
+	nodeListOutput   string
+	nodeListWatch    bool
+	nodeListInterval time.Duration
+)
+
+// NodeListEntry is a node of a cluster, as listed by node list
+type NodeListEntry struct {
+	Name    string
+	Machine string
+	IP      string
+	State   string
+}
+
+// NodeEvent is a change to the nodes of a cluster, as reported by node list --watch
+type NodeEvent struct {
+	Type     string // Added, Removed or Changed
+	Name     string
+	Machine  string
+	IP       string
+	State    string
+	Previous string `json:",omitempty"` // the state before a change
+}
+
 var nodeListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List nodes.",
-	Long:  "List existing minikube nodes.",
+	Long:  "List existing minikube nodes. With --watch, keeps running and reports every node which is added, removed or changes state.",
 	Run: func(cmd *cobra.Command, args []string) {
 		if len(args) != 0 {
 			exit.UsageT("Usage: minikube node list")
 		}
+		output := strings.ToLower(nodeListOutput)
+		if output != "text" && output != "json" {
+			exit.UsageT("Invalid --output: {{.output}}. Valid values: text, json", out.V{"output": nodeListOutput})
+		}
+		if nodeListInterval <= 0 {
+			exit.UsageT("Invalid --interval: {{.interval}}, it must be more than 0", out.V{"interval": nodeListInterval})
+		}
 
 		cname := ClusterFlagValue()
-		_, cc := mustload.Partial(cname)
+		api, cc := mustload.Partial(cname)
+		defer api.Close()
+
+		if nodeListWatch {
+			watchNodes(api, cname, output, os.Stdout)
+			return
+		}
 
 		if len(cc.Nodes) < 1 {
 			glog.Warningf("Did not found any minikube node.")
@@ -45,6 +90,15 @@ var nodeListCmd = &cobra.Command{
 			glog.Infof("%v", cc.Nodes)
 		}
 
+		if output == "json" {
+			js, err := json.Marshal(listNodes(api, *cc))
+			if err != nil {
+				exit.WithError("node list json failure", err)
+			}
+			fmt.Println(string(js))
+			return
+		}
+
 		for _, n := range cc.Nodes {
 			machineName := driver.MachineName(*cc, n)
 			fmt.Printf("%s\t%s\n", machineName, n.IP)
@@ -53,6 +107,97 @@ var nodeListCmd = &cobra.Command{
 	},
 }
 
+// listNodes returns each node of a cluster along with the state of its machine
+func listNodes(api libmachine.API, cc config.ClusterConfig) []NodeListEntry {
+	entries := []NodeListEntry{}
+	for _, n := range cc.Nodes {
+		machineName := driver.MachineName(cc, n)
+		st, err := machine.Status(api, machineName)
+		if err != nil {
+			glog.Warningf("status of %s: %v", machineName, err)
+			st = "Unknown"
+		}
+		entries = append(entries, NodeListEntry{Name: n.Name, Machine: machineName, IP: n.IP, State: st})
+	}
+	return entries
+}
+
+// watchNodes lists the nodes of a cluster every --interval, writing a line for each change until interrupted
+func watchNodes(api libmachine.API, cname string, output string, w io.Writer) {
+	var previous []NodeListEntry
+	for {
+		// the config is loaded again each time, as nodes are added and removed by other minikube commands
+		current := []NodeListEntry{}
+		cc, err := config.Load(cname)
+		switch {
+		case err == nil:
+			current = listNodes(api, *cc)
+		case config.IsNotExist(err):
+			glog.Infof("cluster %s does not exist", cname)
+		default:
+			glog.Warningf("unable to load config of %s: %v", cname, err)
+			current = previous
+		}
+
+		for _, e := range nodeEvents(previous, current) {
+			if err := writeNodeEvent(e, output, w); err != nil {
+				exit.WithError("node list failure", err)
+			}
+		}
+		previous = current
+		time.Sleep(nodeListInterval)
+	}
+}
+
+// nodeEvents returns the nodes which were added or changed in current, followed by those removed from previous
+func nodeEvents(previous []NodeListEntry, current []NodeListEntry) []NodeEvent {
+	before := map[string]NodeListEntry{}
+	for _, e := range previous {
+		before[e.Machine] = e
+	}
+	after := map[string]bool{}
+
+	events := []NodeEvent{}
+	for _, e := range current {
+		after[e.Machine] = true
+		b, ok := before[e.Machine]
+		switch {
+		case !ok:
+			events = append(events, NodeEvent{Type: "Added", Name: e.Name, Machine: e.Machine, IP: e.IP, State: e.State})
+		case b.State != e.State || b.IP != e.IP:
+			events = append(events, NodeEvent{Type: "Changed", Name: e.Name, Machine: e.Machine, IP: e.IP, State: e.State, Previous: b.State})
+		}
+	}
+	for _, e := range previous {
+		if !after[e.Machine] {
+			events = append(events, NodeEvent{Type: "Removed", Name: e.Name, Machine: e.Machine, IP: e.IP, State: e.State})
+		}
+	}
+	return events
+}
+
+// writeNodeEvent writes a change as a line of text, or as a JSON object per line
+func writeNodeEvent(e NodeEvent, output string, w io.Writer) error {
+	if output == "json" {
+		js, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", js)
+		return err
+	}
+
+	state := e.State
+	if e.Type == "Changed" && e.Previous != e.State {
+		state = fmt.Sprintf("%s -> %s", e.Previous, e.State)
+	}
+	_, err := fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", e.Type, e.Machine, e.IP, state)
+	return err
+}
+
 func init() {
+	nodeListCmd.Flags().StringVarP(&nodeListOutput, "output", "o", "text", "Output format. One of: text, json. With --watch, json emits a JSON object per change.")
+	nodeListCmd.Flags().BoolVarP(&nodeListWatch, "watch", "w", false, "Keep running, and print a line for every node which is added, removed or changes state.")
+	nodeListCmd.Flags().DurationVar(&nodeListInterval, "interval", 2*time.Second, "How often to check the nodes with --watch.")
 	nodeCmd.AddCommand(nodeListCmd)
 }
@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestNodeEvents(t *testing.T) {
+	cp := NodeListEntry{Name: "", Machine: "minikube", IP: "192.168.39.2", State: "Running"}
+	m02 := NodeListEntry{Name: "m02", Machine: "minikube-m02", IP: "192.168.39.3", State: "Running"}
+	m03 := NodeListEntry{Name: "m03", Machine: "minikube-m03", IP: "192.168.39.4", State: "Running"}
+	stopped := m02
+	stopped.State = "Stopped"
+
+	var tests = []struct {
+		name     string
+		previous []NodeListEntry
+		current  []NodeListEntry
+		want     []NodeEvent
+	}{
+		{
+			name:    "first list",
+			current: []NodeListEntry{cp, m02},
+			want: []NodeEvent{
+				{Type: "Added", Name: "", Machine: "minikube", IP: "192.168.39.2", State: "Running"},
+				{Type: "Added", Name: "m02", Machine: "minikube-m02", IP: "192.168.39.3", State: "Running"},
+			},
+		},
+		{
+			name:     "unchanged",
+			previous: []NodeListEntry{cp, m02},
+			current:  []NodeListEntry{cp, m02},
+			want:     []NodeEvent{},
+		},
+		{
+			name:     "stopped, added and removed",
+			previous: []NodeListEntry{cp, m02, m03},
+			current:  []NodeListEntry{cp, stopped, {Name: "m04", Machine: "minikube-m04", State: "Running"}},
+			want: []NodeEvent{
+				{Type: "Changed", Name: "m02", Machine: "minikube-m02", IP: "192.168.39.3", State: "Stopped", Previous: "Running"},
+				{Type: "Added", Name: "m04", Machine: "minikube-m04", State: "Running"},
+				{Type: "Removed", Name: "m03", Machine: "minikube-m03", IP: "192.168.39.4", State: "Running"},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := nodeEvents(tc.previous, tc.current)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("nodeEvents() = %+v, want: %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWriteNodeEvent(t *testing.T) {
+	e := NodeEvent{Type: "Changed", Name: "m02", Machine: "minikube-m02", IP: "192.168.39.3", State: "Stopped", Previous: "Running"}
+	var tests = []struct {
+		output string
+		want   string
+	}{
+		{"text", "Changed\tminikube-m02\t192.168.39.3\tRunning -> Stopped\n"},
+		{"json", `{"Type":"Changed","Name":"m02","Machine":"minikube-m02","IP":"192.168.39.3","State":"Stopped","Previous":"Running"}` + "\n"},
+	}
+	for _, tc := range tests {
+		var b bytes.Buffer
+		if err := writeNodeEvent(e, tc.output, &b); err != nil {
+			t.Fatalf("writeNodeEvent(%s) unexpected error: %v", tc.output, err)
+		}
+		if got := b.String(); got != tc.want {
+			t.Errorf("writeNodeEvent(%s) = %q, want: %q", tc.output, got, tc.want)
+		}
+	}
+}
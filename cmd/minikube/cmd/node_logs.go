@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+var (
+	nodeLogsUnits []string
+	nodeLogsSince string
+	nodeLogsUntil string
+	nodeLogsLines int
+)
+
+var nodeLogsCmd = &cobra.Command{
+	Use:   "logs",
+	Short: "Prints the journald logs of a node.",
+	Long:  "Prints the journald logs of a node, optionally filtered by systemd unit and time range.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 1 {
+			exit.UsageT("Usage: minikube node logs [name]")
+		}
+		if nodeLogsLines < 0 {
+			exit.UsageT("Invalid --length: {{.length}}, it must be 0 or more", out.V{"length": nodeLogsLines})
+		}
+
+		api, cc := mustload.Partial(ClusterFlagValue())
+		defer api.Close()
+		name := args[0]
+
+		n, _, err := node.Retrieve(*cc, name)
+		if err != nil {
+			exit.WithCodeT(exit.Unavailable, "Node {{.nodeName}} does not exist.", out.V{"nodeName": name})
+		}
+
+		machineName := driver.MachineName(*cc, *n)
+		if !machine.IsRunning(api, machineName) {
+			exit.WithCodeT(exit.Unavailable, `Node {{.name}} is not running, start it with "minikube node start {{.name}}"`, out.V{"name": name})
+		}
+
+		h, err := machine.LoadHost(api, machineName)
+		if err != nil {
+			exit.WithError("Error loading host", err)
+		}
+		r, err := machine.CommandRunner(h)
+		if err != nil {
+			exit.WithError("Failed to get command runner", err)
+		}
+
+		logs, err := node.JournalLogs(r, node.JournalFilter{Units: nodeLogsUnits, Since: nodeLogsSince, Until: nodeLogsUntil, Lines: nodeLogsLines})
+		if err != nil {
+			exit.WithError("Failed to get journald logs", err)
+		}
+		fmt.Fprint(os.Stdout, logs)
+	},
+}
+
+func init() {
+	nodeLogsCmd.Flags().StringSliceVar(&nodeLogsUnits, "unit", nil, "Only print the logs of these systemd units, such as kubelet or docker. Defaults to all units.")
+	nodeLogsCmd.Flags().StringVar(&nodeLogsSince, "since", "", "Only print logs newer than a duration such as 10m, or than a timestamp such as \"2020-06-01 10:00:00\".")
+	nodeLogsCmd.Flags().StringVar(&nodeLogsUntil, "until", "", "Only print logs older than a duration such as 5m, or than a timestamp such as \"2020-06-01 10:30:00\".")
+	nodeLogsCmd.Flags().IntVarP(&nodeLogsLines, "length", "n", 0, "Number of most recent lines to print. 0 prints all of them.")
+	nodeCmd.AddCommand(nodeLogsCmd)
+}
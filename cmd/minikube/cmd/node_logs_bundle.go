@@ -0,0 +1,216 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/machine/libmachine"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	cmdcfg "k8s.io/minikube/cmd/minikube/cmd/config"
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
+	"k8s.io/minikube/pkg/minikube/cluster"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/cruntime"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/logs"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/vmpath"
+)
+
+var (
+	nodeBundleOutput string
+	nodeBundleLines  int
+)
+
+var nodeLogsBundleCmd = &cobra.Command{
+	Use:   "logs-bundle",
+	Short: "Collects a diagnostic bundle of a node.",
+	Long:  "Collects the kubelet, container runtime, kernel and system pod logs of a node, along with its description, into a gzipped tarball suitable for attaching to bug reports.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			exit.UsageT("Usage: minikube node logs-bundle [name]")
+		}
+
+		api, cc := mustload.Partial(ClusterFlagValue())
+		name := args[0]
+
+		n, _, err := node.Retrieve(*cc, name)
+		if err != nil {
+			exit.WithError("retrieving node", err)
+		}
+
+		machineName := driver.MachineName(*cc, *n)
+		if !machine.IsRunning(api, machineName) {
+			exit.WithCodeT(exit.Unavailable, `Node {{.name}} is not running, start it with "minikube node start {{.name}}"`, out.V{"name": name})
+		}
+
+		h, err := machine.LoadHost(api, machineName)
+		if err != nil {
+			exit.WithError("Error loading host", err)
+		}
+		r, err := machine.CommandRunner(h)
+		if err != nil {
+			exit.WithError("Failed to get command runner", err)
+		}
+		bs, err := cluster.Bootstrapper(api, viper.GetString(cmdcfg.Bootstrapper), *cc, r)
+		if err != nil {
+			exit.WithError("Error getting cluster bootstrapper", err)
+		}
+		cr, err := cruntime.New(cruntime.Config{Type: cc.KubernetesConfig.ContainerRuntime, Runner: r})
+		if err != nil {
+			exit.WithError("Unable to get runtime", err)
+		}
+
+		out.T(out.LogEntry, "Collecting logs of node {{.name}} ...", out.V{"name": name})
+		entries, err := logs.Entries(cr, bs, *cc, r, n.Name, nodeBundleLines)
+		if err != nil {
+			out.WarningT("{{.error}}", out.V{"error": err})
+		}
+
+		files := map[string]string{}
+		for _, e := range entries {
+			// the node is described below, workers have no kubeconfig to describe all nodes with
+			if e.Component == "describe nodes" {
+				continue
+			}
+			content := strings.Join(e.Lines, "\n") + "\n"
+			if e.Error != "" {
+				content += fmt.Sprintf("error: %s\n", e.Error)
+			}
+			files[bundleFileName(e.Component)+".log"] = content
+		}
+
+		var desc bytes.Buffer
+		if err := nodeDescribeText(describeNode(api, *cc, *n), &desc); err != nil {
+			exit.WithError("node describe failure", err)
+		}
+		files["describe.txt"] = desc.String()
+
+		kdesc, err := describeKubeNode(api, *cc, *n)
+		if err != nil {
+			kdesc += fmt.Sprintf("error: %v\n", err)
+		}
+		files["kubectl-describe-node.txt"] = kdesc
+
+		dst := nodeBundleOutput
+		if dst == "" {
+			dst = fmt.Sprintf("%s-logs.tar.gz", machineName)
+		}
+		f, err := os.Create(dst)
+		if err != nil {
+			exit.WithError("Error creating bundle", err)
+		}
+		defer f.Close()
+		if err := writeBundle(f, machineName, files); err != nil {
+			exit.WithError("Error writing bundle", err)
+		}
+		out.T(out.Ready, "Wrote the logs of node {{.name}} to {{.path}}", out.V{"name": name, "path": dst})
+	},
+}
+
+// describeKubeNode returns the 'kubectl describe node' output of a node, run on the primary control plane
+func describeKubeNode(api libmachine.API, cc config.ClusterConfig, n config.Node) (string, error) {
+	cp, err := config.PrimaryControlPlane(&cc)
+	if err != nil {
+		return "", errors.Wrap(err, "primary control plane")
+	}
+	h, err := machine.LoadHost(api, driver.MachineName(cc, cp))
+	if err != nil {
+		return "", errors.Wrap(err, "load host")
+	}
+	r, err := machine.CommandRunner(h)
+	if err != nil {
+		return "", errors.Wrap(err, "command runner")
+	}
+	kubectl := kapi.KubectlBinaryPath(cc.KubernetesConfig.KubernetesVersion)
+	rr, err := r.RunCmd(exec.Command("sudo", fmt.Sprintf("KUBECONFIG=%s", path.Join(vmpath.GuestPersistentDir, "kubeconfig")), kubectl, "describe", "node", bsutil.KubeNodeName(cc, n)))
+	if err != nil {
+		return rr.Output(), errors.Wrap(err, "kubectl describe node")
+	}
+	return rr.Stdout.String(), nil
+}
+
+// bundleFileName turns the name of a log component, such as "kube-proxy [1a2b]", into a file name
+func bundleFileName(component string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '.':
+			return r
+		default:
+			return '_'
+		}
+	}, component)
+	for strings.Contains(name, "__") {
+		name = strings.Replace(name, "__", "_", -1)
+	}
+	return strings.Trim(name, "_")
+}
+
+// writeBundle writes files as a gzipped tarball, within a directory named dir
+func writeBundle(w io.Writer, dir string, files map[string]string) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	names := []string{}
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		hdr := &tar.Header{
+			Name:    path.Join(dir, name),
+			Mode:    0644,
+			Size:    int64(len(files[name])),
+			ModTime: time.Now(),
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return errors.Wrapf(err, "header %s", name)
+		}
+		if _, err := tw.Write([]byte(files[name])); err != nil {
+			return errors.Wrapf(err, "write %s", name)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return errors.Wrap(err, "tar")
+	}
+	return gw.Close()
+}
+
+func init() {
+	nodeLogsBundleCmd.Flags().StringVarP(&nodeBundleOutput, "output", "o", "", "The file to write the bundle to. Defaults to <machine name>-logs.tar.gz in the current directory.")
+	nodeLogsBundleCmd.Flags().IntVarP(&nodeBundleLines, "length", "n", 1000, "Number of lines back to go within each log")
+	nodeCmd.AddCommand(nodeLogsBundleCmd)
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"testing"
+)
+
+func TestBundleFileName(t *testing.T) {
+	var tests = []struct {
+		component string
+		want      string
+	}{
+		{"kubelet", "kubelet"},
+		{"container status", "container_status"},
+		{"kube-proxy [1a2b3c]", "kube-proxy_1a2b3c"},
+		{"CRI-O", "CRI-O"},
+	}
+	for _, tc := range tests {
+		if got := bundleFileName(tc.component); got != tc.want {
+			t.Errorf("bundleFileName(%q) = %q, want %q", tc.component, got, tc.want)
+		}
+	}
+}
+
+func TestWriteBundle(t *testing.T) {
+	files := map[string]string{
+		"kubelet.log":  "kubelet started\n",
+		"describe.txt": "Name: m02\n",
+	}
+	var b bytes.Buffer
+	if err := writeBundle(&b, "minikube-m02", files); err != nil {
+		t.Fatalf("writeBundle: %v", err)
+	}
+
+	gr, err := gzip.NewReader(&b)
+	if err != nil {
+		t.Fatalf("gzip: %v", err)
+	}
+	tr := tar.NewReader(gr)
+	got := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			break
+		}
+		content, err := ioutil.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("read %s: %v", hdr.Name, err)
+		}
+		got[hdr.Name] = string(content)
+	}
+
+	want := map[string]string{
+		"minikube-m02/kubelet.log":  "kubelet started\n",
+		"minikube-m02/describe.txt": "Name: m02\n",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("bundle has %d files, want %d: %v", len(got), len(want), got)
+	}
+	for name, content := range want {
+		if got[name] != content {
+			t.Errorf("%s = %q, want %q", name, got[name], content)
+		}
+	}
+}
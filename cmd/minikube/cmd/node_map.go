@@ -0,0 +1,177 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/docker/machine/libmachine"
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+)
+
+var nodeMapOutput string
+
+// NodeMapping correlates the names a node is known by in minikube, the driver, the guest OS and Kubernetes
+type NodeMapping struct {
+	Name           string
+	MachineName    string
+	Hostname       string
+	KubernetesNode string
+}
+
+var nodeMapCmd = &cobra.Command{
+	Use:   "map",
+	Short: "Map minikube nodes to their machine, host and Kubernetes node names.",
+	Long:  "Map each minikube node to its driver machine name, the hostname of the guest and the name of its Kubernetes node object. The hostname is only known while the node is running, and the Kubernetes node while the cluster is.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 0 {
+			exit.UsageT("Usage: minikube node map")
+		}
+		output := strings.ToLower(nodeMapOutput)
+		if output != "text" && output != "json" {
+			exit.WithCodeT(exit.BadUsage, fmt.Sprintf("invalid output format: %s. Valid values: 'text', 'json'", nodeMapOutput))
+		}
+
+		api, cc := mustload.Partial(ClusterFlagValue())
+		defer api.Close()
+
+		var kns []core.Node
+		client, err := kapi.Client(cc.Name)
+		if err != nil {
+			glog.Warningf("unable to get kubernetes client: %v", err)
+		} else if nl, err := client.CoreV1().Nodes().List(meta.ListOptions{}); err != nil {
+			glog.Warningf("unable to list kubernetes nodes: %v", err)
+		} else {
+			kns = nl.Items
+		}
+
+		var maps []NodeMapping
+		for _, n := range cc.Nodes {
+			m := NodeMapping{Name: n.Name, MachineName: driver.MachineName(*cc, n)}
+			m.Hostname = guestHostname(api, m.MachineName)
+			m.KubernetesNode = kubernetesNodeName(kns, n, m.Hostname, bsutil.KubeNodeName(*cc, n))
+			maps = append(maps, m)
+		}
+
+		if output == "json" {
+			if err := nodeMapJSON(maps, os.Stdout); err != nil {
+				exit.WithError("node map json failure", err)
+			}
+			return
+		}
+		if err := nodeMapText(maps, os.Stdout); err != nil {
+			exit.WithError("node map failure", err)
+		}
+	},
+}
+
+// guestHostname returns the hostname set inside a running machine, or "" if it can not be retrieved
+func guestHostname(api libmachine.API, machineName string) string {
+	if !machine.IsRunning(api, machineName) {
+		glog.Infof("%s is not running, not reporting its hostname", machineName)
+		return ""
+	}
+	h, err := machine.LoadHost(api, machineName)
+	if err != nil {
+		glog.Warningf("unable to load host %s: %v", machineName, err)
+		return ""
+	}
+	r, err := machine.CommandRunner(h)
+	if err != nil {
+		glog.Warningf("unable to get command runner of %s: %v", machineName, err)
+		return ""
+	}
+	rr, err := r.RunCmd(exec.Command("hostname"))
+	if err != nil {
+		glog.Warningf("unable to get hostname of %s: %v", machineName, err)
+		return ""
+	}
+	return strings.TrimSpace(rr.Stdout.String())
+}
+
+// kubernetesNodeName finds the Kubernetes node object of a minikube node, by its IP first as the names may have diverged
+func kubernetesNodeName(kns []core.Node, n config.Node, hostname string, expected string) string {
+	if n.IP != "" {
+		for _, kn := range kns {
+			for _, a := range kn.Status.Addresses {
+				if a.Type == core.NodeInternalIP && a.Address == n.IP {
+					return kn.Name
+				}
+			}
+		}
+	}
+	for _, kn := range kns {
+		if kn.Name == expected {
+			return kn.Name
+		}
+	}
+	if hostname != "" {
+		for _, kn := range kns {
+			if kn.Labels["kubernetes.io/hostname"] == hostname {
+				return kn.Name
+			}
+		}
+	}
+	return ""
+}
+
+func nodeMapText(maps []NodeMapping, w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tMACHINE\tHOSTNAME\tKUBERNETES NODE")
+	for _, m := range maps {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", orNone(m.Name), m.MachineName, orNone(m.Hostname), orNone(m.KubernetesNode))
+	}
+	return tw.Flush()
+}
+
+// orNone shows empty columns as "-", so that the table stays aligned
+func orNone(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func nodeMapJSON(maps []NodeMapping, w io.Writer) error {
+	js, err := json.Marshal(maps)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(js)
+	return err
+}
+
+func init() {
+	nodeMapCmd.Flags().StringVarP(&nodeMapOutput, "output", "o", "text", "Output format. One of: text, json.")
+	nodeCmd.AddCommand(nodeMapCmd)
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestKubernetesNodeName(t *testing.T) {
+	kns := []core.Node{
+		{
+			ObjectMeta: meta.ObjectMeta{Name: "p1", Labels: map[string]string{"kubernetes.io/hostname": "p1"}},
+			Status:     core.NodeStatus{Addresses: []core.NodeAddress{{Type: core.NodeInternalIP, Address: "192.168.39.2"}}},
+		},
+		{
+			ObjectMeta: meta.ObjectMeta{Name: "renamed", Labels: map[string]string{"kubernetes.io/hostname": "guest"}},
+			Status:     core.NodeStatus{Addresses: []core.NodeAddress{{Type: core.NodeInternalIP, Address: "192.168.39.3"}}},
+		},
+	}
+
+	var tests = []struct {
+		name     string
+		node     config.Node
+		hostname string
+		expected string
+		want     string
+	}{
+		{"by name", config.Node{}, "", "p1", "p1"},
+		{"by ip", config.Node{Name: "m02", IP: "192.168.39.3"}, "", "p1-m02", "renamed"},
+		{"by hostname", config.Node{Name: "m02"}, "guest", "p1-m02", "renamed"},
+		{"not registered", config.Node{Name: "m03", IP: "192.168.39.4"}, "p1-m03", "p1-m03", ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := kubernetesNodeName(kns, tc.node, tc.hostname, tc.expected)
+			if got != tc.want {
+				t.Errorf("kubernetesNodeName() = %q, want: %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNodeMapOutput(t *testing.T) {
+	maps := []NodeMapping{
+		{Name: "", MachineName: "p1", Hostname: "p1", KubernetesNode: "p1"},
+		{Name: "m02", MachineName: "p1-m02"},
+	}
+
+	var b bytes.Buffer
+	if err := nodeMapText(maps, &b); err != nil {
+		t.Fatalf("nodeMapText: %v", err)
+	}
+	wantText := "NAME  MACHINE  HOSTNAME  KUBERNETES NODE\n-     p1       p1        p1\nm02   p1-m02   -         -\n"
+	if b.String() != wantText {
+		t.Errorf("nodeMapText() = %q, want: %q", b.String(), wantText)
+	}
+
+	b.Reset()
+	if err := nodeMapJSON(maps, &b); err != nil {
+		t.Fatalf("nodeMapJSON: %v", err)
+	}
+	wantJSON := `[{"Name":"","MachineName":"p1","Hostname":"p1","KubernetesNode":"p1"},{"Name":"m02","MachineName":"p1-m02","Hostname":"","KubernetesNode":""}]`
+	if b.String() != wantJSON {
+		t.Errorf("nodeMapJSON() = %s, want: %s", b.String(), wantJSON)
+	}
+}
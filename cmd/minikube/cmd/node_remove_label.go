@@ -0,0 +1,56 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+var nodeRemoveLabelCmd = &cobra.Command{
+	Use:   "remove-label",
+	Short: "Removes labels from a node.",
+	Long:  "Removes labels by key from a node and from its stored config, so they are no longer reapplied when the node starts.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 2 {
+			exit.UsageT("Usage: minikube node remove-label [name] [KEY] ...")
+		}
+
+		api, cc := mustload.Partial(ClusterFlagValue())
+		name := args[0]
+
+		n, _, err := node.Retrieve(*cc, name)
+		if err != nil {
+			exit.WithError("retrieving node", err)
+		}
+
+		live := machine.IsRunning(api, driver.MachineName(*cc, *n))
+		if err := node.RemoveLabels(cc, n, args[1:], live); err != nil {
+			exit.WithError("removing labels", err)
+		}
+		out.T(out.Check, "Removed labels from node {{.name}}", out.V{"name": name})
+	},
+}
+
+func init() {
+	nodeCmd.AddCommand(nodeRemoveLabelCmd)
+}
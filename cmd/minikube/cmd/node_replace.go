@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+var replaceReadyTimeout time.Duration
+
+var nodeReplaceCmd = &cobra.Command{
+	Use:   "replace",
+	Short: "Replaces a node with a fresh one.",
+	Long:  "Adds a fresh node with the settings, labels and taints of a node, waits for it to be Ready, then drains and deletes the node. The fresh node is named after the next free node name. If it can not be added, it is removed again and the node is left untouched.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			exit.UsageT("Usage: minikube node replace [name]")
+		}
+		name := args[0]
+
+		cname := ClusterFlagValue()
+		co := mustload.Healthy(cname)
+		cc := co.Config
+
+		old, _, err := node.Retrieve(*cc, name)
+		if err != nil {
+			exit.WithError("retrieving node", err)
+		}
+		if old.ControlPlane {
+			exit.UsageT("Node {{.name}} is a control plane and can not be replaced", out.V{"name": name})
+		}
+		kubeName := bsutil.KubeNodeName(*cc, *old)
+
+		client, err := kapi.Client(cname)
+		if err != nil {
+			exit.WithError("Failed to get kubernetes client", err)
+		}
+		// kept to be set on the replacement, which is registered with none of them
+		taints, err := node.Taints(client, kubeName)
+		if err != nil {
+			glog.Warningf("unable to get the taints of %s: %v", kubeName, err)
+		}
+
+		// The old node keeps running its workloads until the replacement is Ready, so a failed add leaves the cluster as it was
+		n := node.Replacement(*cc, *old)
+		newKubeName := bsutil.KubeNodeName(*cc, n)
+		out.T(out.Happy, "Adding node {{.new}} to replace node {{.name}}", out.V{"new": n.Name, "name": name})
+		if err := node.Add(cc, n, false); err != nil {
+			removeReplacement(*cc, n)
+			exit.WithCodeT(exit.Unavailable, "Unable to add a node to replace {{.name}}: {{.error}}. Node {{.name}} was left untouched.", out.V{"name": name, "error": err})
+		}
+
+		out.T(out.Waiting, "Waiting up to {{.timeout}} for node {{.new}} to be Ready ...", out.V{"new": n.Name, "timeout": replaceReadyTimeout})
+		if err := kverify.WaitForNamedNodeReady(client, newKubeName, replaceReadyTimeout); err != nil {
+			removeReplacement(*cc, n)
+			exit.WithCodeT(exit.Unavailable, "Node {{.new}} did not become Ready: {{.error}}. Node {{.name}} was left untouched.", out.V{"new": n.Name, "name": name, "error": err})
+		}
+
+		if len(taints) > 0 {
+			if err := node.ApplyTaints(client, newKubeName, taints); err != nil {
+				out.WarningT("Unable to copy the taints of {{.name}} to {{.new}}: {{.error}}", out.V{"name": name, "new": n.Name, "error": err})
+			}
+		}
+
+		out.T(out.Pause, "Cordoning node {{.name}} ...", out.V{"name": name})
+		if err := node.Cordon(client, kubeName); err != nil {
+			exit.WithError("cordoning node", err)
+		}
+
+		out.T(out.Waiting, "Draining node {{.name}}, waiting up to {{.grace}} ...", out.V{"name": name, "grace": drainGracePeriod})
+		stuck, err := node.DrainWithOptions(client, kubeName, drainGracePeriod, node.DrainOptions{DeleteEmptyDir: drainEmptyDir, Force: drainForce})
+		if err != nil {
+			exit.WithError("draining node", err)
+		}
+		if len(stuck) > 0 {
+			out.WarningT("These pods are still running on {{.name}}: {{.pods}}", out.V{"name": name, "pods": strings.Join(stuck, ", ")})
+			if !drainForce {
+				exit.WithCodeT(exit.Unavailable, "Node {{.new}} was added, but node {{.name}} was cordoned and not deleted. Use --force to replace it anyway, or 'minikube node delete {{.name}}' once its pods are gone.", out.V{"new": n.Name, "name": name})
+			}
+		}
+
+		if err := client.CoreV1().Nodes().Delete(kubeName, &meta.DeleteOptions{}); err != nil {
+			glog.Warningf("unable to delete Kubernetes node %s: %v", kubeName, err)
+		}
+
+		out.T(out.DeletingHost, "Deleting node {{.name}} from cluster {{.cluster}}", out.V{"name": name, "cluster": cc.Name})
+		if _, err := node.Delete(*cc, name); err != nil {
+			exit.WithError("deleting node", err)
+		}
+		cleanupNodeLeftovers(*cc, *old)
+
+		out.T(out.Ready, "Node {{.name}} was successfully replaced by node {{.new}}.", out.V{"name": name, "new": n.Name})
+	},
+}
+
+// removeReplacement deletes a replacement which could not be added or did not become Ready
+func removeReplacement(cc config.ClusterConfig, n config.Node) {
+	out.WarningT("Removing the partially added node {{.name}} ...", out.V{"name": n.Name})
+	if _, err := node.Delete(cc, n.Name); err != nil {
+		glog.Warningf("unable to delete the replacement node %s: %v", n.Name, err)
+	}
+	cleanupNodeLeftovers(cc, n)
+}
+
+// cleanupNodeLeftovers stops the mount of a deleted node and removes what its kic container left behind
+func cleanupNodeLeftovers(cc config.ClusterConfig, n config.Node) {
+	machineName := driver.MachineName(cc, n)
+	if n.Mount != "" {
+		if err := killMountPid(node.MountPidPath(machineName)); err != nil {
+			out.WarningT("Unable to kill mount process: {{.error}}", out.V{"error": err})
+		}
+	}
+	if driver.IsKIC(cc.Driver) {
+		deletePossibleKicLeftOver(machineName, cc.Driver)
+	}
+}
+
+func init() {
+	nodeReplaceCmd.Flags().DurationVar(&replaceReadyTimeout, "ready-timeout", 6*time.Minute, "How long to wait for the fresh node to be Ready before draining the old node.")
+	nodeReplaceCmd.Flags().DurationVar(&drainGracePeriod, "grace-period", 60*time.Second, "How long to wait for pods to be evicted from the old node and to terminate.")
+	nodeReplaceCmd.Flags().BoolVar(&drainForce, "force", false, "Also evict pods not managed by a controller, and delete the old node even if some pods could not be evicted.")
+	nodeReplaceCmd.Flags().BoolVar(&drainEmptyDir, "delete-emptydir-data", false, "Also evict pods using emptyDir volumes from the old node, deleting their data.")
+	nodeCmd.AddCommand(nodeReplaceCmd)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+var nodeResumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resumes a hibernated node.",
+	Long:  "Resumes a node suspended by 'minikube node hibernate', restoring it to the state it was hibernated in.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) == 0 {
+			exit.UsageT("Usage: minikube node resume [name]")
+		}
+
+		api, cc := mustload.Partial(ClusterFlagValue())
+		name := args[0]
+
+		n, _, err := node.Retrieve(*cc, name)
+		if err != nil {
+			exit.WithError("retrieving node", err)
+		}
+
+		machineName := driver.MachineName(*cc, *n)
+		if machine.IsRunning(api, machineName) {
+			out.T(out.Check, "Node {{.name}} is already running", out.V{"name": name})
+			return
+		}
+
+		out.T(out.Unpause, "Resuming node {{.name}} ...", out.V{"name": machineName})
+		if err := machine.Resume(*cc, machineName); err != nil {
+			exit.WithError("resuming node", err)
+		}
+		out.T(out.Ready, "Successfully resumed node {{.name}}", out.V{"name": name})
+	},
+}
+
+func init() {
+	nodeCmd.AddCommand(nodeResumeCmd)
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+var nodeSetLabelCmd = &cobra.Command{
+	Use:   "set-label",
+	Short: "Sets labels on a node.",
+	Long:  "Sets KEY=VALUE labels on a node. The labels are stored in the node config and reapplied whenever the node starts, so unlike 'kubectl label' they survive a restart.",
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) < 2 {
+			exit.UsageT("Usage: minikube node set-label [name] [KEY=VALUE] ...")
+		}
+		if err := node.ValidateLabels(args[1:]); err != nil {
+			exit.UsageT("{{.error}}", out.V{"error": err})
+		}
+
+		api, cc := mustload.Partial(ClusterFlagValue())
+		name := args[0]
+
+		n, _, err := node.Retrieve(*cc, name)
+		if err != nil {
+			exit.WithError("retrieving node", err)
+		}
+
+		live := machine.IsRunning(api, driver.MachineName(*cc, *n))
+		if err := node.SetLabels(cc, n, args[1:], live); err != nil {
+			exit.WithError("setting labels", err)
+		}
+		if !live {
+			out.T(out.Notice, "Node {{.name}} is not running, the labels will be set when it starts", out.V{"name": name})
+			return
+		}
+		out.T(out.Check, "Labelled node {{.name}}", out.V{"name": name})
+	},
+}
+
+func init() {
+	nodeCmd.AddCommand(nodeSetLabelCmd)
+}
@@ -71,7 +71,7 @@ var nodeStartCmd = &cobra.Command{
 		if err != nil {
 			_, err := maybeDeleteAndRetry(*cc, *n, nil, err)
 			if err != nil {
-				node.MaybeExitWithAdvice(err)
+				node.MaybeExitWithAdvice(err, cc, n)
 				exit.WithError("failed to start node", err)
 			}
 		}
@@ -17,42 +17,151 @@ limitations under the License.
 package cmd
 
 import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docker/machine/libmachine"
+	"github.com/golang/glog"
 	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
+	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/kubeconfig"
 	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/mustload"
 	"k8s.io/minikube/pkg/minikube/node"
 	"k8s.io/minikube/pkg/minikube/out"
 )
 
+var (
+	nodeStopDrain         bool
+	nodeStopDrainEmptyDir bool
+	nodeStopDrainForce    bool
+)
+
 var nodeStopCmd = &cobra.Command{
 	Use:   "stop",
-	Short: "Stops a node in a cluster.",
-	Long:  "Stops a node in a cluster.",
+	Short: "Stops one or more nodes in a cluster.",
+	Long:  "Stops one or more nodes in a cluster. Workers are stopped before control planes, and the command fails if any node could not be stopped.",
 	Run: func(cmd *cobra.Command, args []string) {
 		if len(args) == 0 {
-			exit.UsageT("Usage: minikube node stop [name]")
+			exit.UsageT("Usage: minikube node stop [name] [name...]")
+		}
+		if !nodeStopDrain && (nodeStopDrainEmptyDir || nodeStopDrainForce) {
+			exit.UsageT("--drain-delete-emptydir-data and --drain-force require --drain")
 		}
 
-		name := args[0]
 		api, cc := mustload.Partial(ClusterFlagValue())
 
-		n, _, err := node.Retrieve(*cc, name)
-		if err != nil {
-			exit.WithError("retrieving node", err)
+		var nodes []config.Node
+		seen := map[string]bool{}
+		for _, name := range args {
+			n, _, err := node.Retrieve(*cc, name)
+			if err != nil {
+				exit.WithError("retrieving node", err)
+			}
+			if seen[n.Name] {
+				continue
+			}
+			seen[n.Name] = true
+			nodes = append(nodes, *n)
 		}
 
-		machineName := driver.MachineName(*cc, *n)
+		var failed []string
+		var stoppedCP string
+		for _, n := range stopOrder(nodes) {
+			machineName := driver.MachineName(*cc, n)
+			if nodeStopDrain {
+				drainBeforeStop(*cc, n)
+			}
+			if err := machine.StopHost(api, machineName); err != nil {
+				glog.Warningf("stopping %s: %v", machineName, err)
+				out.FailureT("Failed to stop node {{.name}}: {{.error}}", out.V{"name": n.Name, "error": err})
+				failed = append(failed, n.Name)
+				continue
+			}
+			out.T(out.Stopped, "Successfully stopped node {{.name}}", out.V{"name": machineName})
+			if n.ControlPlane {
+				stoppedCP = machineName
+			}
+		}
 
-		err = machine.StopHost(api, machineName)
-		if err != nil {
-			out.FatalT("Failed to stop node {{.name}}", out.V{"name": name})
+		if stoppedCP != "" {
+			repointContext(api, *cc, stoppedCP)
+		}
+
+		if len(nodes) > 1 {
+			out.T(out.Stopped, "Stopped {{.stopped}} of {{.total}} nodes", out.V{"stopped": len(nodes) - len(failed), "total": len(nodes)})
+		}
+		if len(failed) > 0 {
+			exit.WithCodeT(exit.Failure, "Failed to stop nodes: {{.names}}", out.V{"names": strings.Join(failed, ", ")})
 		}
-		out.T(out.Stopped, "Successfully stopped node {{.name}}", out.V{"name": machineName})
 	},
 }
 
+// stopOrder returns the nodes in the order they are stopped in: workers first, then control planes
+func stopOrder(nodes []config.Node) []config.Node {
+	ordered := make([]config.Node, len(nodes))
+	copy(ordered, nodes)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return !ordered[i].ControlPlane && ordered[j].ControlPlane
+	})
+	return ordered
+}
+
+// drainBeforeStop cordons and drains a node before it is stopped, warning about the pods left on it
+func drainBeforeStop(cc config.ClusterConfig, n config.Node) {
+	client, err := kapi.Client(cc.Name)
+	if err != nil {
+		out.WarningT("Unable to drain node {{.name}}: {{.error}}", out.V{"name": n.Name, "error": err})
+		return
+	}
+
+	kubeName := bsutil.KubeNodeName(cc, n)
+	out.T(out.Pause, "Cordoning node {{.name}} ...", out.V{"name": n.Name})
+	if err := node.Cordon(client, kubeName); err != nil {
+		out.WarningT("Unable to drain node {{.name}}: {{.error}}", out.V{"name": n.Name, "error": err})
+		return
+	}
+
+	out.T(out.Waiting, "Draining node {{.name}}, waiting up to {{.grace}} ...", out.V{"name": n.Name, "grace": drainGracePeriod})
+	stuck, err := node.DrainWithOptions(client, kubeName, drainGracePeriod, node.DrainOptions{DeleteEmptyDir: nodeStopDrainEmptyDir, Force: nodeStopDrainForce})
+	if err != nil {
+		out.WarningT("Unable to drain node {{.name}}: {{.error}}", out.V{"name": n.Name, "error": err})
+		return
+	}
+	if len(stuck) > 0 {
+		out.WarningT("Pods left on node {{.name}}: {{.pods}}", out.V{"name": n.Name, "pods": strings.Join(stuck, ", ")})
+	}
+	out.T(out.Tip, "Node {{.name}} stays cordoned once started again, until 'kubectl uncordon {{.kubeName}}'", out.V{"name": n.Name, "kubeName": kubeName})
+}
+
+// repointContext points kubectl at another control plane once the given one is stopped
+func repointContext(api libmachine.API, cc config.ClusterConfig, stopped string) {
+	name, hostname, port, err := healthyControlPlane(api, cc, stopped)
+	if err != nil {
+		glog.Infof("no other control plane to use: %v", err)
+		out.WarningT("kubectl will not be able to reach {{.cluster}} until a control plane node is started", out.V{"cluster": cc.Name})
+		return
+	}
+
+	updated, err := kubeconfig.UpdateEndpoint(cc.Name, hostname, port, kubeconfig.PathFromEnv())
+	if err != nil {
+		out.WarningT("Unable to point kubectl at control plane {{.name}}: {{.error}}", out.V{"name": name, "error": err})
+		return
+	}
+	if updated {
+		out.T(out.Celebrate, `"{{.context}}" context has been updated to point to control plane {{.name}}`, out.V{"context": cc.Name, "name": name})
+	}
+}
+
 func init() {
+	nodeStopCmd.Flags().BoolVar(&nodeStopDrain, "drain", false, "If set, cordon and drain the nodes before stopping them. Mirror and DaemonSet pods are left on the node, as are pods using emptyDir volumes or not managed by a controller.")
+	nodeStopCmd.Flags().BoolVar(&nodeStopDrainEmptyDir, "drain-delete-emptydir-data", false, "With --drain, also evict pods using emptyDir volumes, deleting their data.")
+	nodeStopCmd.Flags().BoolVar(&nodeStopDrainForce, "drain-force", false, "With --drain, also evict pods not managed by a controller, which are not recreated. DaemonSet pods are never evicted.")
+	nodeStopCmd.Flags().DurationVar(&drainGracePeriod, "drain-grace-period", 60*time.Second, "With --drain, how long to wait for pods to be evicted and to terminate.")
 	nodeCmd.AddCommand(nodeStopCmd)
 }
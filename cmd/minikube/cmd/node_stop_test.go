@@ -0,0 +1,59 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestStopOrder(t *testing.T) {
+	var tests = []struct {
+		name  string
+		nodes []config.Node
+		want  []string
+	}{
+		{
+			name:  "workers only",
+			nodes: []config.Node{{Name: "m03", Worker: true}, {Name: "m02", Worker: true}},
+			want:  []string{"m03", "m02"},
+		},
+		{
+			name:  "control plane first",
+			nodes: []config.Node{{Name: "", ControlPlane: true, Worker: true}, {Name: "m03", Worker: true}, {Name: "m02", Worker: true}},
+			want:  []string{"m03", "m02", ""},
+		},
+		{
+			name:  "several control planes",
+			nodes: []config.Node{{Name: "m02", ControlPlane: true}, {Name: "m04", Worker: true}, {Name: "m03", ControlPlane: true}},
+			want:  []string{"m04", "m02", "m03"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, n := range stopOrder(tc.nodes) {
+				got = append(got, n.Name)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("stopOrder(%+v) = %q, want: %q", tc.nodes, got, tc.want)
+			}
+		})
+	}
+}
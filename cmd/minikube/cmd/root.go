@@ -182,6 +182,7 @@ func init() {
 				dockerEnvCmd,
 				podmanEnvCmd,
 				cacheCmd,
+				imageCmd,
 			},
 		},
 		{
@@ -191,6 +192,8 @@ func init() {
 				configCmd.ConfigCmd,
 				configCmd.ProfileCmd,
 				updateContextCmd,
+				kubeconfigCmd,
+				clusterInfoCmd,
 			},
 		},
 		{
@@ -205,6 +208,7 @@ func init() {
 			Commands: []*cobra.Command{
 				mountCmd,
 				sshCmd,
+				cpCmd,
 				kubectlCmd,
 				nodeCmd,
 			},
@@ -215,6 +219,8 @@ func init() {
 				sshKeyCmd,
 				ipCmd,
 				logsCmd,
+				verifyCmd,
+				verifyCNICmd,
 				updateCheckCmd,
 				versionCmd,
 				optionsCmd,
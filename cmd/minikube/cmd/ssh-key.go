@@ -17,21 +17,129 @@ limitations under the License.
 package cmd
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/docker/machine/libmachine"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/node"
 	"k8s.io/minikube/pkg/minikube/out"
 )
 
+var sshKeyOutput string
+
+// SSHKeyInfo is what an external ssh client needs to connect to a node
+type SSHKeyInfo struct {
+	Node         string
+	IdentityFile string
+	User         string
+	Host         string
+	Port         int
+}
+
 // sshKeyCmd represents the sshKey command
 var sshKeyCmd = &cobra.Command{
 	Use:   "ssh-key",
 	Short: "Retrieve the ssh identity key path of the specified cluster",
-	Long:  "Retrieve the ssh identity key path of the specified cluster.",
+	Long:  "Retrieve the ssh identity key path of the specified cluster. With --node or --output=json, the user and host to connect to are reported as well.",
 	Run: func(cmd *cobra.Command, args []string) {
-		_, cc := mustload.Partial(ClusterFlagValue())
-		out.Ln(filepath.Join(localpath.MiniPath(), "machines", cc.Name, "id_rsa"))
+		output := strings.ToLower(sshKeyOutput)
+		if output != "text" && output != "json" {
+			exit.WithCodeT(exit.BadUsage, fmt.Sprintf("invalid output format: %s. Valid values: 'text', 'json'", sshKeyOutput))
+		}
+
+		api, cc := mustload.Partial(ClusterFlagValue())
+		defer api.Close()
+
+		if nodeName == "" && output == "text" {
+			out.Ln(filepath.Join(localpath.MiniPath(), "machines", cc.Name, "id_rsa"))
+			return
+		}
+		if driver.BareMetal(cc.Driver) {
+			exit.UsageT("'none' driver does not support 'minikube ssh-key --node' or '--output=json'")
+		}
+
+		nodes := cc.Nodes
+		if nodeName != "" {
+			n, _, err := node.Retrieve(*cc, nodeName)
+			if err != nil {
+				exit.WithCodeT(exit.Unavailable, "Node {{.nodeName}} does not exist.", out.V{"nodeName": nodeName})
+			}
+			nodes = []config.Node{*n}
+		}
+
+		var infos []SSHKeyInfo
+		for _, n := range nodes {
+			info, err := sshKeyInfo(api, *cc, n)
+			if err != nil {
+				exit.WithError("getting ssh connection info", err)
+			}
+			infos = append(infos, info)
+		}
+
+		if output == "json" {
+			if err := sshKeyJSON(infos, os.Stdout); err != nil {
+				exit.WithError("ssh-key json failure", err)
+			}
+			return
+		}
+		sshKeyText(infos[0], os.Stdout)
 	},
 }
+
+// sshKeyInfo returns the connection info of a node, the host and port are only known while the node is running
+func sshKeyInfo(api libmachine.API, cc config.ClusterConfig, n config.Node) (SSHKeyInfo, error) {
+	machineName := driver.MachineName(cc, n)
+	h, err := machine.LoadHost(api, machineName)
+	if err != nil {
+		return SSHKeyInfo{}, errors.Wrapf(err, "load host %s", machineName)
+	}
+
+	info := SSHKeyInfo{
+		Node:         machineName,
+		IdentityFile: h.Driver.GetSSHKeyPath(),
+		User:         h.Driver.GetSSHUsername(),
+	}
+	if !machine.IsRunning(api, machineName) {
+		glog.Infof("%s is not running, not reporting its ssh host", machineName)
+		return info, nil
+	}
+
+	if info.Host, err = h.Driver.GetSSHHostname(); err != nil {
+		return info, errors.Wrapf(err, "ssh host of %s", machineName)
+	}
+	if info.Port, err = h.Driver.GetSSHPort(); err != nil {
+		return info, errors.Wrapf(err, "ssh port of %s", machineName)
+	}
+	return info, nil
+}
+
+func sshKeyText(info SSHKeyInfo, w io.Writer) {
+	fmt.Fprintf(w, "IdentityFile: %s\nUser: %s\nHost: %s\nPort: %d\n", info.IdentityFile, info.User, info.Host, info.Port)
+}
+
+func sshKeyJSON(infos []SSHKeyInfo, w io.Writer) error {
+	js, err := json.Marshal(infos)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(js)
+	return err
+}
+
+func init() {
+	sshKeyCmd.Flags().StringVarP(&nodeName, "node", "n", "", "The node to report the ssh connection info of.")
+	sshKeyCmd.Flags().StringVarP(&sshKeyOutput, "output", "o", "text", "Output format. One of: text, json. With json, the connection info of every node is reported unless --node is set.")
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSSHKeyOutput(t *testing.T) {
+	running := SSHKeyInfo{Node: "p1-m03", IdentityFile: "/home/u/.minikube/machines/p1-m03/id_rsa", User: "docker", Host: "127.0.0.1", Port: 32771}
+	stopped := SSHKeyInfo{Node: "p1-m02", IdentityFile: "/home/u/.minikube/machines/p1-m02/id_rsa", User: "docker"}
+
+	var tests = []struct {
+		name     string
+		info     SSHKeyInfo
+		wantText string
+		wantJSON string
+	}{
+		{
+			name:     "running",
+			info:     running,
+			wantText: "IdentityFile: /home/u/.minikube/machines/p1-m03/id_rsa\nUser: docker\nHost: 127.0.0.1\nPort: 32771\n",
+			wantJSON: `[{"Node":"p1-m03","IdentityFile":"/home/u/.minikube/machines/p1-m03/id_rsa","User":"docker","Host":"127.0.0.1","Port":32771}]`,
+		},
+		{
+			name:     "stopped",
+			info:     stopped,
+			wantText: "IdentityFile: /home/u/.minikube/machines/p1-m02/id_rsa\nUser: docker\nHost: \nPort: 0\n",
+			wantJSON: `[{"Node":"p1-m02","IdentityFile":"/home/u/.minikube/machines/p1-m02/id_rsa","User":"docker","Host":"","Port":0}]`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var b bytes.Buffer
+			sshKeyText(tc.info, &b)
+			if got := b.String(); got != tc.wantText {
+				t.Errorf("text(%+v) = %q, want: %q", tc.info, got, tc.wantText)
+			}
+
+			b.Reset()
+			if err := sshKeyJSON([]SSHKeyInfo{tc.info}, &b); err != nil {
+				t.Errorf("json(%+v) error: %v", tc.info, err)
+			}
+			if got := b.String(); got != tc.wantJSON {
+				t.Errorf("json(%+v) = %q, want: %q", tc.info, got, tc.wantJSON)
+			}
+		})
+	}
+}
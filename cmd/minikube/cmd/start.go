@@ -25,8 +25,11 @@ import (
 	"os"
 	"os/exec"
 	"os/user"
+	"path"
 	"runtime"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/blang/semver"
 	"github.com/docker/machine/libmachine/ssh"
@@ -42,7 +45,9 @@ import (
 	"github.com/spf13/viper"
 	cmdcfg "k8s.io/minikube/cmd/minikube/cmd/config"
 	"k8s.io/minikube/pkg/drivers/kic/oci"
+	"k8s.io/minikube/pkg/kapi"
 	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
 	"k8s.io/minikube/pkg/minikube/bootstrapper/images"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
@@ -53,12 +58,15 @@ import (
 	"k8s.io/minikube/pkg/minikube/kubeconfig"
 	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/metrics"
 	"k8s.io/minikube/pkg/minikube/mustload"
 	"k8s.io/minikube/pkg/minikube/node"
 	"k8s.io/minikube/pkg/minikube/notify"
 	"k8s.io/minikube/pkg/minikube/out"
 	"k8s.io/minikube/pkg/minikube/registry"
 	"k8s.io/minikube/pkg/minikube/translate"
+	"k8s.io/minikube/pkg/minikube/verify"
+	"k8s.io/minikube/pkg/minikube/vmpath"
 	"k8s.io/minikube/pkg/util"
 	"k8s.io/minikube/pkg/version"
 )
@@ -68,8 +76,13 @@ var (
 	insecureRegistry []string
 	apiServerNames   []string
 	apiServerIPs     []net.IP
+	sysctls          []string
+	metricsOutput    string
 )
 
+// deleteFailedNodes is the --delete-on-failure value which only deletes the nodes that failed to start
+const deleteFailedNodes = "node"
+
 func init() {
 	initMinikubeFlags()
 	initKubernetesFlags()
@@ -122,6 +135,21 @@ func platform() string {
 
 // runStart handles the executes the flow of "minikube start"
 func runStart(cmd *cobra.Command, args []string) {
+	begin := time.Now()
+
+	outputFormat := strings.ToLower(viper.GetString(startOutput))
+	switch outputFormat {
+	case "text":
+	case "json":
+		// stdout is reserved for the summary
+		out.SetOutFile(os.Stderr)
+		exit.SetOnFailure(func(code int, msg string) {
+			writeStartJSON(failedStartSummary(ClusterFlagValue(), viper.GetString("driver"), code, msg, time.Since(begin)), os.Stdout)
+		})
+	default:
+		exit.UsageT("Invalid --output value {{.output}}. Valid values: text, json", out.V{"output": viper.GetString(startOutput)})
+	}
+
 	displayVersion(version.GetVersion())
 
 	// No need to do the update check if no one is going to see it
@@ -156,7 +184,7 @@ func runStart(cmd *cobra.Command, args []string) {
 	ds, alts, specified := selectDriver(existing)
 	starter, err := provisionWithDriver(cmd, ds, existing)
 	if err != nil {
-		node.MaybeExitWithAdvice(err)
+		node.MaybeExitWithAdvice(err, starter.Cfg, starter.Node)
 		machine.MaybeDisplayAdvice(err, viper.GetString("driver"))
 		if specified {
 			// If the user specified a driver, don't fallback to anything else
@@ -194,7 +222,7 @@ func runStart(cmd *cobra.Command, args []string) {
 
 	kubeconfig, err := startWithDriver(starter, existing)
 	if err != nil {
-		node.MaybeExitWithAdvice(err)
+		node.MaybeExitWithAdvice(err, starter.Cfg, starter.Node)
 		exit.WithError("failed to start node", err)
 	}
 
@@ -202,6 +230,91 @@ func runStart(cmd *cobra.Command, args []string) {
 		glog.Errorf("kubectl info: %v", err)
 	}
 
+	if metricsOutput != "" {
+		metrics.Record(metrics.StartDuration, time.Since(begin), map[string]string{"profile": starter.Cfg.Name})
+		if err := metrics.Write(metricsOutput); err != nil {
+			out.WarningT("Unable to write metrics to {{.path}}: {{.error}}", out.V{"path": metricsOutput, "error": err})
+		}
+	}
+
+	if viper.GetBool(verifyNetwork) {
+		verifyNodeNetwork(*starter.Cfg)
+	}
+
+	if hook := viper.GetString(onReady); hook != "" {
+		runOnReadyHook(hook, *starter.Cfg)
+	}
+
+	if outputFormat == "json" {
+		cc := starter.Cfg
+		// the saved config has the addresses of every node
+		if saved, err := config.Load(cc.Name); err == nil {
+			cc = saved
+		}
+		writeStartJSON(newStartSummary(*cc, time.Since(begin)), os.Stdout)
+	}
+}
+
+// verifyNodeNetwork checks that pods on every node can reach each other and the service network, exiting if they cannot
+func verifyNodeNetwork(cc config.ClusterConfig) {
+	client, err := kapi.Client(cc.Name)
+	if err != nil {
+		exit.WithError("Failed to get kubernetes client", err)
+	}
+
+	if err := kverify.WaitForNodeReady(client, viper.GetDuration(waitTimeout)); err != nil {
+		exit.WithCodeT(exit.Unavailable, "Unable to verify the cluster network, as not all nodes became Ready: {{.error}}", out.V{"error": err})
+	}
+
+	out.T(out.Verifying, "Verifying node-to-node connectivity ...")
+	results := verify.Run(client, verify.NetworkChecks(), viper.GetDuration(waitTimeout))
+	for _, r := range results {
+		switch {
+		case r.Err != nil:
+			out.T(out.FailureType, "FAIL: {{.check}}: {{.error}}", out.V{"check": r.Name, "error": r.Err})
+		case r.Skipped != "":
+			out.T(out.Option, "SKIP: {{.check}}: {{.reason}}", out.V{"check": r.Name, "reason": r.Skipped})
+		}
+	}
+	if verify.Failed(results) > 0 {
+		exit.WithCodeT(exit.Unavailable, "The cluster network is not healthy. Run 'minikube verify -p {{.name}}' once it has been fixed.", out.V{"name": cc.Name})
+	}
+}
+
+// runOnReadyHook runs the user supplied hook once every node is Ready, a failing hook leaves the cluster running
+func runOnReadyHook(hook string, cc config.ClusterConfig) {
+	client, err := kapi.Client(cc.Name)
+	if err != nil {
+		exit.WithError("Failed to get kubernetes client", err)
+	}
+	if err := kverify.WaitForNodeReady(client, viper.GetDuration(waitTimeout)); err != nil {
+		exit.WithCodeT(exit.Unavailable, "Not running the --on-ready hook, as not all nodes became Ready: {{.error}}", out.V{"error": err})
+	}
+
+	out.T(out.Launch, "Running the --on-ready hook: {{.hook}}", out.V{"hook": hook})
+	c := exec.Command(hook)
+	c.Env = append(os.Environ(), onReadyEnv(cc, kubeconfig.PathFromEnv())...)
+	c.Stdout = os.Stdout
+	if strings.ToLower(viper.GetString(startOutput)) == "json" {
+		c.Stdout = os.Stderr
+	}
+	c.Stderr = os.Stderr
+	if err := c.Run(); err != nil {
+		exit.WithCodeT(exit.Failure, "The --on-ready hook failed, the cluster was left running: {{.error}}", out.V{"error": err})
+	}
+}
+
+// onReadyEnv returns the environment variables describing the cluster to the --on-ready hook
+func onReadyEnv(cc config.ClusterConfig, kubeconfigPath string) []string {
+	var nodes []string
+	for _, n := range cc.Nodes {
+		nodes = append(nodes, driver.MachineName(cc, n))
+	}
+	return []string{
+		fmt.Sprintf("%s=%s", constants.KubeconfigEnvVar, kubeconfigPath),
+		fmt.Sprintf("MINIKUBE_PROFILE=%s", cc.Name),
+		fmt.Sprintf("MINIKUBE_NODES=%s", strings.Join(nodes, ",")),
+	}
 }
 
 func provisionWithDriver(cmd *cobra.Command, ds registry.DriverState, existing *config.ClusterConfig) (node.Starter, error) {
@@ -213,7 +326,7 @@ func provisionWithDriver(cmd *cobra.Command, ds registry.DriverState, existing *
 		glog.Errorf("Error autoSetOptions : %v", err)
 	}
 
-	validateFlags(cmd, driverName)
+	validateFlags(cmd, driverName, existing)
 	validateUser(driverName)
 
 	// Download & update the driver, even in --download-only mode
@@ -230,6 +343,10 @@ func provisionWithDriver(cmd *cobra.Command, ds registry.DriverState, existing *
 	// This is about as far as we can go without overwriting config files
 	if viper.GetBool(dryRun) {
 		out.T(out.DryRun, `dry-run validation complete!`)
+		// the summary describes the cluster which would have been started
+		if strings.ToLower(viper.GetString(startOutput)) == "json" {
+			writeStartJSON(newStartSummary(cc, 0), os.Stdout)
+		}
 		os.Exit(0)
 	}
 
@@ -251,7 +368,8 @@ func provisionWithDriver(cmd *cobra.Command, ds registry.DriverState, existing *
 
 	mRunner, preExists, mAPI, host, err := node.Provision(&cc, &n, true, viper.GetBool(deleteOnFailure))
 	if err != nil {
-		return node.Starter{}, err
+		// the config is returned along with the error, so that the advice on it can refer to the requested resources
+		return node.Starter{Cfg: &cc, Node: &n}, err
 	}
 
 	if viper.GetBool(nativeSSH) {
@@ -280,53 +398,90 @@ func startWithDriver(starter node.Starter, existing *config.ClusterConfig) (*kub
 		}
 	}
 
-	numNodes := viper.GetInt(nodes)
+	numNodes := requestedNodes()
+	names := viper.GetStringSlice(nodeNames)
+	// nodes deleted because of --delete-on-failure=node
+	var failed []string
+	// nodes restored by --preserve-node-config are part of the config before they are started
+	restored := existing == nil && len(starter.Cfg.Nodes) > 1
+	if restored {
+		numNodes = len(starter.Cfg.Nodes)
+	}
 	if existing != nil {
 		if numNodes > 1 {
 			// We ignore the --nodes parameter if we're restarting an existing cluster
 			out.WarningT(`The cluster {{.cluster}} already exists which means the --nodes parameter will be ignored. Use "minikube node add" to add nodes to an existing cluster.`, out.V{"cluster": existing.Name})
 		}
+		if len(names) > 0 {
+			out.WarningT(`The cluster {{.cluster}} already exists which means the --node-names parameter will be ignored.`, out.V{"cluster": existing.Name})
+		}
 		numNodes = len(existing.Nodes)
 	}
 	if numNodes > 1 {
 		if driver.BareMetal(starter.Cfg.Driver) {
 			exit.WithCodeT(exit.Config, "The none driver is not compatible with multi-node clusters.")
 		} else {
-			// Only warn users on first start.
-			if existing == nil {
+			var toAdd []config.Node
+			switch {
+			case existing != nil:
+				// Only warn users on first start.
+				toAdd = existing.Nodes
+			case restored:
+				out.Ln("")
+				warnAboutMultiNode()
+				// copied, as failed nodes are removed from the config
+				toAdd = append(toAdd, starter.Cfg.Nodes...)
+			default:
 				out.Ln("")
 				warnAboutMultiNode()
-
 				for i := 1; i < numNodes; i++ {
-					nodeName := node.Name(i + 1)
-					n := config.Node{
-						Name:              nodeName,
+					toAdd = append(toAdd, config.Node{
+						Name:              node.NameFor(i+1, names),
 						Worker:            true,
 						ControlPlane:      false,
 						KubernetesVersion: starter.Cfg.KubernetesConfig.KubernetesVersion,
-					}
+					})
+				}
+			}
+
+			for _, n := range toAdd {
+				if n.ControlPlane {
+					continue
+				}
+				if existing == nil {
 					out.Ln("") // extra newline for clarity on the command line
-					err := node.Add(starter.Cfg, n, viper.GetBool(deleteOnFailure))
-					if err != nil {
-						return nil, errors.Wrap(err, "adding node")
-					}
 				}
-			} else {
-				for _, n := range existing.Nodes {
-					if !n.ControlPlane {
-						err := node.Add(starter.Cfg, n, viper.GetBool(deleteOnFailure))
-						if err != nil {
-							return nil, errors.Wrap(err, "adding node")
-						}
+				err := node.Add(starter.Cfg, n, viper.GetBool(deleteOnFailure))
+				if err != nil {
+					if viper.GetString(deleteOnFailure) != deleteFailedNodes {
+						return nil, errors.Wrap(err, "adding node")
 					}
+					deleteFailedNode(starter.Cfg, n, err)
+					failed = append(failed, n.Name)
 				}
 			}
 		}
 	}
 
+	if starter.Cfg.KubernetesConfig.ReserveControlPlane && len(starter.Cfg.Nodes) == 1 {
+		out.WarningT("The control plane of {{.cluster}} is reserved for system workloads, so user workloads will stay Pending until a worker is added with 'minikube node add'.", out.V{"cluster": starter.Cfg.Name})
+	}
+
+	if len(failed) > 0 {
+		out.WarningT("The cluster {{.cluster}} is degraded: {{.nodes}} failed to start and were deleted. Use 'minikube node add' to try again.", out.V{"cluster": starter.Cfg.Name, "nodes": strings.Join(failed, ", ")})
+	}
+
 	return kubeconfig, nil
 }
 
+// requestedNodes returns the number of nodes requested by either --nodes or --node-names
+func requestedNodes() int {
+	if names := viper.GetStringSlice(nodeNames); len(names) > 0 {
+		return len(names)
+	}
+	return viper.GetInt(nodes)
+}
+
 func warnAboutMultiNode() {
 	out.WarningT("Multi-node clusters are currently experimental and might exhibit unintended behavior.")
 	out.T(out.Documentation, "To track progress on multi-node clusters, see https://github.com/kubernetes/minikube/issues/7538.")
@@ -401,6 +556,27 @@ func showKubectlInfo(kcs *kubeconfig.Settings, k8sVersion string, machineName st
 	return nil
 }
 
+// deleteFailedNode deletes a worker which failed to join, keeping the rest of the cluster
+func deleteFailedNode(cc *config.ClusterConfig, n config.Node, originalErr error) {
+	out.WarningT("Node {{.name}} failed to start, deleting it: {{.error}}", out.V{"name": n.Name, "error": originalErr})
+
+	// node.Delete shifts the nodes of the config it is given in place, so hand it a copy
+	c := *cc
+	c.Nodes = append([]config.Node{}, cc.Nodes...)
+	if _, err := node.Delete(c, n.Name); err != nil {
+		glog.Warningf("unable to delete node %s: %v", n.Name, err)
+		out.WarningT("Failed to delete node {{.name}}, remove it with 'minikube node delete {{.name}}'.", out.V{"name": n.Name})
+	}
+
+	var kept []config.Node
+	for _, cn := range cc.Nodes {
+		if cn.Name != n.Name {
+			kept = append(kept, cn)
+		}
+	}
+	cc.Nodes = kept
+}
+
 func maybeDeleteAndRetry(cc config.ClusterConfig, n config.Node, existingAddons map[string]bool, originalErr error) (*kubeconfig.Settings, error) {
 	if viper.GetBool(deleteOnFailure) {
 		out.WarningT("Node {{.name}} failed to start, deleting and trying again.", out.V{"name": n.Name})
@@ -521,8 +697,7 @@ func selectDriver(existing *config.ClusterConfig) (registry.DriverState, []regis
 		for _, r := range rejects {
 			out.T(out.Option, "{{ .name }}: {{ .rejection }}", out.V{"name": r.Name, "rejection": r.Rejection})
 		}
-		out.T(out.Workaround, "Try specifying a --driver, or see https://minikube.sigs.k8s.io/docs/start/")
-		os.Exit(exit.Unavailable)
+		exit.WithCodeT(exit.Unavailable, "Unable to pick a default driver. Try specifying a --driver, or see https://minikube.sigs.k8s.io/docs/start/")
 	}
 
 	if len(alts) > 1 {
@@ -724,7 +899,7 @@ func validateUser(drvName string) {
 	out.ErrT(out.Documentation, "  https://minikube.sigs.k8s.io/docs/reference/drivers/none/")
 
 	if !useForce {
-		os.Exit(exit.Permissions)
+		exit.WithCodeT(exit.Permissions, `Refusing to use the "{{.driver_name}}" driver with root privileges, use --force to use it anyway.`, out.V{"driver_name": drvName})
 	}
 	cname := ClusterFlagValue()
 	_, err = config.Load(cname)
@@ -755,6 +930,106 @@ func memoryLimits(drvName string) (int, int, error) {
 	return sysLimit, containerLimit, nil
 }
 
+// cpuLimits returns the number of CPUs available to the driver
+func cpuLimits(drvName string) (int, error) {
+	if driver.IsKIC(drvName) {
+		s, err := oci.DaemonInfo(drvName)
+		if err != nil {
+			return -1, err
+		}
+		return s.CPUs, nil
+	}
+	return cpu.Counts(true)
+}
+
+// isRelativeSize returns whether a CPU or memory size is relative to what the host has available: "max", its alias "no-limit", or a percentage
+func isRelativeSize(value string) bool {
+	v := strings.ToLower(strings.TrimSpace(value))
+	return v == "max" || v == "no-limit" || strings.HasSuffix(v, "%")
+}
+
+// resolveRelativeSize returns the part of available requested by a relative size, which is at least 1
+func resolveRelativeSize(value string, available int) (int, error) {
+	if available <= 0 {
+		return 0, fmt.Errorf("unable to determine the available amount to resolve %q against", value)
+	}
+	v := strings.ToLower(strings.TrimSpace(value))
+	if v == "max" || v == "no-limit" {
+		return available, nil
+	}
+	pct, err := strconv.Atoi(strings.TrimSuffix(v, "%"))
+	if err != nil || pct < 1 || pct > 100 {
+		return 0, fmt.Errorf("invalid percentage %q, must be between 1%% and 100%%", value)
+	}
+	if size := available * pct / 100; size > 0 {
+		return size, nil
+	}
+	return 1, nil
+}
+
+// parseMemory returns the memory in MB requested by value, resolving relative sizes against what the driver has available
+func parseMemory(drvName string, value string) (int, error) {
+	if !isRelativeSize(value) {
+		return util.CalculateSizeInMB(value)
+	}
+	sysLimit, containerLimit, err := memoryLimits(drvName)
+	if err != nil {
+		return 0, errors.Wrap(err, "memory limits")
+	}
+	if containerLimit > 0 {
+		return resolveRelativeSize(value, containerLimit)
+	}
+	return resolveRelativeSize(value, sysLimit)
+}
+
+// parseCPUs returns the number of CPUs requested by value, resolving relative sizes against what the driver has available
+func parseCPUs(drvName string, value string) (int, error) {
+	if !isRelativeSize(value) {
+		return strconv.Atoi(strings.TrimSpace(value))
+	}
+	available, err := cpuLimits(drvName)
+	if err != nil {
+		return 0, errors.Wrap(err, "cpu limits")
+	}
+	return resolveRelativeSize(value, available)
+}
+
+// checkHostCapacity returns an error if nodes of the requested size do not fit in what is available, 0 meaning unknown
+func checkHostCapacity(resource string, unit string, nodes int, perNode int, available int) error {
+	if available <= 0 || nodes*perNode <= available {
+		return nil
+	}
+	return fmt.Errorf("%d nodes x %d%s = %d%s of %s requested, but only %d%s is available", nodes, perNode, unit, nodes*perNode, unit, resource, available, unit)
+}
+
+// validateHostResources checks that the memory and CPUs of every node to be created fit on the host.
+// Memory which was explicitly requested is enforced unless --force is set, everything else is only warned about.
+func validateHostResources(drvName string, nodes int, mem int, ncpus int, sysLimit int, containerLimit int, memRequested bool) {
+	if nodes < 2 || !driver.HasResourceLimits(drvName) {
+		return
+	}
+
+	availMem := sysLimit
+	if containerLimit > 0 {
+		availMem = containerLimit
+	}
+	if err := checkHostCapacity("memory", "MB", nodes, mem, availMem); err != nil {
+		if memRequested && !viper.GetBool(force) {
+			exit.WithCodeT(exit.Config, "Not enough memory for {{.nodes}} nodes: {{.error}}. Lower --memory or --nodes, or use --force to start anyway.", out.V{"nodes": nodes, "error": err})
+		}
+		out.WarningT("Nodes may run out of memory: {{.error}}", out.V{"error": err})
+	}
+
+	availCPUs, err := cpuLimits(drvName)
+	if err != nil {
+		glog.Warningf("Unable to query CPU limits: %v", err)
+		return
+	}
+	if err := checkHostCapacity("CPUs", "", nodes, ncpus, availCPUs); err != nil {
+		out.WarningT("Nodes will compete for CPUs: {{.error}}", out.V{"error": err})
+	}
+}
+
 // suggestMemoryAllocation calculates the default memory footprint in MB
 func suggestMemoryAllocation(sysLimit int, containerLimit int, nodes int) int {
 	if mem := viper.GetInt(memory); mem != 0 {
@@ -794,8 +1069,8 @@ func suggestMemoryAllocation(sysLimit int, containerLimit int, nodes int) int {
 }
 
 // validateMemorySize validates the memory size matches the minimum recommended
-func validateMemorySize() {
-	req, err := util.CalculateSizeInMB(viper.GetString(memory))
+func validateMemorySize(drvName string) {
+	req, err := parseMemory(drvName, viper.GetString(memory))
 	if err != nil {
 		exit.WithCodeT(exit.Config, "Unable to parse memory '{{.memory}}': {{.error}}", out.V{"memory": viper.GetString(memory), "error": err})
 	}
@@ -810,9 +1085,9 @@ func validateMemorySize() {
 }
 
 // validateCPUCount validates the cpu count matches the minimum recommended
-func validateCPUCount(local bool) {
+func validateCPUCount(drvName string) {
 	var cpuCount int
-	if local {
+	if driver.BareMetal(drvName) {
 		// Uses the gopsutil cpu package to count the number of physical cpu cores
 		ci, err := cpu.Counts(false)
 		if err != nil {
@@ -821,15 +1096,19 @@ func validateCPUCount(local bool) {
 			cpuCount = ci
 		}
 	} else {
-		cpuCount = viper.GetInt(cpus)
+		n, err := parseCPUs(drvName, viper.GetString(cpus))
+		if err != nil {
+			exit.UsageT("Unable to parse cpus '{{.cpus}}': {{.error}}", out.V{"cpus": viper.GetString(cpus), "error": err})
+		}
+		cpuCount = n
 	}
 	if cpuCount < minimumCPUS && !viper.GetBool(force) {
 		exit.UsageT("Requested cpu count {{.requested_cpus}} is less than the minimum allowed of {{.minimum_cpus}}", out.V{"requested_cpus": cpuCount, "minimum_cpus": minimumCPUS})
 	}
 }
 
-// validateFlags validates the supplied flags against known bad combinations
-func validateFlags(cmd *cobra.Command, drvName string) {
+// validateFlags validates the supplied flags against known bad combinations, taking the settings not given as flags from the existing cluster if any
+func validateFlags(cmd *cobra.Command, drvName string, existing *config.ClusterConfig) {
 	if cmd.Flags().Changed(humanReadableDiskSize) {
 		diskSizeMB, err := util.CalculateSizeInMB(viper.GetString(humanReadableDiskSize))
 		if err != nil {
@@ -841,15 +1120,22 @@ func validateFlags(cmd *cobra.Command, drvName string) {
 		}
 	}
 
+	if cmd.Flags().Changed(deleteOnFailure) {
+		v := viper.GetString(deleteOnFailure)
+		if _, err := strconv.ParseBool(v); err != nil && v != deleteFailedNodes {
+			exit.UsageT("Invalid --delete-on-failure value {{.value}}. Valid values: true, false, node", out.V{"value": v})
+		}
+	}
+
 	if cmd.Flags().Changed(cpus) {
-		validateCPUCount(driver.BareMetal(drvName))
+		validateCPUCount(drvName)
 		if !driver.HasResourceLimits(drvName) {
 			out.WarningT("The '{{.name}}' driver does not respect the --cpus flag", out.V{"name": drvName})
 		}
 	}
 
 	if cmd.Flags().Changed(memory) {
-		validateMemorySize()
+		validateMemorySize(drvName)
 		if !driver.HasResourceLimits(drvName) {
 			out.WarningT("The '{{.name}}' driver does not respect the --memory flag", out.V{"name": drvName})
 		}
@@ -920,7 +1206,96 @@ func validateFlags(cmd *cobra.Command, drvName string) {
 		}
 	}
 
+	if cmd.Flags().Changed(cgroupDriver) {
+		validateCgroupDriver(viper.GetString(cgroupDriver))
+	}
+
+	if cmd.Flags().Changed(nodeNames) {
+		names := viper.GetStringSlice(nodeNames)
+		if err := node.ValidateNames(names); err != nil {
+			exit.UsageT("Invalid --node-names: {{.error}}", out.V{"error": err})
+		}
+		if cmd.Flags().Changed(nodes) && viper.GetInt(nodes) != len(names) {
+			exit.UsageT("The --nodes value ({{.nodes}}) does not match the number of --node-names ({{.names}})", out.V{"nodes": viper.GetInt(nodes), "names": len(names)})
+		}
+	}
+
+	if max := viper.GetInt(maxNodes); max < 0 {
+		exit.UsageT("Invalid --max-nodes: {{.max}}, it must be 0 or more", out.V{"max": max})
+	} else if max > 0 && requestedNodes() > max {
+		exit.UsageT("{{.nodes}} nodes were requested, but --max-nodes is {{.max}}", out.V{"nodes": requestedNodes(), "max": max})
+	}
+
+	if invalid := bsutil.FindInvalidEvictionThresholds(config.ExtraOptions); len(invalid) > 0 {
+		exit.UsageT("These kubelet eviction thresholds are invalid: {{.thresholds}}. Thresholds must be of the form signal<quantity, for example memory.available<200Mi", out.V{"thresholds": strings.Join(invalid, ", ")})
+	}
+
+	if err := node.ValidateSysctls(sysctls); err != nil {
+		exit.UsageT("Invalid --sysctl: {{.error}}", out.V{"error": err})
+	}
+
+	if hook := viper.GetString(onReady); hook != "" {
+		if _, err := exec.LookPath(hook); err != nil {
+			exit.UsageT("The --on-ready hook {{.hook}} is not executable: {{.error}}", out.V{"hook": hook, "error": err})
+		}
+	}
+
 	validateRegistryMirror()
+
+	if err := validateBinaryMirror(viper.GetString(binaryMirror)); err != nil {
+		exit.UsageT("Invalid --binary-mirror: {{.error}}", out.V{"error": err})
+	}
+
+	// a /25 is the smallest pod CIDR which holds the 110 pods the kubelet runs per node by default
+	if m := viper.GetInt(nodeCIDRMaskSize); m != 0 && (m < 8 || m > 25) {
+		exit.UsageT("Invalid --node-cidr-mask-size: {{.size}}, it must be between 8 and 25 to hold the 110 pods of each node", out.V{"size": m})
+	}
+
+	if dir := viper.GetString(etcdDataDir); dir != "" {
+		if !path.IsAbs(dir) {
+			exit.UsageT("Invalid --etcd-data-dir {{.dir}}, it must be an absolute path", out.V{"dir": dir})
+		}
+		if driver.IsVM(drvName) && !strings.HasPrefix(path.Clean(dir), vmpath.GuestPersistentDir+"/") {
+			out.WarningT("{{.dir}} is outside of {{.persistent}}, so the data of etcd will not survive a restart of the VM", out.V{"dir": dir, "persistent": vmpath.GuestPersistentDir})
+		}
+	}
+
+	if viper.GetInt64(etcdQuotaBackendBytes) < 0 {
+		exit.UsageT("Invalid --etcd-quota-backend-bytes: {{.quota}}, it can not be negative", out.V{"quota": viper.GetInt64(etcdQuotaBackendBytes)})
+	}
+	// only the kubeadm configuration of v1.17 and later passes extra arguments to etcd
+	if viper.GetInt64(etcdQuotaBackendBytes) > 0 {
+		version, err := util.ParseKubernetesVersion(getKubernetesVersion(existing))
+		if err != nil {
+			exit.UsageT("Unable to parse Kubernetes version for --etcd-quota-backend-bytes: {{.error}}", out.V{"error": err})
+		}
+		if version.LT(semver.MustParse("1.17.0")) {
+			exit.UsageT("--etcd-quota-backend-bytes requires Kubernetes v1.17 or later, but {{.version}} was requested", out.V{"version": version.String()})
+		}
+	}
+
+	if cmd.Flags().Changed(containerLogMaxSize) || cmd.Flags().Changed(containerLogMaxFiles) {
+		if err := bsutil.ValidateContainerLogRotation(viper.GetString(containerLogMaxSize), viper.GetInt(containerLogMaxFiles)); err != nil {
+			exit.UsageT("Invalid container log rotation: {{.error}}", out.V{"error": err})
+		}
+		runtime := viper.GetString(containerRuntime)
+		if !cmd.Flags().Changed(containerRuntime) && existing != nil {
+			runtime = existing.KubernetesConfig.ContainerRuntime
+		}
+		if runtime == "docker" {
+			exit.UsageT("The docker runtime rotates container logs itself, use --docker-opt log-opt=max-size=<size> instead of --container-log-max-size and --container-log-max-files")
+		}
+	}
+}
+
+// validateCgroupDriver validates that the requested cgroup driver is supported
+func validateCgroupDriver(name string) {
+	for _, d := range cruntime.ValidCgroupDrivers() {
+		if name == d {
+			return
+		}
+	}
+	exit.UsageT(`Invalid cgroup driver: "{{.driver}}". Valid cgroup drivers are: {{.valid}}`, out.V{"driver": name, "valid": strings.Join(cruntime.ValidCgroupDrivers(), ", ")})
 }
 
 // This function validates if the --registry-mirror
@@ -941,6 +1316,56 @@ func validateRegistryMirror() {
 	}
 }
 
+// validateBinaryMirror validates the location Kubernetes binaries are downloaded from, empty meaning the release bucket
+func validateBinaryMirror(loc string) error {
+	if loc == "" {
+		return nil
+	}
+	u, err := url.Parse(loc)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "file" {
+		return fmt.Errorf("%s must be an http, https or file URL", loc)
+	}
+	return nil
+}
+
+// preservedNodes returns the nodes of the last deleted cluster with the same name, ready to be recreated
+func preservedNodes(cc config.ClusterConfig) []config.Node {
+	saved, err := config.LoadNodeConfig(cc.Name)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			out.WarningT("Unable to load the node config of {{.cluster}}: {{.error}}", out.V{"cluster": cc.Name, "error": err})
+		} else {
+			out.WarningT("No node config was kept for {{.cluster}}, ignoring --preserve-node-config", out.V{"cluster": cc.Name})
+		}
+		return nil
+	}
+
+	var nodes []config.Node
+	cps := 0
+	for _, n := range saved {
+		n.KubernetesVersion = getKubernetesVersion(&cc)
+		if n.ControlPlane {
+			cps++
+		}
+		// only the primary control plane is created by start, the other nodes are joined like the nodes of --nodes,
+		// which can not join as control planes
+		if n.ControlPlane && cps > 1 {
+			out.WarningT("Recreating control plane {{.name}} as a worker, as clusters have a single control plane", out.V{"name": n.Name})
+			n.ControlPlane = false
+			n.Worker = true
+		}
+		if n.ControlPlane {
+			n.Port = cc.KubernetesConfig.NodePort
+		}
+		nodes = append(nodes, n)
+	}
+	out.T(out.Check, "Recreating {{.count}} nodes from the node config of the deleted {{.cluster}} cluster", out.V{"count": len(nodes), "cluster": cc.Name})
+	return nodes
+}
+
 func createNode(cc config.ClusterConfig, kubeNodeName string, existing *config.ClusterConfig) (config.ClusterConfig, config.Node, error) {
 	// Create the initial node, which will necessarily be a control plane
 	if existing != nil {
@@ -949,12 +1374,17 @@ func createNode(cc config.ClusterConfig, kubeNodeName string, existing *config.C
 		if err != nil {
 			return cc, config.Node{}, err
 		}
+		if cc.KubernetesConfig.NodePort > 0 {
+			cp.Port = cc.KubernetesConfig.NodePort
+		}
 
-		// Make sure that existing nodes honor if KubernetesVersion gets specified on restart
-		// KubernetesVersion is the only attribute that the user can override in the Node object
+		// Make sure that existing nodes honor if KubernetesVersion or the apiserver port gets specified on restart
 		nodes := []config.Node{}
 		for _, n := range existing.Nodes {
 			n.KubernetesVersion = getKubernetesVersion(&cc)
+			if n.ControlPlane && cc.KubernetesConfig.NodePort > 0 {
+				n.Port = cc.KubernetesConfig.NodePort
+			}
 			nodes = append(nodes, n)
 		}
 		cc.Nodes = nodes
@@ -962,12 +1392,21 @@ func createNode(cc config.ClusterConfig, kubeNodeName string, existing *config.C
 		return cc, cp, nil
 	}
 
+	if viper.GetBool(preserveNodeConfig) {
+		if nodes := preservedNodes(cc); len(nodes) > 0 {
+			cc.Nodes = nodes
+			cp, err := config.PrimaryControlPlane(&cc)
+			return cc, cp, err
+		}
+	}
+
 	cp := config.Node{
 		Port:              cc.KubernetesConfig.NodePort,
 		KubernetesVersion: getKubernetesVersion(&cc),
 		Name:              kubeNodeName,
 		ControlPlane:      true,
 		Worker:            true,
+		CgroupDriver:      viper.GetString(cgroupDriver),
 	}
 	cc.Nodes = []config.Node{cp}
 	return cc, cp, nil
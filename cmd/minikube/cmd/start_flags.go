@@ -36,6 +36,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/download"
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/node"
 	"k8s.io/minikube/pkg/minikube/out"
 	"k8s.io/minikube/pkg/minikube/proxy"
 	pkgutil "k8s.io/minikube/pkg/util"
@@ -74,6 +75,8 @@ const (
 	dnsDomain               = "dns-domain"
 	serviceCIDR             = "service-cluster-ip-range"
 	imageRepository         = "image-repository"
+	binaryMirror            = "binary-mirror"
+	nodeCIDRMaskSize        = "node-cidr-mask-size"
 	imageMirrorCountry      = "image-mirror-country"
 	mountString             = "mount-string"
 	disableDriverMounts     = "disable-driver-mounts"
@@ -100,10 +103,24 @@ const (
 	hostOnlyNicType         = "host-only-nic-type"
 	natNicType              = "nat-nic-type"
 	nodes                   = "nodes"
+	nodeNames               = "node-names"
 	preload                 = "preload"
 	deleteOnFailure         = "delete-on-failure"
+	preserveNodeConfig      = "preserve-node-config"
+	reserveControlPlane     = "reserve-control-plane"
 	forceSystemd            = "force-systemd"
+	cgroupDriver            = "cgroup-driver"
+	sysctl                  = "sysctl"
+	prepullImages           = "prepull-images"
+	maxNodes                = "max-nodes"
+	onReady                 = "on-ready"
+	verifyNetwork           = "verify-network"
+	startOutput             = "output"
 	kicBaseImage            = "base-image"
+	containerLogMaxSize     = "container-log-max-size"
+	containerLogMaxFiles    = "container-log-max-files"
+	etcdDataDir             = "etcd-data-dir"
+	etcdQuotaBackendBytes   = "etcd-quota-backend-bytes"
 )
 
 // initMinikubeFlags includes commandline flags for minikube.
@@ -118,8 +135,8 @@ func initMinikubeFlags() {
 	startCmd.Flags().Bool(interactive, true, "Allow user prompts for more information")
 	startCmd.Flags().Bool(dryRun, false, "dry-run mode. Validates configuration, but does not mutate system state")
 
-	startCmd.Flags().Int(cpus, 2, "Number of CPUs allocated to Kubernetes.")
-	startCmd.Flags().String(memory, "", "Amount of RAM to allocate to Kubernetes (format: <number>[<unit>], where unit = b, k, m or g).")
+	startCmd.Flags().String(cpus, "2", "Number of CPUs allocated to Kubernetes. Use \"max\" or \"no-limit\" for every CPU available to the driver, or a percentage of them such as \"50%\".")
+	startCmd.Flags().String(memory, "", "Amount of RAM to allocate to Kubernetes (format: <number>[<unit>], where unit = b, k, m or g). Use \"max\" or \"no-limit\" for all the memory available to the driver, or a percentage of it such as \"50%\".")
 	startCmd.Flags().String(humanReadableDiskSize, defaultDiskSize, "Disk size allocated to the minikube VM (format: <number>[<unit>], where unit = b, k, m or g).")
 	startCmd.Flags().Bool(downloadOnly, false, "If true, only download and cache files for later use - don't install or start anything.")
 	startCmd.Flags().Bool(cacheImages, true, "If true, cache docker images for the current bootstrapper and load them into the machine. Always false with --driver=none.")
@@ -135,15 +152,26 @@ func initMinikubeFlags() {
 	startCmd.Flags().String(networkPlugin, "", "Kubelet network plug-in to use (default: auto)")
 	startCmd.Flags().Bool(enableDefaultCNI, false, "DEPRECATED: Replaced by --cni=bridge")
 	startCmd.Flags().String(cniFlag, "", "CNI plug-in to use. Valid options: auto, bridge, flannel, kindnet, or path to a CNI manifest (default: auto)")
-	startCmd.Flags().StringSlice(waitComponents, kverify.DefaultWaitList, fmt.Sprintf("comma separated list of Kubernetes components to verify and wait for after starting a cluster. defaults to %q, available options: %q . other acceptable values are 'all' or 'none', 'true' and 'false'", strings.Join(kverify.DefaultWaitList, ","), strings.Join(kverify.AllComponentsList, ",")))
+	startCmd.Flags().StringSlice(waitComponents, kverify.DefaultWaitList, fmt.Sprintf("comma separated list of Kubernetes components to verify and wait for after starting a cluster. defaults to %q, available options: %q ('nodes' is an alias of node_ready). other acceptable values are 'all' or 'none', 'true' and 'false'. Joining nodes wait for the per-node components: node_ready and system_pods", strings.Join(kverify.DefaultWaitList, ","), strings.Join(kverify.AllComponentsList, ",")))
 	startCmd.Flags().Duration(waitTimeout, 6*time.Minute, "max time to wait per Kubernetes core services to be healthy.")
+	startCmd.Flags().StringVar(&metricsOutput, "metrics-output", "", "Write start timings to this file, as JSON if it ends in .json and in the Prometheus text format otherwise.")
+	startCmd.Flags().StringP(startOutput, "o", "text", "Format to print the outcome of start in. One of: text, json. With json, progress is printed to stderr, and a summary of the cluster or of the failure to stdout.")
+	startCmd.Flags().String(onReady, "", "A script to run once all nodes are Ready. The kubeconfig path, profile and node names are passed as KUBECONFIG, MINIKUBE_PROFILE and MINIKUBE_NODES.")
+	startCmd.Flags().Bool(verifyNetwork, false, "If true, check that pods on every node can reach each other and the service network once the cluster is up, failing start if they cannot.")
 	startCmd.Flags().Bool(nativeSSH, true, "Use native Golang SSH client (default true). Set to 'false' to use the command line 'ssh' command when accessing the docker machine. Useful for the machine drivers when they will not start with 'Waiting for SSH'.")
 	startCmd.Flags().Bool(autoUpdate, true, "If set, automatically updates drivers to the latest version. Defaults to true.")
 	startCmd.Flags().Bool(installAddons, true, "If set, install addons. Defaults to true.")
 	startCmd.Flags().IntP(nodes, "n", 1, "The number of nodes to spin up. Defaults to 1.")
+	startCmd.Flags().Int(maxNodes, 0, "The maximum number of nodes of the cluster, past which 'minikube node add' is rejected. 0 means no limit.")
+	startCmd.Flags().StringSlice(nodeNames, nil, "Comma separated list of names for the nodes to spin up, the first of which is the control plane. Names must be unique DNS labels. Defaults to autogenerated names.")
 	startCmd.Flags().Bool(preload, true, "If set, download tarball of preloaded images if available to improve start time. Defaults to true.")
-	startCmd.Flags().Bool(deleteOnFailure, false, "If set, delete the current cluster if start fails and try again. Defaults to false.")
+	startCmd.Flags().String(deleteOnFailure, "false", "If set, delete the current cluster if start fails and try again. With 'node', only the nodes which fail to join are deleted and the rest of the cluster is kept. Defaults to false.")
+	startCmd.Flags().Lookup(deleteOnFailure).NoOptDefVal = "true"
+	startCmd.Flags().Bool(preserveNodeConfig, false, "If set, a new cluster is created with the nodes of the last deleted cluster of the same name, including their per-node settings. Overrides --nodes and --node-names.")
 	startCmd.Flags().Bool(forceSystemd, false, "If set, force the container runtime to use sytemd as cgroup manager. Currently available for docker and crio. Defaults to false.")
+	startCmd.Flags().String(cgroupDriver, "", fmt.Sprintf("The cgroup driver shared by the kubelet and container runtime (%s). Defaults to the container runtime's driver.", strings.Join(cruntime.ValidCgroupDrivers(), ", ")))
+	startCmd.Flags().StringArrayVar(&sysctls, sysctl, nil, "Kernel parameters to set on every node, reapplied whenever the node starts. (format: key=value)")
+	startCmd.Flags().StringSlice(prepullImages, nil, "Comma separated list of images to cache on the host and load into every node as it starts, including nodes added later.")
 }
 
 // initKubernetesFlags inits the commandline flags for Kubernetes related options
@@ -160,6 +188,11 @@ func initKubernetesFlags() {
 	startCmd.Flags().String(apiServerName, constants.APIServerName, "The authoritative apiserver hostname for apiserver certificates and connectivity. This can be used if you want to make the apiserver available from outside the machine")
 	startCmd.Flags().StringArrayVar(&apiServerNames, "apiserver-names", nil, "A set of apiserver names which are used in the generated certificate for kubernetes.  This can be used if you want to make the apiserver available from outside the machine")
 	startCmd.Flags().IPSliceVar(&apiServerIPs, "apiserver-ips", nil, "A set of apiserver IP Addresses which are used in the generated certificate for kubernetes.  This can be used if you want to make the apiserver available from outside the machine")
+	startCmd.Flags().Bool(reserveControlPlane, false, "If set, taint the control plane with "+constants.ControlPlaneTaint+" so that user workloads are only scheduled on workers. Reapplied whenever the cluster starts.")
+	startCmd.Flags().String(containerLogMaxSize, "", "The size a container log is rotated at by the kubelet of every node, e.g. 10Mi. Defaults to the kubelet default. Not supported with the docker runtime, which rotates logs itself.")
+	startCmd.Flags().Int(containerLogMaxFiles, 0, "The number of log files kept per container by the kubelet of every node, at least 2. Defaults to the kubelet default. Not supported with the docker runtime, which rotates logs itself.")
+	startCmd.Flags().String(etcdDataDir, "", "The directory etcd stores its data in on every control plane. Defaults to "+bsutil.EtcdDataDir()+". Can not be changed once the cluster is created.")
+	startCmd.Flags().Int64(etcdQuotaBackendBytes, 0, "The space quota of the etcd database on every control plane, in bytes. Defaults to the etcd default. Requires Kubernetes v1.17 or later.")
 }
 
 // initDriverFlags inits the commandline flags for vm drivers
@@ -201,8 +234,10 @@ func initNetworkingFlags() {
 	startCmd.Flags().StringSliceVar(&insecureRegistry, "insecure-registry", nil, "Insecure Docker registries to pass to the Docker daemon.  The default service CIDR range will automatically be added.")
 	startCmd.Flags().StringSliceVar(&registryMirror, "registry-mirror", nil, "Registry mirrors to pass to the Docker daemon")
 	startCmd.Flags().String(imageRepository, "", "Alternative image repository to pull docker images from. This can be used when you have limited access to gcr.io. Set it to \"auto\" to let minikube decide one for you. For Chinese mainland users, you may use local gcr.io mirrors such as registry.cn-hangzhou.aliyuncs.com/google_containers")
+	startCmd.Flags().String(binaryMirror, "", "Location to download the Kubernetes binaries of every node from, laid out like the release bucket: <mirror>/<version>/bin/linux/<arch>/kubelet. Useful to start and join nodes without internet access.")
 	startCmd.Flags().String(imageMirrorCountry, "", "Country code of the image mirror to be used. Leave empty to use the global one. For Chinese mainland users, set it to cn.")
 	startCmd.Flags().String(serviceCIDR, constants.DefaultServiceCIDR, "The CIDR to be used for service cluster IPs.")
+	startCmd.Flags().Int(nodeCIDRMaskSize, 0, "The prefix length of the pod CIDR allocated to each node by kube-controller-manager, e.g. 23 for twice the pods per node of the default 24, raising the max pods of each kubelet accordingly. At most 25, to hold the 110 pods of each node. Requires a CNI.")
 	startCmd.Flags().StringArrayVar(&config.DockerEnv, "docker-env", nil, "Environment variables to pass to the Docker daemon. (format: key=value)")
 	startCmd.Flags().StringArrayVar(&config.DockerOpt, "docker-opt", nil, "Specify arbitrary flags to pass to the Docker daemon. (format: key=value)")
 }
@@ -224,9 +259,9 @@ func generateClusterConfig(cmd *cobra.Command, existing *config.ClusterConfig, k
 			glog.Warningf("Unable to query memory limits: %v", err)
 		}
 
-		mem := suggestMemoryAllocation(sysLimit, containerLimit, viper.GetInt(nodes))
+		mem := suggestMemoryAllocation(sysLimit, containerLimit, requestedNodes())
 		if cmd.Flags().Changed(memory) {
-			mem, err = pkgutil.CalculateSizeInMB(viper.GetString(memory))
+			mem, err = parseMemory(drvName, viper.GetString(memory))
 			if err != nil {
 				exit.WithCodeT(exit.Config, "Generate unable to parse memory '{{.memory}}': {{.error}}", out.V{"memory": viper.GetString(memory), "error": err})
 			}
@@ -234,6 +269,11 @@ func generateClusterConfig(cmd *cobra.Command, existing *config.ClusterConfig, k
 		} else {
 			glog.Infof("Using suggested %dMB memory alloc based on sys=%dMB, container=%dMB", mem, sysLimit, containerLimit)
 		}
+		ncpus, err := parseCPUs(drvName, viper.GetString(cpus))
+		if err != nil {
+			exit.WithCodeT(exit.Config, "Generate unable to parse cpus '{{.cpus}}': {{.error}}", out.V{"cpus": viper.GetString(cpus), "error": err})
+		}
+		validateHostResources(drvName, requestedNodes(), mem, ncpus, sysLimit, containerLimit, cmd.Flags().Changed(memory))
 
 		diskSize, err := pkgutil.CalculateSizeInMB(viper.GetString(humanReadableDiskSize))
 		if err != nil {
@@ -277,7 +317,7 @@ func generateClusterConfig(cmd *cobra.Command, existing *config.ClusterConfig, k
 			MinikubeISO:             viper.GetString(isoURL),
 			KicBaseImage:            viper.GetString(kicBaseImage),
 			Memory:                  mem,
-			CPUs:                    viper.GetInt(cpus),
+			CPUs:                    ncpus,
 			DiskSize:                diskSize,
 			Driver:                  drvName,
 			HyperkitVpnKitSock:      viper.GetString(vpnkitSock),
@@ -288,6 +328,9 @@ func generateClusterConfig(cmd *cobra.Command, existing *config.ClusterConfig, k
 			DockerOpt:               config.DockerOpt,
 			InsecureRegistry:        insecureRegistry,
 			RegistryMirror:          registryMirror,
+			Sysctls:                 sysctls,
+			PrepullImages:           viper.GetStringSlice(prepullImages),
+			MaxNodes:                viper.GetInt(maxNodes),
 			HostOnlyCIDR:            viper.GetString(hostOnlyCIDR),
 			HypervVirtualSwitch:     viper.GetString(hypervVirtualSwitch),
 			HypervUseExternalSwitch: viper.GetBool(hypervUseExternalSwitch),
@@ -316,10 +359,17 @@ func generateClusterConfig(cmd *cobra.Command, existing *config.ClusterConfig, k
 				NetworkPlugin:          viper.GetString(networkPlugin),
 				ServiceCIDR:            viper.GetString(serviceCIDR),
 				ImageRepository:        repository,
+				BinaryMirror:           viper.GetString(binaryMirror),
+				NodeCIDRMaskSize:       viper.GetInt(nodeCIDRMaskSize),
+				ContainerLogMaxSize:    viper.GetString(containerLogMaxSize),
+				ContainerLogMaxFiles:   viper.GetInt(containerLogMaxFiles),
+				EtcdDataDir:            viper.GetString(etcdDataDir),
+				EtcdQuotaBackendBytes:  viper.GetInt64(etcdQuotaBackendBytes),
 				ExtraOptions:           config.ExtraOptions,
 				ShouldLoadCachedImages: viper.GetBool(cacheImages),
 				CNI:                    chosenCNI,
 				NodePort:               viper.GetInt(apiServerPort),
+				ReserveControlPlane:    viper.GetBool(reserveControlPlane),
 			},
 		}
 		cc.VerifyComponents = interpretWaitFlag(*cmd)
@@ -348,15 +398,17 @@ func generateClusterConfig(cmd *cobra.Command, existing *config.ClusterConfig, k
 
 	var kubeNodeName string
 	if driver.BareMetal(cc.Driver) {
-		kubeNodeName = "m01"
+		kubeNodeName = node.Name(1)
+	} else if names := viper.GetStringSlice(nodeNames); len(names) > 0 {
+		kubeNodeName = names[0]
 	}
 	return createNode(cc, kubeNodeName, existing)
 }
 
 // updateExistingConfigFromFlags will update the existing config from the flags - used on a second start
-// skipping updating existing docker env , docker opt, InsecureRegistry, registryMirror, extra-config, apiserver-ips
+// skipping updating existing docker env , docker opt, InsecureRegistry, extra-config (except for kubelet eviction options), apiserver-ips
 func updateExistingConfigFromFlags(cmd *cobra.Command, existing *config.ClusterConfig) config.ClusterConfig { //nolint to suppress cyclomatic complexity 45 of func `updateExistingConfigFromFlags` is high (> 30)
-	validateFlags(cmd, existing.Driver)
+	validateFlags(cmd, existing.Driver, existing)
 
 	cc := *existing
 
@@ -377,7 +429,7 @@ func updateExistingConfigFromFlags(cmd *cobra.Command, existing *config.ClusterC
 	}
 
 	if cmd.Flags().Changed(memory) {
-		memInMB, err := pkgutil.CalculateSizeInMB(viper.GetString(memory))
+		memInMB, err := parseMemory(existing.Driver, viper.GetString(memory))
 		if err != nil {
 			glog.Warningf("error calculate memory size in mb : %v", err)
 		}
@@ -388,7 +440,11 @@ func updateExistingConfigFromFlags(cmd *cobra.Command, existing *config.ClusterC
 	}
 
 	if cmd.Flags().Changed(cpus) {
-		if viper.GetInt(cpus) != existing.CPUs {
+		ncpus, err := parseCPUs(existing.Driver, viper.GetString(cpus))
+		if err != nil {
+			glog.Warningf("error parsing cpus: %v", err)
+		}
+		if ncpus != existing.CPUs {
 			out.WarningT("You cannot change the CPUs for an exiting minikube cluster. Please first delete the cluster.")
 		}
 	}
@@ -536,6 +592,31 @@ func updateExistingConfigFromFlags(cmd *cobra.Command, existing *config.ClusterC
 		cc.KubernetesConfig.ImageRepository = viper.GetString(imageRepository)
 	}
 
+	if cmd.Flags().Changed(binaryMirror) {
+		cc.KubernetesConfig.BinaryMirror = viper.GetString(binaryMirror)
+	}
+
+	if cmd.Flags().Changed(nodeCIDRMaskSize) {
+		cc.KubernetesConfig.NodeCIDRMaskSize = viper.GetInt(nodeCIDRMaskSize)
+	}
+
+	if cmd.Flags().Changed(containerLogMaxSize) {
+		cc.KubernetesConfig.ContainerLogMaxSize = viper.GetString(containerLogMaxSize)
+	}
+
+	if cmd.Flags().Changed(containerLogMaxFiles) {
+		cc.KubernetesConfig.ContainerLogMaxFiles = viper.GetInt(containerLogMaxFiles)
+	}
+
+	// the data of etcd would be left behind in the previous directory
+	if cmd.Flags().Changed(etcdDataDir) && viper.GetString(etcdDataDir) != bsutil.ClusterEtcdDataDir(cc.KubernetesConfig) {
+		out.WarningT("Ignoring --etcd-data-dir, as it can not be changed once the cluster is created")
+	}
+
+	if cmd.Flags().Changed(etcdQuotaBackendBytes) {
+		cc.KubernetesConfig.EtcdQuotaBackendBytes = viper.GetInt64(etcdQuotaBackendBytes)
+	}
+
 	if cmd.Flags().Changed(enableDefaultCNI) && !cmd.Flags().Changed(cniFlag) {
 		if viper.GetBool(enableDefaultCNI) {
 			glog.Errorf("Found deprecated --enable-default-cni flag, setting --cni=bridge")
@@ -547,6 +628,10 @@ func updateExistingConfigFromFlags(cmd *cobra.Command, existing *config.ClusterC
 		cc.KubernetesConfig.CNI = viper.GetString(cniFlag)
 	}
 
+	if cmd.Flags().Changed(reserveControlPlane) {
+		cc.KubernetesConfig.ReserveControlPlane = viper.GetBool(reserveControlPlane)
+	}
+
 	if cmd.Flags().Changed(waitComponents) {
 		cc.VerifyComponents = interpretWaitFlag(*cmd)
 	}
@@ -555,6 +640,33 @@ func updateExistingConfigFromFlags(cmd *cobra.Command, existing *config.ClusterC
 		cc.KicBaseImage = viper.GetString(kicBaseImage)
 	}
 
+	if cmd.Flags().Changed(sysctl) {
+		cc.Sysctls = sysctls
+	}
+
+	if cmd.Flags().Changed(prepullImages) {
+		cc.PrepullImages = viper.GetStringSlice(prepullImages)
+	}
+
+	if cmd.Flags().Changed(maxNodes) {
+		if max := viper.GetInt(maxNodes); max > 0 && len(cc.Nodes) > max {
+			exit.UsageT("--max-nodes={{.max}} is below the {{.nodes}} nodes of the cluster, delete nodes first with 'minikube node delete'", out.V{"max": max, "nodes": len(cc.Nodes)})
+		}
+		cc.MaxNodes = viper.GetInt(maxNodes)
+	}
+
+	// kubelet eviction options are regenerated on every node at start, so they can be changed on an existing cluster
+	for _, eo := range config.ExtraOptions {
+		if bsutil.IsKubeletEvictionOption(eo) {
+			cc.KubernetesConfig.ExtraOptions.Update(eo)
+		}
+	}
+
+	// registry mirrors are applied to every node, including the ones added later on
+	if cmd.Flags().Changed("registry-mirror") || len(registryMirror) > 0 {
+		cc.RegistryMirror = registryMirror
+	}
+
 	return cc
 }
 
@@ -585,8 +697,15 @@ func interpretWaitFlag(cmd cobra.Command) map[string]bool {
 		}
 	}
 
-	waitComponents := kverify.NoComponents
+	// copy, so that the shared map of no components is left untouched
+	waitComponents := map[string]bool{}
+	for k, v := range kverify.NoComponents {
+		waitComponents[k] = v
+	}
 	for _, wc := range waitFlags {
+		if alias, ok := kverify.WaitAliases[wc]; ok {
+			wc = alias
+		}
 		seen := false
 		for _, valid := range kverify.AllComponentsList {
 			if wc == valid {
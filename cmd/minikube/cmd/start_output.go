@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+// StartSummary is printed by 'minikube start --output=json' once start has finished
+type StartSummary struct {
+	Profile           string
+	Driver            string
+	KubernetesVersion string `json:",omitempty"`
+	Nodes             []StartNode
+	Addons            []string
+	Duration          string
+	// Error is only set if start failed
+	Error *StartError `json:",omitempty"`
+}
+
+// StartNode describes a node of a started cluster
+type StartNode struct {
+	Name         string
+	IP           string
+	ControlPlane bool
+	Worker       bool
+}
+
+// StartError describes why start failed
+type StartError struct {
+	ExitCode int
+	Message  string
+}
+
+// newStartSummary returns the summary of a started cluster
+func newStartSummary(cc config.ClusterConfig, took time.Duration) StartSummary {
+	s := StartSummary{
+		Profile:           cc.Name,
+		Driver:            cc.Driver,
+		KubernetesVersion: cc.KubernetesConfig.KubernetesVersion,
+		Nodes:             []StartNode{},
+		Addons:            []string{},
+		Duration:          took.Round(time.Millisecond).String(),
+	}
+	for _, n := range cc.Nodes {
+		name := n.Name
+		if name == "" {
+			name = cc.Name
+		}
+		s.Nodes = append(s.Nodes, StartNode{Name: name, IP: n.IP, ControlPlane: n.ControlPlane, Worker: n.Worker})
+	}
+	for name, enabled := range cc.Addons {
+		if enabled {
+			s.Addons = append(s.Addons, name)
+		}
+	}
+	sort.Strings(s.Addons)
+	return s
+}
+
+// failedStartSummary returns the summary of a failed start
+func failedStartSummary(profile string, driver string, code int, msg string, took time.Duration) StartSummary {
+	return StartSummary{
+		Profile:  profile,
+		Driver:   driver,
+		Nodes:    []StartNode{},
+		Addons:   []string{},
+		Duration: took.Round(time.Millisecond).String(),
+		Error:    &StartError{ExitCode: code, Message: msg},
+	}
+}
+
+// writeStartJSON writes the summary as a single line of JSON
+func writeStartJSON(s StartSummary, w io.Writer) {
+	js, err := json.Marshal(s)
+	if err != nil {
+		glog.Errorf("unable to marshal start summary: %v", err)
+		return
+	}
+	if _, err := w.Write(append(js, '\n')); err != nil {
+		glog.Errorf("unable to write start summary: %v", err)
+	}
+}
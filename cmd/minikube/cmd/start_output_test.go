@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestWriteStartJSON(t *testing.T) {
+	cc := config.ClusterConfig{
+		Name:             "minikube",
+		Driver:           "kvm2",
+		KubernetesConfig: config.KubernetesConfig{KubernetesVersion: "v1.18.3"},
+		Nodes: []config.Node{
+			{Name: "", IP: "192.168.39.2", ControlPlane: true, Worker: true},
+			{Name: "m02", IP: "192.168.39.3", Worker: true},
+		},
+		Addons: map[string]bool{"storage-provisioner": true, "dashboard": false, "default-storageclass": true},
+	}
+
+	var tests = []struct {
+		name    string
+		summary StartSummary
+		want    string
+	}{
+		{
+			name:    "started",
+			summary: newStartSummary(cc, 83*time.Second+1500*time.Microsecond),
+			want:    `{"Profile":"minikube","Driver":"kvm2","KubernetesVersion":"v1.18.3","Nodes":[{"Name":"minikube","IP":"192.168.39.2","ControlPlane":true,"Worker":true},{"Name":"m02","IP":"192.168.39.3","ControlPlane":false,"Worker":true}],"Addons":["default-storageclass","storage-provisioner"],"Duration":"1m23.002s"}` + "\n",
+		},
+		{
+			name:    "failed",
+			summary: failedStartSummary("minikube", "kvm2", 78, "Requested memory allocation (100MB) is less than the recommended minimum 1024MB.", 2*time.Second),
+			want:    `{"Profile":"minikube","Driver":"kvm2","Nodes":[],"Addons":[],"Duration":"2s","Error":{"ExitCode":78,"Message":"Requested memory allocation (100MB) is less than the recommended minimum 1024MB."}}` + "\n",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var b bytes.Buffer
+			writeStartJSON(tc.summary, &b)
+			if got := b.String(); got != tc.want {
+				t.Errorf("writeStartJSON() = %q, want: %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -18,10 +18,12 @@ package cmd
 
 import (
 	"os"
+	"reflect"
 	"testing"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
 	cfg "k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
 )
@@ -234,3 +236,156 @@ func TestSuggestMemoryAllocation(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckHostCapacity(t *testing.T) {
+	var tests = []struct {
+		description string
+		nodes       int
+		perNode     int
+		available   int
+		want        string
+	}{
+		{"fits", 2, 2200, 8192, ""},
+		{"exact", 2, 4096, 8192, ""},
+		{"unknown capacity", 4, 4096, 0, ""},
+		{"too much", 4, 4096, 8192, "4 nodes x 4096MB = 16384MB of memory requested, but only 8192MB is available"},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got := ""
+			if err := checkHostCapacity("memory", "MB", test.nodes, test.perNode, test.available); err != nil {
+				got = err.Error()
+			}
+			if got != test.want {
+				t.Errorf("checkHostCapacity(%d, %d, %d) = %q, want: %q", test.nodes, test.perNode, test.available, got, test.want)
+			}
+		})
+	}
+}
+
+func TestResolveRelativeSize(t *testing.T) {
+	var tests = []struct {
+		value     string
+		available int
+		relative  bool
+		want      int
+		shouldErr bool
+	}{
+		{"4", 8, false, 0, false},
+		{"2g", 16384, false, 0, false},
+		{"max", 8, true, 8, false},
+		{"MAX", 16384, true, 16384, false},
+		{"no-limit", 8, true, 8, false},
+		{"50%", 16384, true, 8192, false},
+		{"10%", 4, true, 1, false},
+		{"max", 0, true, 0, true},
+		{"0%", 8, true, 0, true},
+		{"150%", 8, true, 0, true},
+		{"half%", 8, true, 0, true},
+	}
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			if got := isRelativeSize(test.value); got != test.relative {
+				t.Fatalf("isRelativeSize(%q) = %v, want: %v", test.value, got, test.relative)
+			}
+			if !test.relative {
+				return
+			}
+			got, err := resolveRelativeSize(test.value, test.available)
+			if err != nil && !test.shouldErr {
+				t.Errorf("resolveRelativeSize(%q, %d) unexpected error: %v", test.value, test.available, err)
+			}
+			if err == nil && test.shouldErr {
+				t.Errorf("resolveRelativeSize(%q, %d) expected error but got none", test.value, test.available)
+			}
+			if got != test.want {
+				t.Errorf("resolveRelativeSize(%q, %d) = %d, want: %d", test.value, test.available, got, test.want)
+			}
+		})
+	}
+}
+
+func TestValidateBinaryMirror(t *testing.T) {
+	var tests = []struct {
+		loc       string
+		shouldErr bool
+	}{
+		{"", false},
+		{"https://mirror.internal/kubernetes-release/release", false},
+		{"http://10.0.0.1:8080/", false},
+		{"file:///srv/kubernetes-release", false},
+		{"mirror.internal/kubernetes-release", true},
+		{"ftp://mirror.internal", true},
+		{"://mirror", true},
+	}
+	for _, test := range tests {
+		t.Run(test.loc, func(t *testing.T) {
+			err := validateBinaryMirror(test.loc)
+			if err != nil && !test.shouldErr {
+				t.Errorf("validateBinaryMirror(%q) unexpected error: %v", test.loc, err)
+			}
+			if err == nil && test.shouldErr {
+				t.Errorf("validateBinaryMirror(%q) expected error but got none", test.loc)
+			}
+		})
+	}
+}
+
+func TestOnReadyEnv(t *testing.T) {
+	var tests = []struct {
+		description string
+		nodes       []cfg.Node
+		want        []string
+	}{
+		{
+			description: "single node",
+			nodes:       []cfg.Node{{Name: "", ControlPlane: true, Worker: true}},
+			want:        []string{"KUBECONFIG=/home/user/.kube/config", "MINIKUBE_PROFILE=p1", "MINIKUBE_NODES=p1"},
+		},
+		{
+			description: "multinode",
+			nodes:       []cfg.Node{{Name: "", ControlPlane: true, Worker: true}, {Name: "m02", Worker: true}, {Name: "m03", Worker: true}},
+			want:        []string{"KUBECONFIG=/home/user/.kube/config", "MINIKUBE_PROFILE=p1", "MINIKUBE_NODES=p1,p1-m02,p1-m03"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			cc := cfg.ClusterConfig{Name: "p1", Nodes: test.nodes}
+			got := onReadyEnv(cc, "/home/user/.kube/config")
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("onReadyEnv() = %v, want: %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestInterpretWaitFlag(t *testing.T) {
+	var tests = []struct {
+		wait string
+		want map[string]bool
+	}{
+		{"none", kverify.NoComponents},
+		{"all", kverify.AllComponents},
+		{"nodes,default_sa", map[string]bool{kverify.APIServerWaitKey: false, kverify.SystemPodsWaitKey: false, kverify.DefaultSAWaitKey: true, kverify.AppsRunningKey: false, kverify.NodeReadyKey: true}},
+		{"apiserver,system_pods,node_ready", map[string]bool{kverify.APIServerWaitKey: true, kverify.SystemPodsWaitKey: true, kverify.DefaultSAWaitKey: false, kverify.AppsRunningKey: false, kverify.NodeReadyKey: true}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.wait, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			cmd.Flags().StringSlice(waitComponents, kverify.DefaultWaitList, "")
+			if err := cmd.Flags().Set(waitComponents, tc.wait); err != nil {
+				t.Fatalf("set --wait: %v", err)
+			}
+			if got := interpretWaitFlag(*cmd); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("interpretWaitFlag(%q) = %v, want %v", tc.wait, got, tc.want)
+			}
+		})
+	}
+
+	// selecting components must not alter the shared map of no components
+	for k, v := range kverify.NoComponents {
+		if v {
+			t.Errorf("kverify.NoComponents[%q] was set", k)
+		}
+	}
+}
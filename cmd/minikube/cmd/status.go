@@ -20,28 +20,43 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
+	"text/tabwriter"
 	"text/template"
+	"time"
 
 	"github.com/docker/machine/libmachine"
 	"github.com/docker/machine/libmachine/state"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
 	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
 	"k8s.io/minikube/pkg/minikube/cluster"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/kubeconfig"
+	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/mustload"
 	"k8s.io/minikube/pkg/minikube/node"
+	"k8s.io/minikube/pkg/minikube/out"
 )
 
 var statusFormat string
 var output string
+var statusLayout string
+var statusShowPods bool
+var statusProbeLatency bool
+var statusLatencyThreshold time.Duration
+var statusDiff bool
 
 const (
 	// # Additional states used by kubeconfig:
@@ -59,6 +74,14 @@ const (
 	Irrelevant = "Irrelevant"
 )
 
+// statusRow is a node status along with the node details shown by --layout=wide
+type statusRow struct {
+	*Status
+	IP                string
+	KubernetesVersion string
+	ContainerRuntime  string
+}
+
 // Status holds string representations of component states
 type Status struct {
 	Name       string
@@ -67,6 +90,33 @@ type Status struct {
 	APIServer  string
 	Kubeconfig string
 	Worker     bool
+	// KubeletVersion is the version reported by the kubelet of the node, empty if the apiserver is unreachable
+	KubeletVersion string `json:",omitempty"`
+	// Pods counts the pods scheduled on the node by phase, only set with --show-pods
+	Pods *PodCounts `json:",omitempty"`
+	// APIServerLatency is the response time of the apiserver of a control plane, only set with --probe-latency
+	APIServerLatency *Latency `json:",omitempty"`
+	// nodeName is the name the apiserver knows the node by, which differs from the machine name with --hostname
+	nodeName string
+}
+
+// Latency is the measured response time of an apiserver
+type Latency struct {
+	Milliseconds int64
+	// Slow is set when the response time is above --latency-threshold
+	Slow bool
+}
+
+// newLatency returns the latency of a probe which took d, flagging it as slow above threshold
+func newLatency(d time.Duration, threshold time.Duration) *Latency {
+	return &Latency{Milliseconds: d.Milliseconds(), Slow: d > threshold}
+}
+
+// PodCounts is the number of pods of a node in each phase
+type PodCounts struct {
+	Running int
+	Pending int
+	Failed  int
 }
 
 const (
@@ -87,6 +137,8 @@ host: {{.Host}}
 kubelet: {{.Kubelet}}
 
 `
+	podsStatusFormat    = "pods: {{.Pods.Running}} running, {{.Pods.Pending}} pending, {{.Pods.Failed}} failed\n"
+	latencyStatusFormat = "latency: {{.APIServerLatency.Milliseconds}}ms{{if .APIServerLatency.Slow}} (slow){{end}}\n"
 )
 
 // statusCmd represents the status command
@@ -97,17 +149,37 @@ var statusCmd = &cobra.Command{
 	Exit status contains the status of minikube's VM, cluster and Kubernetes encoded on it's bits in this order from right to left.
 	Eg: 7 meaning: 1 (for minikube NOK) + 2 (for cluster NOK) + 4 (for Kubernetes NOK)`,
 	Run: func(cmd *cobra.Command, args []string) {
-
+		output = strings.ToLower(output)
 		if output != "text" && statusFormat != defaultStatusFormat {
 			exit.UsageT("Cannot use both --output and --format options")
 		}
+		if statusDiff && output != "text" {
+			exit.UsageT("--diff can only be used with the text output")
+		}
+		if err := validateStatusFormat(statusFormat); err != nil {
+			exit.UsageT("Invalid --format: {{.error}}", out.V{"error": err})
+		}
+
+		layout := strings.ToLower(statusLayout)
+		switch layout {
+		case "":
+		case "json":
+			output = "json"
+		case "compact", "wide":
+			if output != "text" || statusFormat != defaultStatusFormat {
+				exit.UsageT("Cannot use --layout={{.layout}} with the --output or --format options", out.V{"layout": layout})
+			}
+		default:
+			exit.WithCodeT(exit.BadUsage, fmt.Sprintf("invalid layout: %s. Valid values: 'compact', 'wide', 'json'", statusLayout))
+		}
 
 		cname := ClusterFlagValue()
 		api, cc := mustload.Partial(cname)
 
 		var statuses []*Status
+		var rows []statusRow
 
-		if nodeName != "" || statusFormat != defaultStatusFormat && len(cc.Nodes) > 1 {
+		if nodeName != "" {
 			n, _, err := node.Retrieve(*cc, nodeName)
 			if err != nil {
 				exit.WithError("retrieving node", err)
@@ -118,6 +190,7 @@ var statusCmd = &cobra.Command{
 				glog.Errorf("status error: %v", err)
 			}
 			statuses = append(statuses, st)
+			rows = append(rows, newStatusRow(*cc, *n, st))
 		} else {
 			for _, n := range cc.Nodes {
 				machineName := driver.MachineName(*cc, n)
@@ -132,14 +205,51 @@ var statusCmd = &cobra.Command{
 					glog.Errorf("The %q host does not exist!", machineName)
 				}
 				statuses = append(statuses, st)
+				rows = append(rows, newStatusRow(*cc, n, st))
+			}
+
+			if len(statuses) > 1 {
+				setKubeletVersions(cname, statuses)
+				if skew := versionSkew(statuses); skew != "" {
+					out.WarningT("Nodes are running different Kubernetes versions: {{.versions}}", out.V{"versions": skew})
+				}
 			}
 		}
 
-		switch strings.ToLower(output) {
+		if statusShowPods {
+			setPodCounts(cname, statuses)
+		}
+
+		for _, st := range statuses {
+			if st.APIServerLatency != nil && st.APIServerLatency.Slow {
+				out.WarningT("The apiserver of {{.name}} took {{.latency}}ms to respond, which is above the {{.threshold}} threshold", out.V{"name": st.Name, "latency": st.APIServerLatency.Milliseconds, "threshold": statusLatencyThreshold})
+			}
+		}
+
+		previous, err := loadLastStatuses(cname)
+		if err != nil {
+			glog.Warningf("unable to load the last status: %v", err)
+		}
+		if err := saveLastStatuses(*cc, previous, statuses); err != nil {
+			glog.Warningf("unable to save the status: %v", err)
+		}
+
+		switch output {
 		case "text":
-			for _, st := range statuses {
-				if err := statusText(st, os.Stdout); err != nil {
-					exit.WithError("status text failure", err)
+			if layout == "compact" || layout == "wide" {
+				if err := statusTable(rows, layout == "wide", os.Stdout); err != nil {
+					exit.WithError("status table failure", err)
+				}
+			} else {
+				for _, st := range statuses {
+					if err := statusText(st, os.Stdout); err != nil {
+						exit.WithError("status text failure", err)
+					}
+				}
+			}
+			if statusDiff {
+				if err := statusChangesText(statusChanges(*cc, previous, statuses), os.Stdout); err != nil {
+					exit.WithError("status diff failure", err)
 				}
 			}
 		case "json":
@@ -182,6 +292,7 @@ func status(api libmachine.API, cc config.ClusterConfig, n config.Node) (*Status
 		Kubelet:    Nonexistent,
 		Kubeconfig: Nonexistent,
 		Worker:     !controlPlane,
+		nodeName:   bsutil.KubeNodeName(cc, n),
 	}
 
 	hs, err := machine.Status(api, name)
@@ -259,36 +370,241 @@ func status(api libmachine.API, cc config.ClusterConfig, n config.Node) (*Status
 		st.APIServer = sta.String()
 	}
 
+	if statusProbeLatency && sta == state.Running {
+		d, err := kverify.APIServerLatency(hostname, port)
+		if err != nil {
+			glog.Warningf("unable to probe %s apiserver latency: %v", name, err)
+		} else {
+			st.APIServerLatency = newLatency(d, statusLatencyThreshold)
+		}
+	}
+
 	return st, nil
 }
 
 func init() {
 	statusCmd.Flags().StringVarP(&statusFormat, "format", "f", defaultStatusFormat,
-		`Go template format string for the status output.  The format for Go templates can be found here: https://golang.org/pkg/text/template/
+		`Go template format string for the status output, rendered once per node, such as '{{.Name}} {{.Host}} {{.Kubelet}}'. {{json .}} renders a value as JSON. Pods and APIServerLatency are not set for every node, so refer to them within {{if}}, such as '{{if .Pods}}{{.Pods.Running}}{{end}}'. The format for Go templates can be found here: https://golang.org/pkg/text/template/
 For the list accessible variables for the template, see the struct values here: https://godoc.org/k8s.io/minikube/cmd/minikube/cmd#Status`)
 	statusCmd.Flags().StringVarP(&output, "output", "o", "text",
 		`minikube status --output OUTPUT. json, text`)
-	statusCmd.Flags().StringVarP(&nodeName, "node", "n", "", "The node to check status for. Defaults to control plane. Leave blank with default format for status on all nodes.")
+	statusCmd.Flags().StringVar(&statusLayout, "layout", "", "Layout of the status output. One of: compact (one line per node), wide (compact with the IP, Kubernetes version and container runtime of each node), json (same as --output=json).")
+	statusCmd.Flags().BoolVar(&statusShowPods, "show-pods", false, "If true, show the number of running, pending and failed pods of each node, which is also included in the json output.")
+	statusCmd.Flags().BoolVar(&statusProbeLatency, "probe-latency", false, "If true, measure the response time of the apiserver of each control plane, which is also included in the json output.")
+	statusCmd.Flags().DurationVar(&statusLatencyThreshold, "latency-threshold", time.Second, "Response time above which an apiserver is flagged as slow by --probe-latency.")
+	statusCmd.Flags().BoolVar(&statusDiff, "diff", false, "If true, also show what changed since the last time the status of the cluster was checked, such as a host going from Running to Stopped.")
+	statusCmd.Flags().StringVarP(&nodeName, "node", "n", "", "The node to check status for. Leave blank for status on all nodes.")
 }
 
 func statusText(st *Status, w io.Writer) error {
-	tmpl, err := template.New("status").Parse(statusFormat)
-	if st.Worker && statusFormat == defaultStatusFormat {
-		tmpl, err = template.New("worker-status").Parse(workerStatusFormat)
+	format := statusFormat
+	if statusFormat == defaultStatusFormat {
+		if st.Worker {
+			format = workerStatusFormat
+		}
+		// the pod counts go right before the blank line separating nodes
+		if st.APIServerLatency != nil {
+			format = strings.TrimSuffix(format, "\n") + latencyStatusFormat + "\n"
+		}
+		if st.Pods != nil {
+			format = strings.TrimSuffix(format, "\n") + podsStatusFormat + "\n"
+		}
 	}
+	tmpl, err := statusTemplate(format)
 	if err != nil {
 		return err
 	}
 	if err := tmpl.Execute(w, st); err != nil {
 		return err
 	}
-	if st.Kubeconfig == Misconfigured {
+	// a custom format is meant for scripts, which only expect what they asked for
+	if statusFormat == defaultStatusFormat && st.Kubeconfig == Misconfigured {
 		_, err := w.Write([]byte("\nWARNING: Your kubectl is pointing to stale minikube-vm.\nTo fix the kubectl context, run `minikube update-context`\n"))
 		return err
 	}
 	return nil
 }
 
+// statusTemplate parses the template of a status format, a custom format being rendered one node per line
+func statusTemplate(format string) (*template.Template, error) {
+	if format != defaultStatusFormat && !strings.HasSuffix(format, "\n") {
+		format += "\n"
+	}
+	return template.New("status").Funcs(template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Parse(format)
+}
+
+// validateStatusFormat checks that a status format parses, and renders for a node without the optional fields of Status:
+// even with the flags setting them, workers have no APIServerLatency, and no node has Pods while the apiserver is down.
+func validateStatusFormat(format string) error {
+	tmpl, err := statusTemplate(format)
+	if err != nil {
+		return err
+	}
+	if err := tmpl.Execute(ioutil.Discard, &Status{}); err != nil {
+		return errors.Wrap(err, "Pods and APIServerLatency are not set for every node, refer to them within {{if}}, such as {{if .Pods}}{{.Pods.Running}}{{end}}")
+	}
+	return nil
+}
+
+// setKubeletVersions fills in the kubelet version of every node known to the apiserver
+func setKubeletVersions(cname string, statuses []*Status) {
+	running := false
+	for _, st := range statuses {
+		if st.APIServer == state.Running.String() {
+			running = true
+		}
+	}
+	if !running {
+		return
+	}
+
+	client, err := kapi.Client(cname)
+	if err != nil {
+		glog.Warningf("unable to get kubernetes client: %v", err)
+		return
+	}
+	nodes, err := client.CoreV1().Nodes().List(meta.ListOptions{})
+	if err != nil {
+		glog.Warningf("unable to list nodes: %v", err)
+		return
+	}
+
+	versions := map[string]string{}
+	for _, n := range nodes.Items {
+		versions[n.Name] = n.Status.NodeInfo.KubeletVersion
+	}
+	for _, st := range statuses {
+		st.KubeletVersion = versions[st.nodeName]
+	}
+}
+
+// setPodCounts fills in the number of pods in each phase of every node known to the apiserver
+func setPodCounts(cname string, statuses []*Status) {
+	running := false
+	for _, st := range statuses {
+		if st.APIServer == state.Running.String() {
+			running = true
+		}
+	}
+	if !running {
+		out.WarningT("Not counting the pods of each node, as the apiserver is not running")
+		return
+	}
+
+	client, err := kapi.Client(cname)
+	if err != nil {
+		glog.Warningf("unable to get kubernetes client: %v", err)
+		return
+	}
+	pods, err := client.CoreV1().Pods(meta.NamespaceAll).List(meta.ListOptions{})
+	if err != nil {
+		glog.Warningf("unable to list pods: %v", err)
+		return
+	}
+
+	counts := countPods(pods.Items)
+	for _, st := range statuses {
+		c := counts[st.nodeName]
+		st.Pods = &c
+	}
+}
+
+// countPods returns the number of running, pending and failed pods of each node, skipping pods not yet scheduled
+func countPods(pods []core.Pod) map[string]PodCounts {
+	counts := map[string]PodCounts{}
+	for _, p := range pods {
+		if p.Spec.NodeName == "" {
+			continue
+		}
+		c := counts[p.Spec.NodeName]
+		switch p.Status.Phase {
+		case core.PodRunning:
+			c.Running++
+		case core.PodPending:
+			c.Pending++
+		case core.PodFailed:
+			c.Failed++
+		}
+		counts[p.Spec.NodeName] = c
+	}
+	return counts
+}
+
+// versionSkew describes the kubelet version of each node if they are not all the same, or returns an empty string
+func versionSkew(statuses []*Status) string {
+	seen := map[string]bool{}
+	var versions []string
+	for _, st := range statuses {
+		if st.KubeletVersion == "" {
+			continue
+		}
+		seen[st.KubeletVersion] = true
+		versions = append(versions, fmt.Sprintf("%s=%s", st.Name, st.KubeletVersion))
+	}
+	if len(seen) < 2 {
+		return ""
+	}
+	return strings.Join(versions, ", ")
+}
+
+// newStatusRow returns the status of a node along with its configured details
+func newStatusRow(cc config.ClusterConfig, n config.Node, st *Status) statusRow {
+	return statusRow{
+		Status:            st,
+		IP:                n.IP,
+		KubernetesVersion: n.KubernetesVersion,
+		ContainerRuntime:  cc.KubernetesConfig.ContainerRuntime,
+	}
+}
+
+// statusTable writes one line per node, with the node details as extra columns if wide is set
+func statusTable(rows []statusRow, wide bool, w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	header := "NAME\tTYPE\tHOST\tKUBELET\tAPISERVER\tKUBECONFIG"
+	if wide {
+		header += "\tIP\tVERSION\tRUNTIME"
+	}
+	pods := len(rows) > 0 && rows[0].Pods != nil
+	if pods {
+		header += "\tPODS (RUNNING/PENDING/FAILED)"
+	}
+	fmt.Fprintln(tw, header)
+
+	for _, r := range rows {
+		typ := "Control Plane"
+		if r.Worker {
+			typ = "Worker"
+		}
+		line := strings.Join([]string{r.Name, typ, r.Host, r.Kubelet, compactState(r.APIServer), compactState(r.Kubeconfig)}, "\t")
+		if wide {
+			// the version the kubelet reports is more accurate than the configured one
+			version := r.KubernetesVersion
+			if r.KubeletVersion != "" {
+				version = r.KubeletVersion
+			}
+			line += "\t" + strings.Join([]string{r.IP, version, r.ContainerRuntime}, "\t")
+		}
+		if pods && r.Pods != nil {
+			line += fmt.Sprintf("\t%d/%d/%d", r.Pods.Running, r.Pods.Pending, r.Pods.Failed)
+		}
+		fmt.Fprintln(tw, line)
+	}
+	return tw.Flush()
+}
+
+// compactState hides states which aren't meaningful for the node
+func compactState(s string) string {
+	if s == Irrelevant {
+		return "-"
+	}
+	return s
+}
+
 func statusJSON(st []*Status, w io.Writer) error {
 	var js []byte
 	var err error
@@ -304,3 +620,123 @@ func statusJSON(st []*Status, w io.Writer) error {
 	_, err = w.Write(js)
 	return err
 }
+
+// lastStatusPath returns the path the last status of each node of a cluster is kept at
+func lastStatusPath(cname string) string {
+	return filepath.Join(localpath.Profile(cname), "last-status.json")
+}
+
+// loadLastStatuses returns the status of each node the last time it was checked, or nothing if it never was
+func loadLastStatuses(cname string) ([]*Status, error) {
+	b, err := ioutil.ReadFile(lastStatusPath(cname))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var statuses []*Status
+	if err := json.Unmarshal(b, &statuses); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	return statuses, nil
+}
+
+// saveLastStatuses keeps the status of the nodes just checked, along with the last status of the other nodes of the cluster
+func saveLastStatuses(cc config.ClusterConfig, previous []*Status, statuses []*Status) error {
+	b, err := json.Marshal(mergeStatuses(cc, previous, statuses))
+	if err != nil {
+		return errors.Wrap(err, "marshal")
+	}
+	return ioutil.WriteFile(lastStatusPath(cc.Name), b, 0644)
+}
+
+// mergeStatuses returns the statuses just checked, followed by the previous status of the nodes of the cluster which were not checked
+func mergeStatuses(cc config.ClusterConfig, previous []*Status, statuses []*Status) []*Status {
+	checked := map[string]bool{}
+	for _, st := range statuses {
+		checked[st.Name] = true
+	}
+	exists := machineNames(cc)
+	merged := append([]*Status{}, statuses...)
+	for _, st := range previous {
+		if !checked[st.Name] && exists[st.Name] {
+			merged = append(merged, st)
+		}
+	}
+	return merged
+}
+
+// machineNames returns the machine names of the nodes of a cluster, which statuses are named after
+func machineNames(cc config.ClusterConfig) map[string]bool {
+	names := map[string]bool{}
+	for _, n := range cc.Nodes {
+		names[driver.MachineName(cc, n)] = true
+	}
+	return names
+}
+
+// statusChanges describes the components of each node whose state changed between two checks,
+// as well as the nodes added to or removed from the cluster since
+func statusChanges(cc config.ClusterConfig, previous []*Status, statuses []*Status) []string {
+	last := map[string]*Status{}
+	for _, st := range previous {
+		last[st.Name] = st
+	}
+	checked := map[string]bool{}
+	for _, st := range statuses {
+		checked[st.Name] = true
+	}
+
+	var changes []string
+	for _, st := range statuses {
+		prev, ok := last[st.Name]
+		if !ok {
+			// nothing to compare the first status to
+			if len(previous) > 0 {
+				changes = append(changes, fmt.Sprintf("%s: new node", st.Name))
+			}
+			continue
+		}
+		for _, c := range []struct {
+			name string
+			from string
+			to   string
+		}{
+			{"host", prev.Host, st.Host},
+			{"kubelet", prev.Kubelet, st.Kubelet},
+			{"apiserver", prev.APIServer, st.APIServer},
+			{"kubeconfig", prev.Kubeconfig, st.Kubeconfig},
+		} {
+			if c.from != c.to {
+				changes = append(changes, fmt.Sprintf("%s: %s went from %s to %s", st.Name, c.name, c.from, c.to))
+			}
+		}
+	}
+
+	// nodes which were not checked may just have been left out with --node
+	exists := machineNames(cc)
+	for _, st := range previous {
+		if !checked[st.Name] && !exists[st.Name] {
+			changes = append(changes, fmt.Sprintf("%s: removed node", st.Name))
+		}
+	}
+	return changes
+}
+
+// statusChangesText writes the changes since the last status, after the status of every node
+func statusChangesText(changes []string, w io.Writer) error {
+	if len(changes) == 0 {
+		_, err := fmt.Fprintln(w, "changes: none since the last status")
+		return err
+	}
+	if _, err := fmt.Fprintln(w, "changes:"); err != nil {
+		return err
+	}
+	for _, c := range changes {
+		if _, err := fmt.Fprintf(w, "  %s\n", c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -19,7 +19,13 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"reflect"
 	"testing"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/minikube/pkg/minikube/config"
 )
 
 func TestExitCode(t *testing.T) {
@@ -64,6 +70,21 @@ func TestStatusText(t *testing.T) {
 			state: &Status{Name: "minikube", Host: "Stopped", Kubelet: "Stopped", APIServer: "Stopped", Kubeconfig: Misconfigured},
 			want:  "minikube\ntype: Control Plane\nhost: Stopped\nkubelet: Stopped\napiserver: Stopped\nkubeconfig: Misconfigured\n\n\nWARNING: Your kubectl is pointing to stale minikube-vm.\nTo fix the kubectl context, run `minikube update-context`\n",
 		},
+		{
+			name:  "pods",
+			state: &Status{Name: "minikube", Host: "Running", Kubelet: "Running", APIServer: "Running", Kubeconfig: Configured, Pods: &PodCounts{Running: 8, Pending: 1}},
+			want:  "minikube\ntype: Control Plane\nhost: Running\nkubelet: Running\napiserver: Running\nkubeconfig: Configured\npods: 8 running, 1 pending, 0 failed\n\n",
+		},
+		{
+			name:  "latency",
+			state: &Status{Name: "minikube", Host: "Running", Kubelet: "Running", APIServer: "Running", Kubeconfig: Configured, APIServerLatency: &Latency{Milliseconds: 1500, Slow: true}, Pods: &PodCounts{Running: 8}},
+			want:  "minikube\ntype: Control Plane\nhost: Running\nkubelet: Running\napiserver: Running\nkubeconfig: Configured\nlatency: 1500ms (slow)\npods: 8 running, 0 pending, 0 failed\n\n",
+		},
+		{
+			name:  "worker pods",
+			state: &Status{Name: "minikube-m02", Host: "Running", Kubelet: "Running", APIServer: Irrelevant, Kubeconfig: Irrelevant, Worker: true, Pods: &PodCounts{Running: 3, Failed: 2}},
+			want:  "minikube-m02\ntype: Worker\nhost: Running\nkubelet: Running\npods: 3 running, 0 pending, 2 failed\n\n",
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -81,6 +102,56 @@ func TestStatusText(t *testing.T) {
 	}
 }
 
+func TestStatusTextFormat(t *testing.T) {
+	defer func(f string) { statusFormat = f }(statusFormat)
+
+	var tests = []struct {
+		format string
+		state  *Status
+		want   string
+	}{
+		{"{{.Name}} {{.Host}} {{.Kubelet}}", &Status{Name: "minikube", Host: "Running", Kubelet: "Running"}, "minikube Running Running\n"},
+		{"{{.Host}}\n", &Status{Name: "minikube", Host: "Stopped"}, "Stopped\n"},
+		{"{{.APIServer}}", &Status{Name: "minikube", APIServer: "Stopped", Kubeconfig: Misconfigured}, "Stopped\n"},
+		{"{{.Name}} {{json .Pods}}", &Status{Name: "minikube-m02", Pods: &PodCounts{Running: 3}}, "minikube-m02 {\"Running\":3,\"Pending\":0,\"Failed\":0}\n"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.format, func(t *testing.T) {
+			statusFormat = tc.format
+			var b bytes.Buffer
+			if err := statusText(tc.state, &b); err != nil {
+				t.Fatalf("text(%+v) error: %v", tc.state, err)
+			}
+			if got := b.String(); got != tc.want {
+				t.Errorf("text(%+v) = %q, want: %q", tc.state, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateStatusFormat(t *testing.T) {
+	var tests = []struct {
+		format  string
+		wantErr bool
+	}{
+		{defaultStatusFormat, false},
+		{"{{.Name}} {{.Host}} {{.Kubelet}}", false},
+		{"{{.Pods.Running}}", true},
+		{"{{.APIServerLatency.Milliseconds}}", true},
+		{"{{if .Pods}}{{.Pods.Running}}{{end}}", false},
+		{"{{with .APIServerLatency}}{{.Milliseconds}}{{end}}", false},
+		{"{{json .}}", false},
+		{"{{.Name", true},
+		{"{{.Nodes}}", true},
+	}
+	for _, tc := range tests {
+		err := validateStatusFormat(tc.format)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("validateStatusFormat(%q) error = %v, wantErr: %v", tc.format, err, tc.wantErr)
+		}
+	}
+}
+
 func TestStatusJSON(t *testing.T) {
 	var tests = []struct {
 		name  string
@@ -105,3 +176,207 @@ func TestStatusJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestStatusTable(t *testing.T) {
+	rows := []statusRow{
+		{
+			Status:            &Status{Name: "minikube", Host: "Running", Kubelet: "Running", APIServer: "Running", Kubeconfig: Configured, KubeletVersion: "v1.18.4"},
+			IP:                "192.168.39.2",
+			KubernetesVersion: "v1.18.3",
+			ContainerRuntime:  "docker",
+		},
+		{
+			Status:            &Status{Name: "minikube-m02", Host: "Stopped", Kubelet: "Stopped", APIServer: Irrelevant, Kubeconfig: Irrelevant, Worker: true},
+			IP:                "192.168.39.3",
+			KubernetesVersion: "v1.18.3",
+			ContainerRuntime:  "docker",
+		},
+	}
+
+	var tests = []struct {
+		name string
+		wide bool
+		want string
+	}{
+		{
+			name: "compact",
+			want: "NAME          TYPE           HOST     KUBELET  APISERVER  KUBECONFIG\n" +
+				"minikube      Control Plane  Running  Running  Running    Configured\n" +
+				"minikube-m02  Worker         Stopped  Stopped  -          -\n",
+		},
+		{
+			name: "wide",
+			wide: true,
+			want: "NAME          TYPE           HOST     KUBELET  APISERVER  KUBECONFIG  IP            VERSION  RUNTIME\n" +
+				"minikube      Control Plane  Running  Running  Running    Configured  192.168.39.2  v1.18.4  docker\n" +
+				"minikube-m02  Worker         Stopped  Stopped  -          -           192.168.39.3  v1.18.3  docker\n",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := statusTable(rows, tc.wide, &b); err != nil {
+				t.Errorf("table(wide=%v) error: %v", tc.wide, err)
+			}
+
+			got := b.String()
+			if got != tc.want {
+				t.Errorf("table(wide=%v) = %q, want: %q", tc.wide, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStatusTablePods(t *testing.T) {
+	rows := []statusRow{
+		{Status: &Status{Name: "minikube", Host: "Running", Kubelet: "Running", APIServer: "Running", Kubeconfig: Configured, Pods: &PodCounts{Running: 5, Pending: 1}}},
+		{Status: &Status{Name: "minikube-m02", Host: "Stopped", Kubelet: "Stopped", APIServer: Irrelevant, Kubeconfig: Irrelevant, Worker: true, Pods: &PodCounts{}}},
+	}
+	want := "NAME          TYPE           HOST     KUBELET  APISERVER  KUBECONFIG  PODS (RUNNING/PENDING/FAILED)\n" +
+		"minikube      Control Plane  Running  Running  Running    Configured  5/1/0\n" +
+		"minikube-m02  Worker         Stopped  Stopped  -          -           0/0/0\n"
+
+	var b bytes.Buffer
+	if err := statusTable(rows, false, &b); err != nil {
+		t.Errorf("table() error: %v", err)
+	}
+	if got := b.String(); got != want {
+		t.Errorf("table() = %q, want: %q", got, want)
+	}
+}
+
+func TestNewLatency(t *testing.T) {
+	var tests = []struct {
+		d    time.Duration
+		want Latency
+	}{
+		{d: 20 * time.Millisecond, want: Latency{Milliseconds: 20}},
+		{d: time.Second, want: Latency{Milliseconds: 1000}},
+		{d: 1200 * time.Millisecond, want: Latency{Milliseconds: 1200, Slow: true}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.d.String(), func(t *testing.T) {
+			if got := newLatency(tc.d, time.Second); *got != tc.want {
+				t.Errorf("newLatency(%s) = %+v, want: %+v", tc.d, *got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCountPods(t *testing.T) {
+	pod := func(node string, phase core.PodPhase) core.Pod {
+		return core.Pod{Spec: core.PodSpec{NodeName: node}, Status: core.PodStatus{Phase: phase}}
+	}
+	pods := []core.Pod{
+		pod("minikube", core.PodRunning),
+		pod("minikube", core.PodRunning),
+		pod("minikube", core.PodSucceeded),
+		pod("minikube-m02", core.PodPending),
+		pod("minikube-m02", core.PodFailed),
+		pod("", core.PodPending),
+	}
+	want := map[string]PodCounts{
+		"minikube":     {Running: 2},
+		"minikube-m02": {Pending: 1, Failed: 1},
+	}
+	if got := countPods(pods); !reflect.DeepEqual(got, want) {
+		t.Errorf("countPods() = %+v, want: %+v", got, want)
+	}
+}
+
+func TestVersionSkew(t *testing.T) {
+	var tests = []struct {
+		name     string
+		versions []string
+		want     string
+	}{
+		{"same", []string{"v1.18.3", "v1.18.3"}, ""},
+		{"unknown", []string{"v1.18.3", ""}, ""},
+		{"skew", []string{"v1.18.3", "v1.17.0", ""}, "minikube=v1.18.3, minikube-m02=v1.17.0"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var statuses []*Status
+			for i, v := range tc.versions {
+				name := "minikube"
+				if i > 0 {
+					name = fmt.Sprintf("minikube-m%02d", i+1)
+				}
+				statuses = append(statuses, &Status{Name: name, KubeletVersion: v})
+			}
+			got := versionSkew(statuses)
+			if got != tc.want {
+				t.Errorf("versionSkew(%v) = %q, want: %q", tc.versions, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStatusChanges(t *testing.T) {
+	running := func(name string) *Status {
+		return &Status{Name: name, Host: "Running", Kubelet: "Running", APIServer: Irrelevant, Kubeconfig: Irrelevant, Worker: true}
+	}
+	stopped := &Status{Name: "minikube-m03", Host: "Stopped", Kubelet: "Stopped", APIServer: "Stopped", Kubeconfig: "Stopped", Worker: true}
+	cc := config.ClusterConfig{Name: "minikube", Nodes: []config.Node{{Name: "", ControlPlane: true}, {Name: "m02"}, {Name: "m03"}}}
+
+	var tests = []struct {
+		name     string
+		previous []*Status
+		statuses []*Status
+		want     []string
+	}{
+		{name: "first", statuses: []*Status{running("minikube-m02")}},
+		{name: "unchanged", previous: []*Status{running("minikube-m02")}, statuses: []*Status{running("minikube-m02")}},
+		{
+			name:     "stopped",
+			previous: []*Status{running("minikube-m02"), running("minikube-m03")},
+			statuses: []*Status{running("minikube-m02"), stopped},
+			want: []string{
+				"minikube-m03: host went from Running to Stopped",
+				"minikube-m03: kubelet went from Running to Stopped",
+				"minikube-m03: apiserver went from Irrelevant to Stopped",
+				"minikube-m03: kubeconfig went from Irrelevant to Stopped",
+			},
+		},
+		{name: "new", previous: []*Status{running("minikube-m02")}, statuses: []*Status{running("minikube-m02"), running("minikube-m03")}, want: []string{"minikube-m03: new node"}},
+		{name: "removed", previous: []*Status{running("minikube-m02"), running("minikube-m04")}, statuses: []*Status{running("minikube-m02")}, want: []string{"minikube-m04: removed node"}},
+		{name: "not checked", previous: []*Status{running("minikube-m02"), running("minikube-m03")}, statuses: []*Status{running("minikube-m02")}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := statusChanges(cc, tc.previous, tc.statuses); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("statusChanges() = %q, want: %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMergeStatuses(t *testing.T) {
+	cc := config.ClusterConfig{Name: "minikube", Nodes: []config.Node{{Name: "", ControlPlane: true}, {Name: "m02"}}}
+	previous := []*Status{{Name: "minikube", Host: "Stopped"}, {Name: "minikube-m02", Host: "Stopped"}, {Name: "minikube-m03", Host: "Running"}}
+	statuses := []*Status{{Name: "minikube", Host: "Running"}}
+
+	got := mergeStatuses(cc, previous, statuses)
+	want := []*Status{{Name: "minikube", Host: "Running"}, {Name: "minikube-m02", Host: "Stopped"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeStatuses() = %+v, want: %+v", got, want)
+	}
+}
+
+func TestStatusChangesText(t *testing.T) {
+	var b bytes.Buffer
+	if err := statusChangesText(nil, &b); err != nil {
+		t.Fatalf("statusChangesText() error: %v", err)
+	}
+	if got, want := b.String(), "changes: none since the last status\n"; got != want {
+		t.Errorf("statusChangesText(nil) = %q, want: %q", got, want)
+	}
+
+	b.Reset()
+	if err := statusChangesText([]string{"minikube-m03: host went from Running to Stopped"}, &b); err != nil {
+		t.Fatalf("statusChangesText() error: %v", err)
+	}
+	if got, want := b.String(), "changes:\n  minikube-m03: host went from Running to Stopped\n"; got != want {
+		t.Errorf("statusChangesText() = %q, want: %q", got, want)
+	}
+}
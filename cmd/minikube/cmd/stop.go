@@ -17,6 +17,7 @@ limitations under the License.
 package cmd
 
 import (
+	"strings"
 	"time"
 
 	"github.com/docker/machine/libmachine"
@@ -31,12 +32,15 @@ import (
 	"k8s.io/minikube/pkg/minikube/kubeconfig"
 	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/machine"
-	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/metrics"
 	"k8s.io/minikube/pkg/minikube/out"
 	"k8s.io/minikube/pkg/util/retry"
 )
 
-var stopAll bool
+var (
+	stopAll           bool
+	stopMetricsOutput string
+)
 
 // stopCmd represents the stop command
 var stopCmd = &cobra.Command{
@@ -49,7 +53,8 @@ itself, leaving all files intact. The cluster can be started again with the "sta
 
 func init() {
 
-	stopCmd.Flags().BoolVar(&stopAll, "all", false, "Set flag to stop all profiles (clusters)")
+	stopCmd.Flags().BoolVar(&stopAll, "all", false, "Set flag to stop all profiles (clusters), continuing past the profiles which fail to stop")
+	stopCmd.Flags().StringVar(&stopMetricsOutput, "metrics-output", "", "Write stop timings to this file, as JSON if it ends in .json and in the Prometheus text format otherwise.")
 
 	if err := viper.GetViper().BindPFlags(stopCmd.Flags()); err != nil {
 		exit.WithError("unable to bind flags", err)
@@ -74,31 +79,80 @@ func runStop(cmd *cobra.Command, args []string) {
 		cname := ClusterFlagValue()
 		profilesToStop = append(profilesToStop, cname)
 	}
-	for _, profile := range profilesToStop {
-		// end new code
-		api, cc := mustload.Partial(profile)
-		defer api.Close()
+	// end new code
 
-		for _, n := range cc.Nodes {
-			machineName := driver.MachineName(*cc, n)
-			nonexistent := stop(api, machineName)
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		exit.WithError("libmachine failed", err)
+	}
+	defer api.Close()
 
-			if !nonexistent {
-				out.T(out.Stopped, `Node "{{.node_name}}" stopped.`, out.V{"node_name": machineName})
+	// with --all, a profile which fails to stop is reported and the others are still stopped
+	var failed []string
+	for _, profile := range profilesToStop {
+		if err := stopProfile(api, profile); err != nil {
+			if !stopAll {
+				if config.IsNotExist(errors.Cause(err)) {
+					exit.WithCodeT(exit.Data, `There is no local cluster named "{{.cluster}}"`, out.V{"cluster": profile})
+				}
+				exit.WithError("Unable to stop", err)
 			}
+			out.FailureT("Unable to stop profile {{.profile}}: {{.error}}", out.V{"profile": profile, "error": err})
+			failed = append(failed, profile)
+			continue
+		}
+		if stopAll {
+			out.T(out.Stopped, `Profile "{{.profile}}" stopped.`, out.V{"profile": profile})
 		}
+	}
 
-		if err := killMountProcess(); err != nil {
-			out.WarningT("Unable to kill mount process: {{.error}}", out.V{"error": err})
+	if stopMetricsOutput != "" {
+		if err := metrics.Write(stopMetricsOutput); err != nil {
+			out.WarningT("Unable to write metrics to {{.path}}: {{.error}}", out.V{"path": stopMetricsOutput, "error": err})
 		}
+	}
+
+	if len(failed) > 0 {
+		exit.WithCodeT(exit.Failure, "{{.failed}} of {{.count}} profiles could not be stopped: {{.profiles}}", out.V{"failed": len(failed), "count": len(profilesToStop), "profiles": strings.Join(failed, ", ")})
+	}
+}
 
-		if err := kubeconfig.UnsetCurrentContext(profile, kubeconfig.PathFromEnv()); err != nil {
-			exit.WithError("update config", err)
+// stopProfile stops every node of a profile, returning rather than exiting on errors so that other profiles can still be stopped
+func stopProfile(api libmachine.API, profile string) error {
+	begin := time.Now()
+	glog.Infof("Loading cluster: %s", profile)
+	cc, err := config.Load(profile)
+	if err != nil {
+		return errors.Wrap(err, "load config")
+	}
+
+	for _, n := range cc.Nodes {
+		machineName := driver.MachineName(*cc, n)
+		nodeBegin := time.Now()
+		nonexistent, err := stop(api, machineName)
+		if err != nil {
+			return errors.Wrapf(err, "stop %s", machineName)
+		}
+		metrics.Record(metrics.NodeStopDuration, time.Since(nodeBegin), map[string]string{"profile": profile, "node": machineName})
+
+		if !nonexistent {
+			out.T(out.Stopped, `Node "{{.node_name}}" stopped.`, out.V{"node_name": machineName})
 		}
 	}
+
+	if err := killMountProcess(cc); err != nil {
+		out.WarningT("Unable to kill mount process: {{.error}}", out.V{"error": err})
+	}
+
+	if err := kubeconfig.UnsetCurrentContext(profile, kubeconfig.PathFromEnv()); err != nil {
+		return errors.Wrap(err, "update config")
+	}
+	metrics.Record(metrics.StopDuration, time.Since(begin), map[string]string{"profile": profile})
+	return nil
 }
 
-func stop(api libmachine.API, machineName string) bool {
+// stop stops a machine, returning whether it does not exist
+func stop(api libmachine.API, machineName string) (bool, error) {
 	nonexistent := false
 
 	tryStop := func() (err error) {
@@ -119,8 +173,8 @@ func stop(api libmachine.API, machineName string) bool {
 	}
 
 	if err := retry.Expo(tryStop, 1*time.Second, 120*time.Second, 5); err != nil {
-		exit.WithError("Unable to stop VM", err)
+		return false, err
 	}
 
-	return nonexistent
+	return nonexistent, nil
 }
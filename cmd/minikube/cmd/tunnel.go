@@ -18,26 +18,36 @@ package cmd
 
 import (
 	"context"
+	"net"
 	"os"
 	"os/signal"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"syscall"
 
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
 
 	"k8s.io/minikube/pkg/drivers/kic/oci"
 	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
 	"k8s.io/minikube/pkg/minikube/tunnel"
 	"k8s.io/minikube/pkg/minikube/tunnel/kic"
 )
 
-var cleanup bool
+var (
+	cleanup          bool
+	cleanupOnExit    bool
+	bindAddress      string
+	allowUnreachable bool
+)
 
 // tunnelCmd represents the tunnel command
 var tunnelCmd = &cobra.Command{
@@ -52,6 +62,10 @@ var tunnelCmd = &cobra.Command{
 		cname := ClusterFlagValue()
 		co := mustload.Healthy(cname)
 
+		if bindAddress != "" && net.ParseIP(bindAddress) == nil {
+			exit.UsageT("Invalid --bind-address: {{.address}}", out.V{"address": bindAddress})
+		}
+
 		if cleanup {
 			glog.Info("Checking for tunnels to cleanup...")
 			if err := manager.CleanupNotRunningTunnels(); err != nil {
@@ -69,7 +83,7 @@ var tunnelCmd = &cobra.Command{
 		}
 
 		ctrlC := make(chan os.Signal, 1)
-		signal.Notify(ctrlC, os.Interrupt)
+		signal.Notify(ctrlC, os.Interrupt, syscall.SIGTERM)
 		ctx, cancel := context.WithCancel(context.Background())
 		go func() {
 			<-ctrlC
@@ -78,14 +92,7 @@ var tunnelCmd = &cobra.Command{
 
 		if driver.NeedsPortForward(co.Config.Driver) {
 
-			port, err := oci.ForwardedPort(oci.Docker, cname, 22)
-			if err != nil {
-				exit.WithError("error getting ssh port", err)
-			}
-			sshPort := strconv.Itoa(port)
-			sshKey := filepath.Join(localpath.MiniPath(), "machines", cname, "id_rsa")
-
-			kicSSHTunnel := kic.NewSSHTunnel(ctx, sshPort, sshKey, clientset.CoreV1())
+			kicSSHTunnel := kic.NewSSHTunnel(ctx, sshNodes(*co.Config), bindAddress, clientset.CoreV1())
 			err = kicSSHTunnel.Start()
 			if err != nil {
 				exit.WithError("error starting tunnel", err)
@@ -94,6 +101,28 @@ var tunnelCmd = &cobra.Command{
 			return
 		}
 
+		if bindAddress != "" {
+			out.WarningT("The {{.driver}} driver is reached through a route, so --bind-address is ignored", out.V{"driver": co.Config.Driver})
+		}
+
+		if unreachable := tunnel.UnreachableNodes(*co.Config); len(unreachable) > 0 {
+			if !allowUnreachable {
+				exit.WithCodeT(exit.Unavailable, "Nodes {{.nodes}} are not reachable from this host, so services with pods on them would not respond through the tunnel. Use --allow-unreachable-nodes to start the tunnel anyway.", out.V{"nodes": strings.Join(unreachable, ", ")})
+			}
+			out.WarningT("Nodes {{.nodes}} are not reachable from this host, services with pods on them may not respond through the tunnel", out.V{"nodes": strings.Join(unreachable, ", ")})
+		}
+
+		if cleanupOnExit {
+			// routes which failed to be removed when the tunnel stopped are retried once more before exiting
+			defer func() {
+				if err := manager.CleanupOwnTunnels(); err != nil {
+					glog.Errorf("error cleaning up routes: %s", err)
+				}
+			}()
+		} else {
+			manager.KeepRoutesOnExit()
+		}
+
 		done, err := manager.StartTunnel(ctx, cname, co.API, config.DefaultLoader, clientset.CoreV1())
 		if err != nil {
 			exit.WithError("error starting tunnel", err)
@@ -102,6 +131,43 @@ var tunnelCmd = &cobra.Command{
 	},
 }
 
+// sshNodes returns the nodes services can be tunneled through, the primary control plane first
+func sshNodes(cc config.ClusterConfig) []kic.SSHNode {
+	cp, err := config.PrimaryControlPlane(&cc)
+	if err != nil {
+		exit.WithError("error getting primary control plane", err)
+	}
+	var nodes []kic.SSHNode
+	for _, n := range append([]config.Node{cp}, cc.Nodes...) {
+		if len(nodes) > 0 && n.Name == cp.Name {
+			continue
+		}
+		machineName := driver.MachineName(cc, n)
+		port, err := oci.ForwardedPort(oci.Docker, machineName, 22)
+		if err != nil {
+			if n.Name == cp.Name {
+				exit.WithError("error getting ssh port", err)
+			}
+			out.WarningT("Unable to tunnel through node {{.name}}, services with pods on it are tunneled through the control plane: {{.error}}", out.V{"name": n.Name, "error": err})
+			continue
+		}
+		host := n.ListenAddress
+		if ip := net.ParseIP(host); ip == nil || ip.IsUnspecified() {
+			host = "127.0.0.1"
+		}
+		nodes = append(nodes, kic.SSHNode{
+			Name: bsutil.KubeNodeName(cc, n),
+			Host: host,
+			Port: strconv.Itoa(port),
+			Key:  filepath.Join(localpath.MiniPath(), "machines", machineName, "id_rsa"),
+		})
+	}
+	return nodes
+}
+
 func init() {
 	tunnelCmd.Flags().BoolVarP(&cleanup, "cleanup", "c", true, "call with cleanup=true to remove old tunnels")
+	tunnelCmd.Flags().BoolVar(&cleanupOnExit, "cleanup-on-exit", true, "If true, remove the routes and restore the services of the tunnel when it is interrupted or terminated. If false, they are kept until the next tunnel cleans them up.")
+	tunnelCmd.Flags().BoolVar(&allowUnreachable, "allow-unreachable-nodes", false, "If true, start the tunnel even though some nodes are not reachable from this host, in which case services with pods on them may not respond.")
+	tunnelCmd.Flags().StringVar(&bindAddress, "bind-address", "", "The local address the tunnels listen on, such as 0.0.0.0 to expose services to other hosts. Defaults to localhost. (docker driver on macOS and Windows only)")
 }
@@ -17,9 +17,17 @@ limitations under the License.
 package cmd
 
 import (
+	"github.com/docker/machine/libmachine"
+	"github.com/docker/machine/libmachine/state"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/kubeconfig"
+	"k8s.io/minikube/pkg/minikube/machine"
 	"k8s.io/minikube/pkg/minikube/mustload"
 	"k8s.io/minikube/pkg/minikube/out"
 )
@@ -29,20 +37,65 @@ var updateContextCmd = &cobra.Command{
 	Use:   "update-context",
 	Short: "Update kubeconfig in case of an IP or port change",
 	Long: `Retrieves the IP address of the running cluster, checks it
-			with IP in kubeconfig, and corrects kubeconfig if incorrect.`,
+			with IP in kubeconfig, and corrects kubeconfig if incorrect.
+			If the primary control plane is not running, another control plane with a healthy apiserver is used.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		cname := ClusterFlagValue()
-		co := mustload.Running(cname)
+		api, cc := mustload.Partial(cname)
+		defer api.Close()
 
-		updated, err := kubeconfig.UpdateEndpoint(cname, co.CP.Hostname, co.CP.Port, kubeconfig.PathFromEnv())
+		name, hostname, port, err := healthyControlPlane(api, *cc, "")
+		if err != nil {
+			exit.WithCodeT(exit.Unavailable, "No control plane of {{.cluster}} has a running apiserver: {{.error}}", out.V{"cluster": cname, "error": err})
+		}
+		glog.Infof("using control plane %s at %s:%d", name, hostname, port)
+
+		updated, err := kubeconfig.UpdateEndpoint(cname, hostname, port, kubeconfig.PathFromEnv())
 		if err != nil {
 			exit.WithError("update config", err)
 		}
 		if updated {
-			out.T(out.Celebrate, `"{{.context}}" context has been updated to point to {{.hostname}}:{{.port}}`, out.V{"context": cname, "hostname": co.CP.Hostname, "port": co.CP.Port})
+			out.T(out.Celebrate, `"{{.context}}" context has been updated to point to {{.hostname}}:{{.port}}`, out.V{"context": cname, "hostname": hostname, "port": port})
 		} else {
 			out.T(out.Meh, `No changes required for the "{{.context}}" context`, out.V{"context": cname})
 		}
 
 	},
 }
+
+// healthyControlPlane returns the machine name and host-accessible apiserver endpoint of the first control plane
+// with a running apiserver, ignoring the machine named skip
+func healthyControlPlane(api libmachine.API, cc config.ClusterConfig, skip string) (string, string, int, error) {
+	for _, n := range cc.Nodes {
+		if !n.ControlPlane {
+			continue
+		}
+		machineName := driver.MachineName(cc, n)
+		if machineName == skip || !machine.IsRunning(api, machineName) {
+			continue
+		}
+
+		h, err := machine.LoadHost(api, machineName)
+		if err != nil {
+			glog.Warningf("unable to load host %s: %v", machineName, err)
+			continue
+		}
+		cr, err := machine.CommandRunner(h)
+		if err != nil {
+			glog.Warningf("unable to get command runner for %s: %v", machineName, err)
+			continue
+		}
+		hostname, _, port, err := driver.ControlPlaneEndpoint(&cc, &n, h.DriverName)
+		if err != nil {
+			glog.Warningf("unable to get apiserver endpoint of %s: %v", machineName, err)
+			continue
+		}
+
+		st, err := kverify.APIServerStatus(cr, hostname, port)
+		glog.Infof("%s apiserver status = %s (err=%v)", machineName, st, err)
+		if err == nil && st == state.Running {
+			return machineName, hostname, port, nil
+		}
+	}
+	return "", "", 0, errors.New("no running control plane found")
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/verify"
+)
+
+var verifyTimeout time.Duration
+
+// verifyCmd represents the verify command
+var verifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Runs a battery of checks against a running cluster",
+	Long: `Runs a battery of checks against a running cluster: every node is Ready, CoreDNS resolves cluster names,
+pods on different nodes can reach each other and storage can be provisioned. Exits non-zero if any check fails.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cname := ClusterFlagValue()
+		mustload.Healthy(cname)
+
+		client, err := kapi.Client(cname)
+		if err != nil {
+			exit.WithError("Failed to get kubernetes client", err)
+		}
+
+		out.T(out.Verifying, "Verifying cluster {{.name}} ...", out.V{"name": cname})
+		reportChecks(cname, verify.Run(client, verify.Checks(), verifyTimeout))
+	},
+}
+
+// reportChecks prints the outcome of every check, exiting if any of them failed
+func reportChecks(cname string, results []verify.Result) {
+	for _, r := range results {
+		switch {
+		case r.Err != nil:
+			out.T(out.FailureType, "FAIL: {{.check}}: {{.error}}", out.V{"check": r.Name, "error": r.Err})
+		case r.Skipped != "":
+			out.T(out.Option, "SKIP: {{.check}}: {{.reason}}", out.V{"check": r.Name, "reason": r.Skipped})
+		default:
+			out.T(out.Check, "PASS: {{.check}}", out.V{"check": r.Name})
+		}
+	}
+
+	if failed := verify.Failed(results); failed > 0 {
+		exit.WithCodeT(exit.Failure, "{{.failed}} of {{.total}} checks failed", out.V{"failed": failed, "total": len(results)})
+	}
+	out.T(out.Ready, "All checks passed for cluster {{.name}}", out.V{"name": cname})
+}
+
+func init() {
+	verifyCmd.Flags().DurationVar(&verifyTimeout, "timeout", 3*time.Minute, "max time to wait for each check to complete")
+}
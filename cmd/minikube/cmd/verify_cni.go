@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/exit"
+	"k8s.io/minikube/pkg/minikube/mustload"
+	"k8s.io/minikube/pkg/minikube/out"
+	"k8s.io/minikube/pkg/minikube/verify"
+)
+
+var verifyCNITimeout time.Duration
+
+// verifyCNICmd represents the verify-cni command
+var verifyCNICmd = &cobra.Command{
+	Use:   "verify-cni",
+	Short: "Checks pod and service connectivity across the nodes of a running cluster",
+	Long: `Checks pod and service connectivity across the nodes of a running cluster: short-lived pods are scheduled on every node,
+and must reach each other and a service backed by a pod on the first node. The pods and the service are deleted afterwards.
+Exits non-zero if any check fails.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cname := ClusterFlagValue()
+		mustload.Healthy(cname)
+
+		client, err := kapi.Client(cname)
+		if err != nil {
+			exit.WithError("Failed to get kubernetes client", err)
+		}
+
+		out.T(out.Verifying, "Verifying the network of cluster {{.name}} ...", out.V{"name": cname})
+		reportChecks(cname, verify.Run(client, verify.CNIChecks(), verifyCNITimeout))
+	},
+}
+
+func init() {
+	verifyCNICmd.Flags().DurationVar(&verifyCNITimeout, "timeout", 3*time.Minute, "max time to wait for each check to complete")
+}
@@ -18,6 +18,7 @@ package addons
 
 import (
 	"fmt"
+	"io"
 	"path"
 	"runtime"
 	"sort"
@@ -216,6 +217,7 @@ func isAddonAlreadySet(cc *config.ClusterConfig, addon *assets.Addon, enable boo
 
 func enableOrDisableAddonInternal(cc *config.ClusterConfig, addon *assets.Addon, cmd command.Runner, data interface{}, enable bool) error {
 	deployFiles := []string{}
+	var workloads []workload
 
 	for _, addon := range addon.Assets {
 		var f assets.CopyableFile
@@ -246,6 +248,16 @@ func enableOrDisableAddonInternal(cc *config.ClusterConfig, addon *assets.Addon,
 		}
 		if strings.HasSuffix(fPath, ".yaml") {
 			deployFiles = append(deployFiles, fPath)
+			if enable {
+				if _, err := f.Seek(0, io.SeekStart); err != nil {
+					return errors.Wrapf(err, "rewind %s", fPath)
+				}
+				ws, err := waitForWorkloads(f)
+				if err != nil {
+					return errors.Wrapf(err, "addon %s asset %s", addon.GetSourcePath(), fPath)
+				}
+				workloads = append(workloads, ws...)
+			}
 		}
 	}
 
@@ -260,7 +272,24 @@ func enableOrDisableAddonInternal(cc *config.ClusterConfig, addon *assets.Addon,
 		return err
 	}
 
-	return retry.Expo(apply, 250*time.Millisecond, 2*time.Minute)
+	if err := retry.Expo(apply, 250*time.Millisecond, 2*time.Minute); err != nil {
+		return err
+	}
+	if len(workloads) == 0 {
+		return nil
+	}
+
+	client, err := kapi.Client(cc.Name)
+	if err != nil {
+		return errors.Wrap(err, "kubernetes client")
+	}
+	for _, w := range workloads {
+		out.T(out.HealthCheck, "Waiting for {{.kind}} {{.name}} to be ready ...", out.V{"kind": w.Kind, "name": w.Name})
+		if err := waitForReady(client, w, waitForTimeout); err != nil {
+			return errors.Wrapf(err, "waiting for %s", w)
+		}
+	}
+	return nil
 }
 
 // enableOrDisableStorageClasses enables or disables storage classes
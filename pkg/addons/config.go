@@ -151,6 +151,11 @@ var Addons = []*Addon{
 		set:       SetBool,
 		callbacks: []setFn{enableOrDisableAddon},
 	},
+	{
+		name:      "nodelocaldns",
+		set:       SetBool,
+		callbacks: []setFn{enableOrDisableAddon},
+	},
 	{
 		name:      "ambassador",
 		set:       SetBool,
@@ -0,0 +1,133 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// waitForAnnotation names a workload which has to be Ready before an addon is considered enabled, formatted as kind/name
+	waitForAnnotation = "minikube.k8s.io/wait-for"
+	// waitForTimeout is how long an addon is given to become Ready
+	waitForTimeout = 3 * time.Minute
+)
+
+// workload is a Deployment, StatefulSet or DaemonSet an addon waits for
+type workload struct {
+	Namespace string
+	Kind      string
+	Name      string
+}
+
+func (w workload) String() string {
+	return fmt.Sprintf("%s/%s/%s", w.Namespace, w.Kind, w.Name)
+}
+
+// waitForWorkloads returns the workloads named by the wait-for annotations of the objects in a manifest
+func waitForWorkloads(manifest io.Reader) ([]workload, error) {
+	var ws []workload
+	d := yaml.NewDecoder(manifest)
+	for {
+		var obj struct {
+			Metadata struct {
+				Namespace   string            `yaml:"namespace"`
+				Annotations map[string]string `yaml:"annotations"`
+			} `yaml:"metadata"`
+		}
+		err := d.Decode(&obj)
+		if err == io.EOF {
+			return ws, nil
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "parse manifest")
+		}
+
+		v, ok := obj.Metadata.Annotations[waitForAnnotation]
+		if !ok {
+			continue
+		}
+		kv := strings.SplitN(v, "/", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("invalid %s annotation %q, expected kind/name", waitForAnnotation, v)
+		}
+		kind := strings.ToLower(kv[0])
+		if kind != "deployment" && kind != "statefulset" && kind != "daemonset" {
+			return nil, fmt.Errorf("invalid %s annotation %q, kind must be one of deployment, statefulset, daemonset", waitForAnnotation, v)
+		}
+
+		ns := obj.Metadata.Namespace
+		if ns == "" {
+			ns = meta.NamespaceDefault
+		}
+		ws = append(ws, workload{Namespace: ns, Kind: kind, Name: kv[1]})
+	}
+}
+
+// waitForReady waits until every replica of a workload is Ready
+func waitForReady(client kubernetes.Interface, w workload, timeout time.Duration) error {
+	return wait.PollImmediate(2*time.Second, timeout, func() (bool, error) {
+		ready, err := workloadReady(client, w)
+		if err != nil {
+			glog.Infof("unable to get %s, will retry: %v", w, err)
+			return false, nil
+		}
+		return ready, nil
+	})
+}
+
+func workloadReady(client kubernetes.Interface, w workload) (bool, error) {
+	switch w.Kind {
+	case "deployment":
+		d, err := client.AppsV1().Deployments(w.Namespace).Get(w.Name, meta.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		replicas := int32(1)
+		if d.Spec.Replicas != nil {
+			replicas = *d.Spec.Replicas
+		}
+		return d.Status.ObservedGeneration >= d.Generation && d.Status.ReadyReplicas >= replicas, nil
+	case "statefulset":
+		s, err := client.AppsV1().StatefulSets(w.Namespace).Get(w.Name, meta.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		replicas := int32(1)
+		if s.Spec.Replicas != nil {
+			replicas = *s.Spec.Replicas
+		}
+		return s.Status.ObservedGeneration >= s.Generation && s.Status.ReadyReplicas >= replicas, nil
+	case "daemonset":
+		ds, err := client.AppsV1().DaemonSets(w.Namespace).Get(w.Name, meta.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return ds.Status.ObservedGeneration >= ds.Generation && ds.Status.DesiredNumberScheduled > 0 && ds.Status.NumberReady >= ds.Status.DesiredNumberScheduled, nil
+	}
+	return false, fmt.Errorf("unsupported kind %q", w.Kind)
+}
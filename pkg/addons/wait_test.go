@@ -0,0 +1,132 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package addons
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	apps "k8s.io/api/apps/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestWaitForWorkloads(t *testing.T) {
+	var tests = []struct {
+		description string
+		manifest    string
+		want        []workload
+		shouldErr   bool
+	}{
+		{
+			description: "no annotation",
+			manifest:    "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: demo\n",
+		},
+		{
+			description: "annotated deployment",
+			manifest: `---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: demo
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+  namespace: demo
+  annotations:
+    minikube.k8s.io/wait-for: Deployment/web
+`,
+			want: []workload{{Namespace: "demo", Kind: "deployment", Name: "web"}},
+		},
+		{
+			description: "default namespace",
+			manifest:    "kind: ConfigMap\nmetadata:\n  name: cfg\n  annotations:\n    minikube.k8s.io/wait-for: daemonset/agent\n",
+			want:        []workload{{Namespace: "default", Kind: "daemonset", Name: "agent"}},
+		},
+		{
+			description: "missing name",
+			manifest:    "kind: Deployment\nmetadata:\n  name: web\n  annotations:\n    minikube.k8s.io/wait-for: web\n",
+			shouldErr:   true,
+		},
+		{
+			description: "unsupported kind",
+			manifest:    "kind: Job\nmetadata:\n  name: web\n  annotations:\n    minikube.k8s.io/wait-for: job/web\n",
+			shouldErr:   true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			got, err := waitForWorkloads(strings.NewReader(tc.manifest))
+			if err != nil && !tc.shouldErr {
+				t.Fatalf("waitForWorkloads() unexpected error: %v", err)
+			}
+			if err == nil && tc.shouldErr {
+				t.Fatalf("waitForWorkloads() expected error but got none")
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("waitForWorkloads() = %+v, want: %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWorkloadReady(t *testing.T) {
+	replicas := int32(2)
+	client := fake.NewSimpleClientset(
+		&apps.Deployment{
+			ObjectMeta: meta.ObjectMeta{Name: "ready", Namespace: "demo"},
+			Spec:       apps.DeploymentSpec{Replicas: &replicas},
+			Status:     apps.DeploymentStatus{ReadyReplicas: 2},
+		},
+		&apps.Deployment{
+			ObjectMeta: meta.ObjectMeta{Name: "starting", Namespace: "demo"},
+			Spec:       apps.DeploymentSpec{Replicas: &replicas},
+			Status:     apps.DeploymentStatus{ReadyReplicas: 1},
+		},
+		&apps.DaemonSet{
+			ObjectMeta: meta.ObjectMeta{Name: "agent", Namespace: "demo"},
+			Status:     apps.DaemonSetStatus{DesiredNumberScheduled: 3, NumberReady: 3},
+		},
+	)
+
+	var tests = []struct {
+		w    workload
+		want bool
+	}{
+		{workload{Namespace: "demo", Kind: "deployment", Name: "ready"}, true},
+		{workload{Namespace: "demo", Kind: "deployment", Name: "starting"}, false},
+		{workload{Namespace: "demo", Kind: "daemonset", Name: "agent"}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.w.String(), func(t *testing.T) {
+			got, err := workloadReady(client, tc.w)
+			if err != nil {
+				t.Fatalf("workloadReady() unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("workloadReady(%s) = %v, want: %v", tc.w, got, tc.want)
+			}
+		})
+	}
+
+	if _, err := workloadReady(client, workload{Namespace: "demo", Kind: "statefulset", Name: "missing"}); err == nil {
+		t.Errorf("workloadReady() of a missing workload expected an error")
+	}
+}
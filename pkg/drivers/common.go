@@ -17,6 +17,7 @@ limitations under the License.
 package drivers
 
 import (
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
@@ -39,6 +40,14 @@ func GetDiskPath(d *drivers.BaseDriver) string {
 	return filepath.Join(d.ResolveStorePath("."), d.GetMachineName()+".rawdisk")
 }
 
+// MaxExtraDisks is the number of additional disks that fit in the vdb..vdz device names
+const MaxExtraDisks = 25
+
+// ExtraDiskPath returns the path of an additional raw disk image of the machine
+func ExtraDiskPath(d *drivers.BaseDriver, index int) string {
+	return filepath.Join(d.ResolveStorePath("."), fmt.Sprintf("%s-%d.rawdisk", d.GetMachineName(), index))
+}
+
 // CommonDriver is the common driver base class
 type CommonDriver struct{}
 
@@ -122,6 +131,28 @@ func MakeDiskImage(d *drivers.BaseDriver, boot2dockerURL string, diskSize int) e
 	return nil
 }
 
+// CreateRawDisk creates an empty sparse raw disk image, if it does not already exist
+func CreateRawDisk(diskPath string, diskSizeMb int) error {
+	if _, err := os.Stat(diskPath); err == nil {
+		glog.Infof("%s already exists", diskPath)
+		return nil
+	}
+
+	glog.Infof("Creating raw disk image: %s...", diskPath)
+	file, err := os.OpenFile(diskPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return errors.Wrap(err, "open")
+	}
+	if err := file.Close(); err != nil {
+		return errors.Wrapf(err, "closing file %s", diskPath)
+	}
+
+	if err := os.Truncate(diskPath, int64(diskSizeMb*1000000)); err != nil {
+		return errors.Wrap(err, "truncate")
+	}
+	return nil
+}
+
 func fixMachinePermissions(path string) error {
 	glog.Infof("Fixing permissions on %s ...", path)
 	if err := os.Chown(path, syscall.Getuid(), syscall.Getegid()); err != nil {
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net"
 	"os/exec"
+	"runtime"
 	"strconv"
 	"strings"
 	"sync"
@@ -78,23 +79,26 @@ func (d *Driver) Create() error {
 		ExtraArgs:     []string{"--expose", fmt.Sprintf("%d", d.NodeConfig.APIServerPort)},
 		OCIBinary:     d.NodeConfig.OCIBinary,
 		APIServerPort: d.NodeConfig.APIServerPort,
+		GPUs:          d.NodeConfig.GPUs,
+		Arch:          d.NodeConfig.Arch,
 	}
 
 	// control plane specific options
+	listen := d.listenAddress()
 	params.PortMappings = append(params.PortMappings, oci.PortMapping{
-		ListenAddress: oci.DefaultBindIPV4,
+		ListenAddress: listen,
 		ContainerPort: int32(params.APIServerPort),
 	},
 		oci.PortMapping{
-			ListenAddress: oci.DefaultBindIPV4,
+			ListenAddress: listen,
 			ContainerPort: constants.SSHPort,
 		},
 		oci.PortMapping{
-			ListenAddress: oci.DefaultBindIPV4,
+			ListenAddress: listen,
 			ContainerPort: constants.DockerDaemonPort,
 		},
 		oci.PortMapping{
-			ListenAddress: oci.DefaultBindIPV4,
+			ListenAddress: listen,
 			ContainerPort: constants.RegistryAddonPort,
 		},
 	)
@@ -126,6 +130,11 @@ func (d *Driver) Create() error {
 		waitForPreload.Add(1)
 		go func() {
 			defer waitForPreload.Done()
+			// The preloaded images are built for the architecture of the host
+			if d.NodeConfig.Arch != "" && d.NodeConfig.Arch != runtime.GOARCH {
+				glog.Infof("Node architecture is %s, skipping extracting preloaded images", d.NodeConfig.Arch)
+				return
+			}
 			// If preload doesn't exist, don't bother extracting tarball to volume
 			if !download.PreloadExists(d.NodeConfig.KubernetesVersion, d.NodeConfig.ContainerRuntime) {
 				return
@@ -195,12 +204,29 @@ func (d *Driver) GetIP() (string, error) {
 
 // GetExternalIP returns an IP which is accissble from outside
 func (d *Driver) GetExternalIP() (string, error) {
-	return oci.DefaultBindIPV4, nil
+	return d.hostAddress(), nil
 }
 
 // GetSSHHostname returns hostname for use with ssh
 func (d *Driver) GetSSHHostname() (string, error) {
-	return oci.DefaultBindIPV4, nil
+	return d.hostAddress(), nil
+}
+
+// hostAddress returns the host address the published ports of the container are reached on
+func (d *Driver) hostAddress() string {
+	// ports published on every address are reachable through the loopback
+	if ip := net.ParseIP(d.listenAddress()); ip != nil && ip.IsUnspecified() {
+		return oci.DefaultBindIPV4
+	}
+	return d.listenAddress()
+}
+
+// listenAddress returns the host address the ports of the container are published on
+func (d *Driver) listenAddress() string {
+	if d.NodeConfig.ListenAddress != "" {
+		return d.NodeConfig.ListenAddress
+	}
+	return oci.DefaultBindIPV4
 }
 
 // GetSSHPort returns port for use with ssh
@@ -184,6 +184,20 @@ func CreateContainerNode(p CreateParams) error {
 		runArgs = append(runArgs, "-e", fmt.Sprintf("%s=%s", key, val))
 	}
 
+	if p.GPUs != "" {
+		if p.OCIBinary != Docker {
+			return errors.Errorf("GPU passthrough is not supported by %s", p.OCIBinary)
+		}
+		runArgs = append(runArgs, "--gpus", p.GPUs)
+	}
+
+	if p.Arch != "" {
+		if p.OCIBinary != Docker {
+			return errors.Errorf("setting the architecture is not supported by %s", p.OCIBinary)
+		}
+		runArgs = append(runArgs, "--platform", "linux/"+p.Arch)
+	}
+
 	// adds node specific args
 	runArgs = append(runArgs, p.ExtraArgs...)
 
@@ -290,6 +304,22 @@ func StartContainer(ociBin string, container string) error {
 	return nil
 }
 
+// PauseContainer freezes every process of a container with "docker/podman pause"
+func PauseContainer(ociBin string, container string) error {
+	if _, err := runCmd(exec.Command(ociBin, "pause", container)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UnpauseContainer resumes a container frozen by PauseContainer
+func UnpauseContainer(ociBin string, container string) error {
+	if _, err := runCmd(exec.Command(ociBin, "unpause", container)); err != nil {
+		return err
+	}
+	return nil
+}
+
 // ContainerID returns id of a container name
 func ContainerID(ociBin string, nameOrID string) (string, error) {
 	rr, err := runCmd(exec.Command(ociBin, "container", "inspect", "-f", "{{.Id}}", nameOrID))
@@ -48,6 +48,8 @@ type CreateParams struct {
 	Envs          map[string]string // environment variables to pass to the container
 	ExtraArgs     []string          // a list of any extra option to pass to oci binary during creation time, for example --expose 8080...
 	OCIBinary     string            // docker or podman
+	GPUs          string            // GPUs to pass through to the container, only supported by docker
+	Arch          string            // CPU architecture of the container, only supported by docker
 }
 
 // createOpt is an option for Create
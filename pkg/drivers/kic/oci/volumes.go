@@ -63,6 +63,19 @@ func PruneAllVolumesByLabel(ociBin string, label string, warnSlow ...bool) []err
 	return deleteErrs
 }
 
+// DeleteVolume deletes the volume with the given name, returning false if there was no volume to delete
+func DeleteVolume(ociBin string, name string) (bool, error) {
+	if _, err := runCmd(exec.Command(ociBin, "volume", "inspect", name)); err != nil {
+		glog.Infof("no %s volume named %s to delete: %v", ociBin, name, err)
+		return false, nil
+	}
+
+	if _, err := runCmd(exec.Command(ociBin, "volume", "rm", "--force", name)); err != nil {
+		return false, errors.Wrapf(err, "delete volume %s", name)
+	}
+	return true, nil
+}
+
 // allVolumesByLabel returns name of all docker volumes by a specific label
 // will not return error if there is no volume found.
 func allVolumesByLabel(ociBin string, label string) ([]string, error) {
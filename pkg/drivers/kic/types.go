@@ -60,4 +60,7 @@ type Config struct {
 	Envs              map[string]string // key,value of environment variables passed to the node
 	KubernetesVersion string            // Kubernetes version to install
 	ContainerRuntime  string            // container runtime kic is running
+	GPUs              string            // GPUs to pass through to the container, as accepted by docker run --gpus
+	Arch              string            // CPU architecture of the container, empty means the architecture of the host
+	ListenAddress     string            // host address the ports of the container are published on, empty means 127.0.0.1
 }
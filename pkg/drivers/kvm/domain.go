@@ -27,6 +27,7 @@ import (
 
 	libvirt "github.com/libvirt/libvirt-go"
 	"github.com/pkg/errors"
+	pkgdrivers "k8s.io/minikube/pkg/drivers"
 )
 
 const domainTmpl = `
@@ -62,6 +63,13 @@ const domainTmpl = `
       <source file='{{.DiskPath}}'/>
       <target dev='hda' bus='virtio'/>
     </disk>
+    {{range .ExtraDiskDevices}}
+    <disk type='file' device='disk'>
+      <driver name='qemu' type='raw' cache='default' io='threads' />
+      <source file='{{.Path}}'/>
+      <target dev='{{.Target}}' bus='virtio'/>
+    </disk>
+    {{end}}
     <interface type='network'>
       <source network='{{.Network}}'/>
       <mac address='{{.MAC}}'/>
@@ -141,6 +149,24 @@ func closeDomain(dom *libvirt.Domain, conn *libvirt.Connect) error {
 	return err
 }
 
+// ExtraDisk is an additional raw disk attached to the domain
+type ExtraDisk struct {
+	Path   string
+	Target string
+}
+
+// ExtraDiskDevices returns the additional disks to attach to the domain, as vdb, vdc, ...
+func (d *Driver) ExtraDiskDevices() []ExtraDisk {
+	var disks []ExtraDisk
+	for i := 0; i < d.ExtraDisks; i++ {
+		disks = append(disks, ExtraDisk{
+			Path:   pkgdrivers.ExtraDiskPath(d.BaseDriver, i),
+			Target: fmt.Sprintf("vd%c", 'b'+i),
+		})
+	}
+	return disks
+}
+
 func (d *Driver) createDomain() (*libvirt.Domain, error) {
 	// create random MAC addresses first for our NICs
 	if d.MAC == "" {
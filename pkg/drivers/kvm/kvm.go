@@ -81,6 +81,12 @@ type Driver struct {
 
 	// QEMU Connection URI
 	ConnectionURI string
+
+	// The number of additional raw disks to attach to the VM
+	ExtraDisks int
+
+	// The size of each additional disk, in MB
+	ExtraDiskSize int
 }
 
 const (
@@ -332,6 +338,12 @@ func (d *Driver) Create() (err error) {
 		return errors.Wrap(err, "error creating disk")
 	}
 
+	for i := 0; i < d.ExtraDisks; i++ {
+		if err := pkgdrivers.CreateRawDisk(pkgdrivers.ExtraDiskPath(d.BaseDriver, i), d.ExtraDiskSize); err != nil {
+			return errors.Wrap(err, "creating extra disk")
+		}
+	}
+
 	if err := ensureDirPermissions(store); err != nil {
 		log.Errorf("unable to ensure permissions on %s: %v", store, err)
 	}
@@ -19,9 +19,11 @@ package assets
 import (
 	"runtime"
 
+	"github.com/golang/glog"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
 	"k8s.io/minikube/pkg/minikube/vmpath"
+	"k8s.io/minikube/pkg/util"
 )
 
 // Addon is a named list of assets, that can be enabled
@@ -399,6 +401,14 @@ var Addons = map[string]*Addon{
 			"0640",
 			true),
 	}, false, "metallb"),
+	"nodelocaldns": NewAddon([]*BinAsset{
+		MustBinAsset(
+			"deploy/addons/nodelocaldns/nodelocaldns.yaml.tmpl",
+			vmpath.GuestAddonsDir,
+			"nodelocaldns.yaml",
+			"0640",
+			true),
+	}, false, "nodelocaldns"),
 	"ambassador": NewAddon([]*BinAsset{
 		MustBinAsset(
 			"deploy/addons/ambassador/ambassador-operator-crds.yaml",
@@ -431,18 +441,39 @@ func GenerateTemplateData(cfg config.KubernetesConfig) interface{} {
 	if runtime.GOARCH != "amd64" {
 		ea = "-" + runtime.GOARCH
 	}
+	serviceCIDR := cfg.ServiceCIDR
+	if serviceCIDR == "" {
+		serviceCIDR = constants.DefaultServiceCIDR
+	}
+	dnsIP := ""
+	if ip, err := util.GetDNSIP(serviceCIDR); err != nil {
+		glog.Warningf("unable to get the DNS service IP of %s: %v", serviceCIDR, err)
+	} else {
+		dnsIP = ip.String()
+	}
+	dnsDomain := cfg.DNSDomain
+	if dnsDomain == "" {
+		dnsDomain = constants.ClusterDNSDomain
+	}
+
 	opts := struct {
 		Arch                string
 		ExoticArch          string
 		ImageRepository     string
 		LoadBalancerStartIP string
 		LoadBalancerEndIP   string
+		ProvisionerNode     string
+		DNSIP               string
+		DNSDomain           string
 	}{
 		Arch:                a,
 		ExoticArch:          ea,
 		ImageRepository:     cfg.ImageRepository,
 		LoadBalancerStartIP: cfg.LoadBalancerStartIP,
 		LoadBalancerEndIP:   cfg.LoadBalancerEndIP,
+		ProvisionerNode:     cfg.ProvisionerNode,
+		DNSIP:               dnsIP,
+		DNSDomain:           dnsDomain,
 	}
 
 	return opts
@@ -45,6 +45,8 @@ type Bootstrapper interface {
 	// LogCommands returns a map of log type to a command which will display that log.
 	LogCommands(config.ClusterConfig, LogOptions) map[string]string
 	SetupCerts(config.KubernetesConfig, config.Node) error
+	// RenewCerts renews the certificates of a control plane, keeping the certificate authorities.
+	RenewCerts(config.ClusterConfig, config.Node) error
 	GetAPIServerStatus(string, int) (string, error)
 }
 
@@ -36,9 +36,20 @@ import (
 	"k8s.io/minikube/pkg/minikube/vmpath"
 )
 
-// TransferBinaries transfers all required Kubernetes binaries
-func TransferBinaries(cfg config.KubernetesConfig, c command.Runner, sm sysinit.Manager) error {
-	ok, err := binariesExist(cfg, c)
+// archMarker is the file next to the Kubernetes binaries of a node recording the architecture they are built for
+const archMarker = ".arch"
+
+// NodeArch returns the CPU architecture of the node
+func NodeArch(n config.Node) string {
+	if n.Arch != "" {
+		return n.Arch
+	}
+	return runtime.GOARCH
+}
+
+// TransferBinaries transfers all required Kubernetes binaries, built for the given architecture
+func TransferBinaries(cfg config.KubernetesConfig, arch string, c command.Runner, sm sysinit.Manager) error {
+	ok, err := binariesExist(cfg, arch, c)
 	if err == nil && ok {
 		glog.Info("Found k8s binaries, skipping transfer")
 		return nil
@@ -55,7 +66,7 @@ func TransferBinaries(cfg config.KubernetesConfig, c command.Runner, sm sysinit.
 	for _, name := range constants.KubernetesReleaseBinaries {
 		name := name
 		g.Go(func() error {
-			src, err := download.Binary(name, cfg.KubernetesVersion, "linux", runtime.GOARCH)
+			src, err := download.Binary(name, cfg.KubernetesVersion, "linux", arch, cfg.BinaryMirror)
 			if err != nil {
 				return errors.Wrapf(err, "downloading %s", name)
 			}
@@ -73,17 +84,29 @@ func TransferBinaries(cfg config.KubernetesConfig, c command.Runner, sm sysinit.
 			return nil
 		})
 	}
-	return g.Wait()
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	// record the architecture, so that binaries of another one are never mistaken for the right ones
+	marker := path.Join(dir, archMarker)
+	if _, err := c.RunCmd(exec.Command("sudo", "/bin/bash", "-c", fmt.Sprintf("echo %s > %s", arch, marker))); err != nil {
+		return errors.Wrapf(err, "writing %s", marker)
+	}
+	return nil
 }
 
-// binariesExist returns true if the binaries already exist
-func binariesExist(cfg config.KubernetesConfig, c command.Runner) (bool, error) {
+// binariesExist returns true if the binaries already exist, built for the given architecture
+func binariesExist(cfg config.KubernetesConfig, arch string, c command.Runner) (bool, error) {
 	dir := binRoot(cfg.KubernetesVersion)
 	rr, err := c.RunCmd(exec.Command("sudo", "ls", dir))
 	stdout := rr.Stdout.String()
 	if err != nil {
 		return false, err
 	}
+	if got := binariesArch(c, dir); got != arch {
+		return false, fmt.Errorf("preexisting binaries are built for %s, not %s", got, arch)
+	}
 	foundBinaries := map[string]struct{}{}
 	for _, binary := range strings.Split(stdout, "\n") {
 		foundBinaries[binary] = struct{}{}
@@ -96,6 +119,16 @@ func binariesExist(cfg config.KubernetesConfig, c command.Runner) (bool, error)
 	return true, nil
 }
 
+// binariesArch returns the architecture recorded with the binaries of a node. Binaries transferred by older
+// versions or extracted from a preload carry no record, and are built for the architecture of the host.
+func binariesArch(c command.Runner, dir string) string {
+	rr, err := c.RunCmd(exec.Command("sudo", "cat", path.Join(dir, archMarker)))
+	if err != nil {
+		return runtime.GOARCH
+	}
+	return strings.TrimSpace(rr.Stdout.String())
+}
+
 // binRoot returns the persistent path binaries are stored in
 func binRoot(version string) string {
 	return path.Join(vmpath.GuestPersistentDir, "binaries", version)
@@ -0,0 +1,61 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bsutil
+
+import (
+	"runtime"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestBinariesExist(t *testing.T) {
+	cfg := config.KubernetesConfig{KubernetesVersion: "v1.18.0"}
+	dir := "/var/lib/minikube/binaries/v1.18.0"
+	foreign := "arm64"
+	if runtime.GOARCH == foreign {
+		foreign = "amd64"
+	}
+
+	var tests = []struct {
+		description string
+		marker      string
+		arch        string
+		want        bool
+	}{
+		{"no marker, host arch", "", runtime.GOARCH, true},
+		{"no marker, foreign arch", "", foreign, false},
+		{"marker matches", foreign, foreign, true},
+		{"marker differs", foreign, runtime.GOARCH, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			c := command.NewFakeCommandRunner()
+			cmds := map[string]string{"sudo ls " + dir: "kubeadm\nkubectl\nkubelet\n"}
+			if tc.marker != "" {
+				cmds["sudo cat "+dir+"/.arch"] = tc.marker + "\n"
+			}
+			c.SetCommandToOutput(cmds)
+
+			got, _ := binariesExist(cfg, tc.arch, c)
+			if got != tc.want {
+				t.Errorf("binariesExist(%s) = %v, want %v", tc.arch, got, tc.want)
+			}
+		})
+	}
+}
@@ -110,6 +110,28 @@ func FindInvalidExtraConfigFlags(opts config.ExtraOptionSlice) []string {
 	return invalidOpts
 }
 
+// FindInvalidEvictionThresholds returns the kubelet eviction-hard and eviction-soft thresholds which are not of the form signal<quantity
+func FindInvalidEvictionThresholds(opts config.ExtraOptionSlice) []string {
+	var invalid []string
+	for _, opt := range opts {
+		if opt.Component != Kubelet || (opt.Key != "eviction-hard" && opt.Key != "eviction-soft") {
+			continue
+		}
+		for _, t := range strings.Split(opt.Value, ",") {
+			parts := strings.SplitN(t, "<", 2)
+			if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+				invalid = append(invalid, t)
+			}
+		}
+	}
+	return invalid
+}
+
+// IsKubeletEvictionOption returns whether an extra option configures kubelet eviction
+func IsKubeletEvictionOption(opt config.ExtraOption) bool {
+	return opt.Component == Kubelet && strings.HasPrefix(opt.Key, "eviction-")
+}
+
 // extraConfigForComponent generates a map of flagname-value pairs for a k8s
 // component.
 func extraConfigForComponent(component string, opts config.ExtraOptionSlice, version semver.Version) (map[string]string, error) {
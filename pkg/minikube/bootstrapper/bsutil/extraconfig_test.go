@@ -57,3 +57,43 @@ func TestFindInvalidExtraConfigFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestFindInvalidEvictionThresholds(t *testing.T) {
+	tests := []struct {
+		name string
+		opts config.ExtraOptionSlice
+		want []string
+	}{
+		{
+			name: "valid thresholds",
+			opts: config.ExtraOptionSlice{
+				{Component: Kubelet, Key: "eviction-hard", Value: "memory.available<200Mi,nodefs.available<10%"},
+				{Component: Kubelet, Key: "eviction-soft", Value: "memory.available<500Mi"},
+				{Component: Kubelet, Key: "eviction-soft-grace-period", Value: "memory.available=1m30s"},
+			},
+			want: nil,
+		},
+		{
+			name: "invalid thresholds",
+			opts: config.ExtraOptionSlice{
+				{Component: Kubelet, Key: "eviction-hard", Value: "memory.available=200Mi,nodefs.available<10%"},
+				{Component: Kubelet, Key: "eviction-soft", Value: "<500Mi"},
+			},
+			want: []string{"memory.available=200Mi", "<500Mi"},
+		},
+		{
+			name: "other components",
+			opts: config.ExtraOptionSlice{
+				{Component: Apiserver, Key: "eviction-hard", Value: "bogus"},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FindInvalidEvictionThresholds(tt.opts); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindInvalidEvictionThresholds() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -20,7 +20,10 @@ package bsutil
 import (
 	"bytes"
 	"fmt"
+	"net"
 	"path"
+	"regexp"
+	"strconv"
 
 	"github.com/blang/semver"
 	"github.com/golang/glog"
@@ -37,6 +40,9 @@ import (
 // Container runtimes
 const remoteContainerRuntime = "remote"
 
+// joinEndpointRe matches the endpoint argument of a kubeadm join command
+var joinEndpointRe = regexp.MustCompile(`(kubeadm join )\S+`)
+
 // GenerateKubeadmYAML generates the kubeadm.yaml file
 func GenerateKubeadmYAML(cc config.ClusterConfig, n config.Node, r cruntime.Manager) ([]byte, error) {
 	k8s := cc.KubernetesConfig
@@ -51,20 +57,11 @@ func GenerateKubeadmYAML(cc config.ClusterConfig, n config.Node, r cruntime.Mana
 		return nil, errors.Wrap(err, "parses feature gate config for kubeadm and component")
 	}
 
-	// In case of no port assigned, use default
 	cp, err := config.PrimaryControlPlane(&cc)
 	if err != nil {
 		return nil, errors.Wrap(err, "getting control plane")
 	}
-	nodePort := cp.Port
-	if nodePort <= 0 {
-		nodePort = constants.APIServerPort
-	}
-
-	componentOpts, err := createExtraComponentConfig(k8s.ExtraOptions, version, componentFeatureArgs, cp)
-	if err != nil {
-		return nil, errors.Wrap(err, "generating extra component config for kubeadm")
-	}
+	nodePort := APIServerPort(cc, cp)
 
 	cnm, err := cni.New(cc)
 	if err != nil {
@@ -78,6 +75,16 @@ func GenerateKubeadmYAML(cc config.ClusterConfig, n config.Node, r cruntime.Mana
 	}
 	glog.Infof("Using pod CIDR: %s", podCIDR)
 
+	extraOpts, err := nodeCIDRMaskOptions(k8s.ExtraOptions, k8s.NodeCIDRMaskSize, podCIDR)
+	if err != nil {
+		return nil, errors.Wrap(err, "node CIDR mask size")
+	}
+
+	componentOpts, err := createExtraComponentConfig(extraOpts, version, componentFeatureArgs, cp)
+	if err != nil {
+		return nil, errors.Wrap(err, "generating extra component config for kubeadm")
+	}
+
 	opts := struct {
 		CertDir             string
 		ServiceCIDR         string
@@ -105,8 +112,8 @@ func GenerateKubeadmYAML(cc config.ClusterConfig, n config.Node, r cruntime.Mana
 		AdvertiseAddress:  n.IP,
 		APIServerPort:     nodePort,
 		KubernetesVersion: k8s.KubernetesVersion,
-		EtcdDataDir:       EtcdDataDir(),
-		EtcdExtraArgs:     etcdExtraArgs(k8s.ExtraOptions),
+		EtcdDataDir:       ClusterEtcdDataDir(k8s),
+		EtcdExtraArgs:     etcdExtraArgs(k8s.ExtraOptions, k8s.EtcdQuotaBackendBytes),
 		ClusterName:       cc.Name,
 		//kubeadm uses NodeName as the --hostname-override parameter, so this needs to be the name of the machine
 		NodeName:            KubeNodeName(cc, n),
@@ -144,6 +151,27 @@ func GenerateKubeadmYAML(cc config.ClusterConfig, n config.Node, r cruntime.Mana
 	return b.Bytes(), nil
 }
 
+// nodeCIDRMaskOptions adds the node CIDR mask size to the controller-manager options, unless set with --extra-config
+func nodeCIDRMaskOptions(opts config.ExtraOptionSlice, maskSize int, podCIDR string) (config.ExtraOptionSlice, error) {
+	if maskSize == 0 || opts.Get("node-cidr-mask-size", ControllerManager) != "" {
+		return opts, nil
+	}
+	// without a pod CIDR, the controller-manager allocates no CIDR to nodes
+	if podCIDR == "" {
+		glog.Warningf("ignoring node CIDR mask size /%d, as there is no pod CIDR without a CNI", maskSize)
+		return opts, nil
+	}
+	_, subnet, err := net.ParseCIDR(podCIDR)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing pod CIDR %q", podCIDR)
+	}
+	if ones, _ := subnet.Mask.Size(); maskSize < ones {
+		return nil, fmt.Errorf("/%d is larger than the pod CIDR %s", maskSize, podCIDR)
+	}
+	res := append(config.ExtraOptionSlice{}, opts...)
+	return append(res, config.ExtraOption{Component: ControllerManager, Key: "node-cidr-mask-size", Value: strconv.Itoa(maskSize)}), nil
+}
+
 // These are the components that can be configured
 // through the "extra-config"
 const (
@@ -167,6 +195,24 @@ var KubeadmExtraConfigOpts = []string{
 	Kubeproxy,
 }
 
+// APIServerPort returns the port the apiserver of the control plane cp listens on
+func APIServerPort(cc config.ClusterConfig, cp config.Node) int {
+	if cp.Port > 0 {
+		return cp.Port
+	}
+	// In case of no port assigned, use the cluster wide one or the default
+	if cc.KubernetesConfig.NodePort > 0 {
+		return cc.KubernetesConfig.NodePort
+	}
+	return constants.APIServerPort
+}
+
+// SetJoinEndpoint points a kubeadm join command at the control plane alias on the given port,
+// as the endpoint printed by kubeadm may be stale if the apiserver port was changed
+func SetJoinEndpoint(joinCmd string, port int) string {
+	return joinEndpointRe.ReplaceAllString(joinCmd, fmt.Sprintf("${1}%s", net.JoinHostPort(constants.ControlPlaneAlias, strconv.Itoa(port))))
+}
+
 // InvokeKubeadm returns the invocation command for Kubeadm
 func InvokeKubeadm(version string) string {
 	return fmt.Sprintf("sudo env PATH=%s:$PATH kubeadm", binRoot(version))
@@ -177,8 +223,20 @@ func EtcdDataDir() string {
 	return path.Join(vmpath.GuestPersistentDir, "etcd")
 }
 
-func etcdExtraArgs(extraOpts config.ExtraOptionSlice) map[string]string {
+// ClusterEtcdDataDir is where etcd data is stored on every control plane of a cluster
+func ClusterEtcdDataDir(k8s config.KubernetesConfig) string {
+	if k8s.EtcdDataDir != "" {
+		return k8s.EtcdDataDir
+	}
+	return EtcdDataDir()
+}
+
+// etcdExtraArgs returns the etcd arguments, a quota set with --extra-config taking precedence over the one of the cluster
+func etcdExtraArgs(extraOpts config.ExtraOptionSlice, quota int64) map[string]string {
 	args := map[string]string{}
+	if quota > 0 {
+		args["quota-backend-bytes"] = strconv.FormatInt(quota, 10)
+	}
 	for _, eo := range extraOpts {
 		if eo.Component != Etcd {
 			continue
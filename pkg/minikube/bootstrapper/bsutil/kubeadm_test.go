@@ -257,8 +257,121 @@ func TestEtcdExtraArgs(t *testing.T) {
 		Key:       "key",
 		Value:     "value",
 	})
-	actual := etcdExtraArgs(extraOpts)
+	actual := etcdExtraArgs(extraOpts, 0)
 	if diff := cmp.Diff(expected, actual); diff != "" {
 		t.Errorf("machines mismatch (-want +got):\n%s", diff)
 	}
 }
+
+func TestEtcdExtraArgsQuota(t *testing.T) {
+	tests := []struct {
+		name      string
+		extraOpts config.ExtraOptionSlice
+		quota     int64
+		want      map[string]string
+	}{
+		{"unset", nil, 0, map[string]string{}},
+		{"quota", nil, 8589934592, map[string]string{"quota-backend-bytes": "8589934592"}},
+		{"extra-config", config.ExtraOptionSlice{{Component: Etcd, Key: "quota-backend-bytes", Value: "4294967296"}}, 8589934592, map[string]string{"quota-backend-bytes": "4294967296"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := etcdExtraArgs(tc.extraOpts, tc.quota)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("etcdExtraArgs() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestClusterEtcdDataDir(t *testing.T) {
+	if got := ClusterEtcdDataDir(config.KubernetesConfig{}); got != EtcdDataDir() {
+		t.Errorf("ClusterEtcdDataDir() = %q, want: %q", got, EtcdDataDir())
+	}
+	if got := ClusterEtcdDataDir(config.KubernetesConfig{EtcdDataDir: "/var/lib/minikube/etcd-fast"}); got != "/var/lib/minikube/etcd-fast" {
+		t.Errorf("ClusterEtcdDataDir() = %q, want: %q", got, "/var/lib/minikube/etcd-fast")
+	}
+}
+
+func TestNodeCIDRMaskOptions(t *testing.T) {
+	mask := config.ExtraOption{Component: ControllerManager, Key: "node-cidr-mask-size", Value: "23"}
+	user := config.ExtraOption{Component: ControllerManager, Key: "node-cidr-mask-size", Value: "25"}
+	tests := []struct {
+		name      string
+		opts      config.ExtraOptionSlice
+		size      int
+		podCIDR   string
+		want      config.ExtraOptionSlice
+		shouldErr bool
+	}{
+		{name: "unset", podCIDR: "10.244.0.0/16"},
+		{name: "set", size: 23, podCIDR: "10.244.0.0/16", want: config.ExtraOptionSlice{mask}},
+		{name: "extra-config wins", opts: config.ExtraOptionSlice{user}, size: 23, podCIDR: "10.244.0.0/16", want: config.ExtraOptionSlice{user}},
+		{name: "no cni", size: 23},
+		{name: "larger than pod cidr", size: 15, podCIDR: "10.244.0.0/16", shouldErr: true},
+		{name: "invalid pod cidr", size: 23, podCIDR: "10.244.0.0", shouldErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := nodeCIDRMaskOptions(tc.opts, tc.size, tc.podCIDR)
+			if (err != nil) != tc.shouldErr {
+				t.Fatalf("nodeCIDRMaskOptions() error = %v, shouldErr: %v", err, tc.shouldErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("nodeCIDRMaskOptions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAPIServerPort(t *testing.T) {
+	var tests = []struct {
+		description string
+		nodePort    int
+		cpPort      int
+		want        int
+	}{
+		{"control plane", 8443, 9443, 9443},
+		{"cluster wide", 9443, 0, 9443},
+		{"default", 0, 0, constants.APIServerPort},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			cc := config.ClusterConfig{KubernetesConfig: config.KubernetesConfig{NodePort: tc.nodePort}}
+			got := APIServerPort(cc, config.Node{Port: tc.cpPort})
+			if got != tc.want {
+				t.Errorf("APIServerPort() = %d, want: %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSetJoinEndpoint(t *testing.T) {
+	var tests = []struct {
+		joinCmd string
+		port    int
+		want    string
+	}{
+		{
+			joinCmd: "kubeadm join control-plane.minikube.internal:8443 --token abc --discovery-token-ca-cert-hash sha256:def",
+			port:    9443,
+			want:    "kubeadm join control-plane.minikube.internal:9443 --token abc --discovery-token-ca-cert-hash sha256:def",
+		},
+		{
+			joinCmd: "kubeadm join 192.168.39.2:8443 --token abc",
+			port:    8443,
+			want:    "kubeadm join control-plane.minikube.internal:8443 --token abc",
+		},
+		{
+			joinCmd: "kubeadm token list",
+			port:    9443,
+			want:    "kubeadm token list",
+		},
+	}
+	for _, tc := range tests {
+		got := SetJoinEndpoint(tc.joinCmd, tc.port)
+		if got != tc.want {
+			t.Errorf("SetJoinEndpoint(%q, %d) = %q, want: %q", tc.joinCmd, tc.port, got, tc.want)
+		}
+	}
+}
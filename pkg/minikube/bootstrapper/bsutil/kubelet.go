@@ -19,10 +19,14 @@ package bsutil
 
 import (
 	"bytes"
+	"fmt"
 	"os"
 	"path"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/ktmpl"
 	"k8s.io/minikube/pkg/minikube/bootstrapper/images"
 	"k8s.io/minikube/pkg/minikube/cni"
@@ -47,6 +51,8 @@ func extraKubeletOpts(mc config.ClusterConfig, nc config.Node, r cruntime.Manage
 	cgroupDriver, err := r.CGroupDriver()
 	if err == nil {
 		extraOpts["cgroup-driver"] = cgroupDriver
+	} else if nc.CgroupDriver != "" {
+		extraOpts["cgroup-driver"] = nc.CgroupDriver
 	}
 
 	for k, v := range r.KubeletOptions() {
@@ -72,6 +78,30 @@ func extraKubeletOpts(mc config.ClusterConfig, nc config.Node, r cruntime.Manage
 	if _, ok := extraOpts["pod-infra-container-image"]; !ok && k8s.ImageRepository != "" && pauseImage != "" && k8s.ContainerRuntime != remoteContainerRuntime {
 		extraOpts["pod-infra-container-image"] = pauseImage
 	}
+	// the pause image of a node is configured in its runtime too, so it overrides the one of the image repository
+	if nc.PauseImage != "" && k8s.ContainerRuntime != remoteContainerRuntime {
+		if _, ok := k8s.ExtraOptions.AsMap().Get(Kubelet)["pod-infra-container-image"]; !ok {
+			extraOpts["pod-infra-container-image"] = nc.PauseImage
+		}
+	}
+
+	// the kubelet only rotates the logs of containers run through CRI, docker rotates them itself
+	if extraOpts["container-runtime"] == remoteContainerRuntime {
+		size, files := ContainerLogRotation(mc, nc)
+		if _, ok := extraOpts["container-log-max-size"]; !ok && size != "" {
+			extraOpts["container-log-max-size"] = size
+		}
+		if _, ok := extraOpts["container-log-max-files"]; !ok && files != 0 {
+			extraOpts["container-log-max-files"] = strconv.Itoa(files)
+		}
+	}
+
+	// a pod CIDR larger than /24 only fits more pods if the kubelet accepts more than its default
+	if _, ok := extraOpts["max-pods"]; !ok {
+		if pods := maxPods(k8s.NodeCIDRMaskSize); pods != 0 {
+			extraOpts["max-pods"] = strconv.Itoa(pods)
+		}
+	}
 
 	// parses a map of the feature gates for kubelet
 	_, kubeletFeatureArgs, err := parseFeatureArgs(k8s.FeatureGates)
@@ -83,9 +113,78 @@ func extraKubeletOpts(mc config.ClusterConfig, nc config.Node, r cruntime.Manage
 		extraOpts["feature-gates"] = kubeletFeatureArgs
 	}
 
+	// per-node arguments take precedence over everything else
+	for k, v := range kubeletArgs(nc.KubeletExtraArgs) {
+		extraOpts[k] = v
+	}
+
 	return extraOpts, nil
 }
 
+// maxPods returns the pods a kubelet accepts with the given node CIDR mask size, keeping the ratio of its default
+// of 110 pods per /24, or 0 to keep the default
+func maxPods(maskSize int) int {
+	if maskSize == 0 || maskSize >= 24 {
+		return 0
+	}
+	return 110 << uint(24-maskSize)
+}
+
+// ValidateKubeletExtraArgs checks that per-node kubelet arguments are formatted as --flag or --flag=value
+func ValidateKubeletExtraArgs(args []string) error {
+	for _, a := range args {
+		name := strings.SplitN(a, "=", 2)[0]
+		if !strings.HasPrefix(name, "--") || strings.TrimPrefix(name, "--") == "" {
+			return fmt.Errorf("invalid kubelet argument %q, expected --flag=value", a)
+		}
+	}
+	return nil
+}
+
+// ValidateContainerLogRotation checks the size a container log is rotated at, and the number of log files kept per container.
+// An empty size and 0 files mean the kubelet defaults.
+func ValidateContainerLogRotation(size string, files int) error {
+	if size != "" {
+		q, err := resource.ParseQuantity(size)
+		if err != nil {
+			return errors.Wrapf(err, "invalid container log size %q", size)
+		}
+		if q.Sign() <= 0 {
+			return fmt.Errorf("invalid container log size %q, it must be positive", size)
+		}
+	}
+	if files != 0 && files < 2 {
+		return fmt.Errorf("invalid number of container log files %d, it must be at least 2", files)
+	}
+	return nil
+}
+
+// ContainerLogRotation returns the effective container log rotation of a node, node settings take precedence over cluster-wide ones
+func ContainerLogRotation(mc config.ClusterConfig, nc config.Node) (string, int) {
+	size, files := mc.KubernetesConfig.ContainerLogMaxSize, mc.KubernetesConfig.ContainerLogMaxFiles
+	if nc.ContainerLogMaxSize != "" {
+		size = nc.ContainerLogMaxSize
+	}
+	if nc.ContainerLogMaxFiles != 0 {
+		files = nc.ContainerLogMaxFiles
+	}
+	return size, files
+}
+
+// kubeletArgs converts per-node kubelet arguments to a map of flag names to values, a flag without a value is set to true
+func kubeletArgs(args []string) map[string]string {
+	opts := map[string]string{}
+	for _, a := range args {
+		kv := strings.SplitN(strings.TrimPrefix(a, "--"), "=", 2)
+		if len(kv) == 1 {
+			opts[kv[0]] = "true"
+			continue
+		}
+		opts[kv[0]] = kv[1]
+	}
+	return opts
+}
+
 // NewKubeletConfig generates a new systemd unit containing a configured kubelet
 // based on the options present in the KubernetesConfig.
 func NewKubeletConfig(mc config.ClusterConfig, nc config.Node, r cruntime.Manager) ([]byte, error) {
@@ -100,7 +199,8 @@ func NewKubeletConfig(mc config.ClusterConfig, nc config.Node, r cruntime.Manage
 		ContainerRuntime string
 		KubeletPath      string
 	}{
-		ExtraOptions:     convertToFlags(extraOpts),
+		// systemd treats % as a specifier, and percentages are common in eviction thresholds
+		ExtraOptions:     strings.Replace(convertToFlags(extraOpts), "%", "%%", -1),
 		ContainerRuntime: k8s.ContainerRuntime,
 		KubeletPath:      path.Join(binRoot(k8s.KubernetesVersion), "kubelet"),
 	}
@@ -128,5 +228,8 @@ func KubeNodeName(cc config.ClusterConfig, n config.Node) string {
 		hostname, _ := os.Hostname()
 		return hostname
 	}
+	if n.Hostname != "" {
+		return n.Hostname
+	}
 	return driver.MachineName(cc, n)
 }
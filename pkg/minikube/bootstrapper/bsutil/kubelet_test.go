@@ -142,6 +142,73 @@ Wants=containerd.service
 ExecStart=
 ExecStart=/var/lib/minikube/binaries/v1.18.2/kubelet --authorization-mode=Webhook --bootstrap-kubeconfig=/etc/kubernetes/bootstrap-kubelet.conf --cgroup-driver=cgroupfs --client-ca-file=/var/lib/minikube/certs/ca.crt --cluster-domain=cluster.local --config=/var/lib/kubelet/config.yaml --container-runtime=remote --container-runtime-endpoint=unix:///run/containerd/containerd.sock --fail-swap-on=false --hostname-override=minikube --image-service-endpoint=unix:///run/containerd/containerd.sock --kubeconfig=/etc/kubernetes/kubelet.conf --node-ip=192.168.1.200 --pod-manifest-path=/etc/kubernetes/manifests --runtime-request-timeout=15m
 
+[Install]
+`,
+		},
+		{
+			description: "docker with eviction thresholds",
+			cfg: config.ClusterConfig{
+				Name: "minikube",
+				KubernetesConfig: config.KubernetesConfig{
+					KubernetesVersion: constants.DefaultKubernetesVersion,
+					ContainerRuntime:  "docker",
+					ExtraOptions: config.ExtraOptionSlice{
+						config.ExtraOption{
+							Component: Kubelet,
+							Key:       "eviction-hard",
+							Value:     "memory.available<200Mi,nodefs.available<10%",
+						},
+					},
+				},
+				Nodes: []config.Node{
+					{
+						IP:           "192.168.1.100",
+						Name:         "minikube",
+						ControlPlane: true,
+					},
+				},
+			},
+			expected: `[Unit]
+Wants=docker.socket
+
+[Service]
+ExecStart=
+ExecStart=/var/lib/minikube/binaries/v1.18.2/kubelet --authorization-mode=Webhook --bootstrap-kubeconfig=/etc/kubernetes/bootstrap-kubelet.conf --cgroup-driver=cgroupfs --client-ca-file=/var/lib/minikube/certs/ca.crt --cluster-domain=cluster.local --config=/var/lib/kubelet/config.yaml --container-runtime=docker --eviction-hard=memory.available<200Mi,nodefs.available<10%% --fail-swap-on=false --hostname-override=minikube --kubeconfig=/etc/kubernetes/kubelet.conf --node-ip=192.168.1.100 --pod-manifest-path=/etc/kubernetes/manifests
+
+[Install]
+`,
+		},
+		{
+			description: "docker with per-node kubelet arguments",
+			cfg: config.ClusterConfig{
+				Name: "minikube",
+				KubernetesConfig: config.KubernetesConfig{
+					KubernetesVersion: constants.DefaultKubernetesVersion,
+					ContainerRuntime:  "docker",
+					ExtraOptions: config.ExtraOptionSlice{
+						config.ExtraOption{
+							Component: Kubelet,
+							Key:       "max-pods",
+							Value:     "100",
+						},
+					},
+				},
+				Nodes: []config.Node{
+					{
+						IP:               "192.168.1.100",
+						Name:             "minikube",
+						ControlPlane:     true,
+						KubeletExtraArgs: []string{"--system-reserved=cpu=500m", "--max-pods=50"},
+					},
+				},
+			},
+			expected: `[Unit]
+Wants=docker.socket
+
+[Service]
+ExecStart=
+ExecStart=/var/lib/minikube/binaries/v1.18.2/kubelet --authorization-mode=Webhook --bootstrap-kubeconfig=/etc/kubernetes/bootstrap-kubelet.conf --cgroup-driver=cgroupfs --client-ca-file=/var/lib/minikube/certs/ca.crt --cluster-domain=cluster.local --config=/var/lib/kubelet/config.yaml --container-runtime=docker --fail-swap-on=false --hostname-override=minikube --kubeconfig=/etc/kubernetes/kubelet.conf --max-pods=50 --node-ip=192.168.1.100 --pod-manifest-path=/etc/kubernetes/manifests --system-reserved=cpu=500m
+
 [Install]
 `,
 		},
@@ -169,6 +236,63 @@ Wants=docker.socket
 ExecStart=
 ExecStart=/var/lib/minikube/binaries/v1.18.2/kubelet --authorization-mode=Webhook --bootstrap-kubeconfig=/etc/kubernetes/bootstrap-kubelet.conf --cgroup-driver=cgroupfs --client-ca-file=/var/lib/minikube/certs/ca.crt --cluster-domain=cluster.local --config=/var/lib/kubelet/config.yaml --container-runtime=docker --fail-swap-on=false --hostname-override=minikube --kubeconfig=/etc/kubernetes/kubelet.conf --node-ip=192.168.1.100 --pod-infra-container-image=docker-proxy-image.io/google_containers/pause:3.2 --pod-manifest-path=/etc/kubernetes/manifests
 
+[Install]
+`,
+		},
+		{
+			description: "docker with per-node pause image",
+			cfg: config.ClusterConfig{
+				Name: "minikube",
+				KubernetesConfig: config.KubernetesConfig{
+					KubernetesVersion: constants.DefaultKubernetesVersion,
+					ContainerRuntime:  "docker",
+					ImageRepository:   "docker-proxy-image.io/google_containers",
+				},
+				Nodes: []config.Node{
+					{
+						IP:           "192.168.1.100",
+						Name:         "minikube",
+						ControlPlane: true,
+						PauseImage:   "myregistry/pause:3.9",
+					},
+				},
+			},
+			expected: `[Unit]
+Wants=docker.socket
+
+[Service]
+ExecStart=
+ExecStart=/var/lib/minikube/binaries/v1.18.2/kubelet --authorization-mode=Webhook --bootstrap-kubeconfig=/etc/kubernetes/bootstrap-kubelet.conf --cgroup-driver=cgroupfs --client-ca-file=/var/lib/minikube/certs/ca.crt --cluster-domain=cluster.local --config=/var/lib/kubelet/config.yaml --container-runtime=docker --fail-swap-on=false --hostname-override=minikube --kubeconfig=/etc/kubernetes/kubelet.conf --node-ip=192.168.1.100 --pod-infra-container-image=myregistry/pause:3.9 --pod-manifest-path=/etc/kubernetes/manifests
+
+[Install]
+`,
+		},
+		{
+			description: "containerd with container log rotation",
+			cfg: config.ClusterConfig{
+				Name: "minikube",
+				KubernetesConfig: config.KubernetesConfig{
+					KubernetesVersion:    constants.DefaultKubernetesVersion,
+					ContainerRuntime:     "containerd",
+					ContainerLogMaxSize:  "10Mi",
+					ContainerLogMaxFiles: 3,
+				},
+				Nodes: []config.Node{
+					{
+						IP:                  "192.168.1.100",
+						Name:                "minikube",
+						ControlPlane:        true,
+						ContainerLogMaxSize: "50Mi",
+					},
+				},
+			},
+			expected: `[Unit]
+Wants=containerd.service
+
+[Service]
+ExecStart=
+ExecStart=/var/lib/minikube/binaries/v1.18.2/kubelet --authorization-mode=Webhook --bootstrap-kubeconfig=/etc/kubernetes/bootstrap-kubelet.conf --cgroup-driver=cgroupfs --client-ca-file=/var/lib/minikube/certs/ca.crt --cluster-domain=cluster.local --config=/var/lib/kubelet/config.yaml --container-log-max-files=3 --container-log-max-size=50Mi --container-runtime=remote --container-runtime-endpoint=unix:///run/containerd/containerd.sock --fail-swap-on=false --hostname-override=minikube --image-service-endpoint=unix:///run/containerd/containerd.sock --kubeconfig=/etc/kubernetes/kubelet.conf --node-ip=192.168.1.100 --pod-manifest-path=/etc/kubernetes/manifests --runtime-request-timeout=15m
+
 [Install]
 `,
 		},
@@ -205,3 +329,64 @@ ExecStart=/var/lib/minikube/binaries/v1.18.2/kubelet --authorization-mode=Webhoo
 		})
 	}
 }
+
+func TestValidateContainerLogRotation(t *testing.T) {
+	tests := []struct {
+		size    string
+		files   int
+		wantErr bool
+	}{
+		{"", 0, false},
+		{"10Mi", 5, false},
+		{"100M", 0, false},
+		{"", 2, false},
+		{"ten", 0, true},
+		{"0", 0, true},
+		{"-5Mi", 0, true},
+		{"10Mi", 1, true},
+		{"", -3, true},
+	}
+	for _, tc := range tests {
+		err := ValidateContainerLogRotation(tc.size, tc.files)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("ValidateContainerLogRotation(%q, %d) error = %v, wantErr: %v", tc.size, tc.files, err, tc.wantErr)
+		}
+	}
+}
+
+func TestMaxPods(t *testing.T) {
+	tests := []struct {
+		maskSize int
+		want     int
+	}{
+		{0, 0},
+		{25, 0},
+		{24, 0},
+		{23, 220},
+		{22, 440},
+	}
+	for _, tc := range tests {
+		if got := maxPods(tc.maskSize); got != tc.want {
+			t.Errorf("maxPods(%d) = %d, want: %d", tc.maskSize, got, tc.want)
+		}
+	}
+}
+
+func TestValidateKubeletExtraArgs(t *testing.T) {
+	tests := []struct {
+		args    []string
+		wantErr bool
+	}{
+		{[]string{"--system-reserved=cpu=500m", "--max-pods=50"}, false},
+		{[]string{"--rotate-certificates"}, false},
+		{[]string{"system-reserved=cpu=500m"}, true},
+		{[]string{"--=true"}, true},
+		{[]string{"-v=5"}, true},
+	}
+	for _, tc := range tests {
+		err := ValidateKubeletExtraArgs(tc.args)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("ValidateKubeletExtraArgs(%v) error = %v, wantErr: %v", tc.args, err, tc.wantErr)
+		}
+	}
+}
@@ -213,13 +213,7 @@ func apiServerHealthz(hostname string, port int) (state.State, error) {
 func apiServerHealthzNow(hostname string, port int) (state.State, error) {
 	url := fmt.Sprintf("https://%s/healthz", net.JoinHostPort(hostname, fmt.Sprint(port)))
 	glog.Infof("Checking apiserver healthz at %s ...", url)
-	// To avoid: x509: certificate signed by unknown authority
-	tr := &http.Transport{
-		Proxy:           nil, // Avoid using a proxy to speak to a local host
-		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-	}
-	client := &http.Client{Transport: tr}
-	resp, err := client.Get(url)
+	resp, err := healthzClient.Get(url)
 	// Connection refused, usually.
 	if err != nil {
 		glog.Infof("stopped: %s: %v", url, err)
@@ -241,3 +235,36 @@ func apiServerHealthzNow(hostname string, port int) (state.State, error) {
 	}
 	return state.Running, nil
 }
+
+// APIServerLatency returns the time taken by the apiserver to answer a single /healthz request
+func APIServerLatency(hostname string, port int) (time.Duration, error) {
+	url := fmt.Sprintf("https://%s/healthz", net.JoinHostPort(hostname, fmt.Sprint(port)))
+	glog.Infof("Probing apiserver latency at %s ...", url)
+	start := time.Now()
+	resp, err := healthzClient.Get(url)
+	if err != nil {
+		return 0, errors.Wrap(err, "healthz")
+	}
+	defer resp.Body.Close()
+	if _, err := ioutil.ReadAll(resp.Body); err != nil {
+		return 0, errors.Wrap(err, "read healthz")
+	}
+	elapsed := time.Since(start)
+	if resp.StatusCode != http.StatusOK {
+		return elapsed, fmt.Errorf("%s returned error %d", url, resp.StatusCode)
+	}
+	glog.Infof("%s answered in %s", url, elapsed)
+	return elapsed, nil
+}
+
+// healthzClient is the http client for the apiserver healthz endpoint, shared so that its connections are reused
+// rather than doing a TLS handshake on every request
+var healthzClient = &http.Client{
+	// To avoid: x509: certificate signed by unknown authority
+	Transport: &http.Transport{
+		Proxy:           nil, // Avoid using a proxy to speak to a local host
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	},
+	// an unresponsive apiserver must not hang the caller
+	Timeout: 5 * time.Second,
+}
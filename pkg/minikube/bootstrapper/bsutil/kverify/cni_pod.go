@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kverify
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	kconst "k8s.io/kubernetes/cmd/kubeadm/app/constants"
+)
+
+// WaitForCNIPod waits for the CNI pod matching selector to be scheduled and Ready on the given node
+func WaitForCNIPod(cs kubernetes.Interface, nodeName string, selector string, timeout time.Duration) error {
+	glog.Infof("waiting %s for CNI pod %q on %s to be Ready ...", timeout, selector, nodeName)
+	start := time.Now()
+	defer func() {
+		glog.Infof("duration metric: took %s to wait for CNI pod on %s ...", time.Since(start), nodeName)
+	}()
+
+	reason := "not scheduled"
+	checkReady := func() (bool, error) {
+		pods, err := cs.CoreV1().Pods("kube-system").List(meta.ListOptions{
+			LabelSelector: selector,
+			FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+		})
+		if err != nil {
+			glog.Infof("error listing CNI pods will retry: %v", err)
+			return false, nil
+		}
+
+		for _, p := range pods.Items {
+			// not every client honours field selectors
+			if p.Spec.NodeName != nodeName {
+				continue
+			}
+			if podReady(p) {
+				return true, nil
+			}
+			reason = fmt.Sprintf("%s is %s and not Ready", p.Name, p.Status.Phase)
+		}
+		return false, nil
+	}
+
+	if err := wait.PollImmediate(kconst.APICallRetryInterval, timeout, checkReady); err != nil {
+		return errors.Wrapf(err, "CNI pod on %s: %s", nodeName, reason)
+	}
+	return nil
+}
+
+func podReady(p core.Pod) bool {
+	for _, c := range p.Status.Conditions {
+		if c.Type == core.PodReady {
+			return c.Status == core.ConditionTrue
+		}
+	}
+	return false
+}
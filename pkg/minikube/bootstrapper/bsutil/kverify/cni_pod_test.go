@@ -0,0 +1,75 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kverify
+
+import (
+	"testing"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func cniPod(name string, node string, ready core.ConditionStatus) *core.Pod {
+	return &core.Pod{
+		ObjectMeta: meta.ObjectMeta{Name: name, Namespace: "kube-system", Labels: map[string]string{"app": "kindnet"}},
+		Spec:       core.PodSpec{NodeName: node},
+		Status: core.PodStatus{
+			Phase:      core.PodRunning,
+			Conditions: []core.PodCondition{{Type: core.PodReady, Status: ready}},
+		},
+	}
+}
+
+func TestWaitForCNIPod(t *testing.T) {
+	var tests = []struct {
+		name    string
+		pods    []*core.Pod
+		wantErr bool
+	}{
+		{
+			name: "ready",
+			pods: []*core.Pod{cniPod("kindnet-a", "minikube", core.ConditionTrue), cniPod("kindnet-b", "minikube-m02", core.ConditionTrue)},
+		},
+		{
+			name:    "not ready",
+			pods:    []*core.Pod{cniPod("kindnet-a", "minikube", core.ConditionTrue), cniPod("kindnet-b", "minikube-m02", core.ConditionFalse)},
+			wantErr: true,
+		},
+		{
+			name:    "not scheduled",
+			pods:    []*core.Pod{cniPod("kindnet-a", "minikube", core.ConditionTrue)},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := fake.NewSimpleClientset()
+			for _, p := range tc.pods {
+				if _, err := cs.CoreV1().Pods(p.Namespace).Create(p); err != nil {
+					t.Fatalf("create %s: %v", p.Name, err)
+				}
+			}
+
+			err := WaitForCNIPod(cs, "minikube-m02", "app=kindnet", time.Second)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("WaitForCNIPod() error = %v, wantErr: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -35,6 +35,8 @@ const (
 	AppsRunningKey = "apps_running"
 	// NodeReadyKey is the name used in the flags for waiting for the node status to be ready
 	NodeReadyKey = "node_ready"
+	// NodesWaitKey is an alias of NodeReadyKey accepted by the --wait flag
+	NodesWaitKey = "nodes"
 )
 
 //  vars related to the --wait flag
@@ -49,6 +51,8 @@ var (
 	DefaultWaitList = []string{APIServerWaitKey, SystemPodsWaitKey}
 	// AllComponentsList list of all valid components keys to wait for. only names to be used used for start flags.
 	AllComponentsList = []string{APIServerWaitKey, SystemPodsWaitKey, DefaultSAWaitKey, AppsRunningKey, NodeReadyKey}
+	// WaitAliases maps alternative names accepted by the --wait flag to the components they select
+	WaitAliases = map[string]string{NodesWaitKey: NodeReadyKey}
 	// AppsRunningList running list are valid k8s-app components to wait for them to be running
 	AppsRunningList = []string{
 		"kube-dns", // coredns
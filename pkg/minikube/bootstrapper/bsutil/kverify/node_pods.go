@@ -0,0 +1,183 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kverify
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	kconst "k8s.io/kubernetes/cmd/kubeadm/app/constants"
+)
+
+// WaitForNodePods waits for the given node to be Ready, along with every kube-system pod scheduled on it
+func WaitForNodePods(cs kubernetes.Interface, nodeName string, timeout time.Duration) error {
+	glog.Infof("waiting %s for system pods on %s to be Ready ...", timeout, nodeName)
+	start := time.Now()
+	defer func() {
+		glog.Infof("duration metric: took %s to wait for system pods on %s ...", time.Since(start), nodeName)
+	}()
+
+	reason := "not registered"
+	checkReady := func() (bool, error) {
+		n, err := cs.CoreV1().Nodes().Get(nodeName, meta.GetOptions{})
+		if err != nil {
+			glog.Infof("error getting node %s will retry: %v", nodeName, err)
+			return false, nil
+		}
+		if !nodeReady(*n) {
+			reason = "node is not Ready"
+			return false, nil
+		}
+
+		pods, err := cs.CoreV1().Pods("kube-system").List(meta.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+		})
+		if err != nil {
+			glog.Infof("error listing system pods will retry: %v", err)
+			return false, nil
+		}
+		for _, p := range pods.Items {
+			// not every client honours field selectors
+			if p.Spec.NodeName != nodeName || p.Status.Phase == core.PodSucceeded {
+				continue
+			}
+			if !podReady(p) {
+				reason = fmt.Sprintf("%s is %s and not Ready", p.Name, p.Status.Phase)
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+
+	if err := wait.PollImmediate(kconst.APICallRetryInterval, timeout, checkReady); err != nil {
+		return errors.Wrapf(err, "system pods on %s: %s", nodeName, reason)
+	}
+	return nil
+}
+
+func nodeReady(n core.Node) bool {
+	for _, c := range n.Status.Conditions {
+		if c.Type == core.NodeReady {
+			return c.Status == core.ConditionTrue
+		}
+	}
+	return false
+}
+
+// PodSelector selects the pods to wait for on a node by namespace and labels
+type PodSelector struct {
+	// Namespace of the pods, empty for every namespace
+	Namespace string
+	// Labels is a label selector, empty for every pod of the namespace
+	Labels string
+}
+
+// String returns the selector in the format accepted by ParsePodSelector
+func (s PodSelector) String() string {
+	var parts []string
+	if s.Namespace != "" {
+		parts = append(parts, "namespace="+s.Namespace)
+	}
+	if s.Labels != "" {
+		parts = append(parts, s.Labels)
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParsePodSelector parses a comma separated list of key=value pairs, where the namespace key selects the namespace
+// and every other pair is a label, e.g. "namespace=kube-system,k8s-app=kube-proxy"
+func ParsePodSelector(s string) (PodSelector, error) {
+	var sel PodSelector
+	var lbls []string
+	for _, kv := range strings.Split(s, ",") {
+		kv = strings.TrimSpace(kv)
+		if kv == "" {
+			continue
+		}
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return sel, fmt.Errorf("invalid pod selector %q: %q is not in the key=value format", s, kv)
+		}
+		if parts[0] == "namespace" {
+			sel.Namespace = parts[1]
+			continue
+		}
+		lbls = append(lbls, kv)
+	}
+	if sel.Namespace == "" && len(lbls) == 0 {
+		return sel, fmt.Errorf("invalid pod selector %q: a namespace or label is required", s)
+	}
+	sel.Labels = strings.Join(lbls, ",")
+	if _, err := labels.Parse(sel.Labels); err != nil {
+		return sel, errors.Wrapf(err, "invalid pod selector %q", s)
+	}
+	return sel, nil
+}
+
+// WaitForSelectedPods waits for the pods matching each selector to be scheduled and Ready on the given node
+func WaitForSelectedPods(cs kubernetes.Interface, nodeName string, selectors []PodSelector, timeout time.Duration) error {
+	glog.Infof("waiting %s for pods %v on %s to be Ready ...", timeout, selectors, nodeName)
+	start := time.Now()
+	defer func() {
+		glog.Infof("duration metric: took %s to wait for selected pods on %s ...", time.Since(start), nodeName)
+	}()
+
+	reason := ""
+	checkReady := func() (bool, error) {
+		for _, sel := range selectors {
+			pods, err := cs.CoreV1().Pods(sel.Namespace).List(meta.ListOptions{
+				LabelSelector: sel.Labels,
+				FieldSelector: fields.OneTermEqualSelector("spec.nodeName", nodeName).String(),
+			})
+			if err != nil {
+				glog.Infof("error listing pods %q will retry: %v", sel, err)
+				return false, nil
+			}
+			found := false
+			for _, p := range pods.Items {
+				// not every client honours field selectors
+				if p.Spec.NodeName != nodeName || p.Status.Phase == core.PodSucceeded {
+					continue
+				}
+				found = true
+				if !podReady(p) {
+					reason = fmt.Sprintf("%s is %s and not Ready", p.Name, p.Status.Phase)
+					return false, nil
+				}
+			}
+			if !found {
+				reason = fmt.Sprintf("no pod matching %q is scheduled", sel)
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+
+	if err := wait.PollImmediate(kconst.APICallRetryInterval, timeout, checkReady); err != nil {
+		return errors.Wrapf(err, "pods on %s: %s", nodeName, reason)
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kverify
+
+import (
+	"testing"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestWaitForNodePods(t *testing.T) {
+	ready := &core.Node{
+		ObjectMeta: meta.ObjectMeta{Name: "minikube-m02"},
+		Status:     core.NodeStatus{Conditions: []core.NodeCondition{{Type: core.NodeReady, Status: core.ConditionTrue}}},
+	}
+	notReady := ready.DeepCopy()
+	notReady.Status.Conditions[0].Status = core.ConditionFalse
+
+	var tests = []struct {
+		name    string
+		node    *core.Node
+		pods    []*core.Pod
+		wantErr bool
+	}{
+		{
+			name: "ready",
+			node: ready,
+			pods: []*core.Pod{cniPod("kindnet-b", "minikube-m02", core.ConditionTrue), cniPod("kube-proxy-b", "minikube-m02", core.ConditionTrue)},
+		},
+		{
+			name:    "pod not ready",
+			node:    ready,
+			pods:    []*core.Pod{cniPod("kindnet-b", "minikube-m02", core.ConditionTrue), cniPod("kube-proxy-b", "minikube-m02", core.ConditionFalse)},
+			wantErr: true,
+		},
+		{
+			name: "other node not ready",
+			node: ready,
+			pods: []*core.Pod{cniPod("kindnet-b", "minikube-m02", core.ConditionTrue), cniPod("kube-proxy-a", "minikube", core.ConditionFalse)},
+		},
+		{
+			name:    "node not ready",
+			node:    notReady,
+			pods:    []*core.Pod{cniPod("kindnet-b", "minikube-m02", core.ConditionTrue)},
+			wantErr: true,
+		},
+		{
+			name:    "not registered",
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := fake.NewSimpleClientset()
+			if tc.node != nil {
+				if _, err := cs.CoreV1().Nodes().Create(tc.node); err != nil {
+					t.Fatalf("create %s: %v", tc.node.Name, err)
+				}
+			}
+			for _, p := range tc.pods {
+				if _, err := cs.CoreV1().Pods(p.Namespace).Create(p); err != nil {
+					t.Fatalf("create %s: %v", p.Name, err)
+				}
+			}
+
+			err := WaitForNodePods(cs, "minikube-m02", time.Second)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("WaitForNodePods() error = %v, wantErr: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestWaitForNamedNodeReady(t *testing.T) {
+	ready := &core.Node{
+		ObjectMeta: meta.ObjectMeta{Name: "minikube-m02"},
+		Status:     core.NodeStatus{Conditions: []core.NodeCondition{{Type: core.NodeReady, Status: core.ConditionTrue}}},
+	}
+	notReady := ready.DeepCopy()
+	notReady.Status.Conditions[0].Status = core.ConditionFalse
+
+	var tests = []struct {
+		name    string
+		node    *core.Node
+		wantErr bool
+	}{
+		{name: "ready", node: ready},
+		{name: "not ready", node: notReady, wantErr: true},
+		{name: "not registered", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := fake.NewSimpleClientset()
+			if tc.node != nil {
+				if _, err := cs.CoreV1().Nodes().Create(tc.node); err != nil {
+					t.Fatalf("create %s: %v", tc.node.Name, err)
+				}
+			}
+
+			err := WaitForNamedNodeReady(cs, "minikube-m02", time.Second)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("WaitForNamedNodeReady() error = %v, wantErr: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestParsePodSelector(t *testing.T) {
+	var tests = []struct {
+		in      string
+		want    PodSelector
+		wantErr bool
+	}{
+		{in: "namespace=kube-system", want: PodSelector{Namespace: "kube-system"}},
+		{in: "namespace=kube-system,k8s-app=kube-proxy", want: PodSelector{Namespace: "kube-system", Labels: "k8s-app=kube-proxy"}},
+		{in: "app=kindnet,tier=node", want: PodSelector{Labels: "app=kindnet,tier=node"}},
+		{in: "", wantErr: true},
+		{in: "kube-system", wantErr: true},
+		{in: "namespace=kube-system,=x", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.in, func(t *testing.T) {
+			got, err := ParsePodSelector(tc.in)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ParsePodSelector(%q) error = %v, wantErr: %v", tc.in, err, tc.wantErr)
+			}
+			if !tc.wantErr && got != tc.want {
+				t.Errorf("ParsePodSelector(%q) = %+v, want: %+v", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWaitForSelectedPods(t *testing.T) {
+	proxy := cniPod("kube-proxy-b", "minikube-m02", core.ConditionTrue)
+	proxy.Labels = map[string]string{"k8s-app": "kube-proxy"}
+
+	var tests = []struct {
+		name     string
+		selector PodSelector
+		pods     []*core.Pod
+		wantErr  bool
+	}{
+		{
+			name:     "namespace ready",
+			selector: PodSelector{Namespace: "kube-system"},
+			pods:     []*core.Pod{cniPod("kindnet-b", "minikube-m02", core.ConditionTrue), proxy},
+		},
+		{
+			name:     "namespace not ready",
+			selector: PodSelector{Namespace: "kube-system"},
+			pods:     []*core.Pod{cniPod("kindnet-b", "minikube-m02", core.ConditionFalse), proxy},
+			wantErr:  true,
+		},
+		{
+			name:     "label ready",
+			selector: PodSelector{Namespace: "kube-system", Labels: "k8s-app=kube-proxy"},
+			pods:     []*core.Pod{cniPod("kindnet-b", "minikube-m02", core.ConditionFalse), proxy},
+		},
+		{
+			name:     "not scheduled",
+			selector: PodSelector{Namespace: "kube-system", Labels: "k8s-app=kube-proxy"},
+			pods:     []*core.Pod{cniPod("kindnet-b", "minikube-m02", core.ConditionTrue)},
+			wantErr:  true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cs := fake.NewSimpleClientset()
+			for _, p := range tc.pods {
+				if _, err := cs.CoreV1().Pods(p.Namespace).Create(p); err != nil {
+					t.Fatalf("create %s: %v", p.Name, err)
+				}
+			}
+
+			err := WaitForSelectedPods(cs, "minikube-m02", []PodSelector{tc.selector}, time.Second)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("WaitForSelectedPods() error = %v, wantErr: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestWaitForNodeRegistered(t *testing.T) {
+	notReady := &core.Node{
+		ObjectMeta: meta.ObjectMeta{Name: "minikube-m02"},
+		Status:     core.NodeStatus{Conditions: []core.NodeCondition{{Type: core.NodeReady, Status: core.ConditionFalse}}},
+	}
+
+	if err := WaitForNodeRegistered(fake.NewSimpleClientset(notReady), "minikube-m02", time.Second); err != nil {
+		t.Errorf("WaitForNodeRegistered() of a registered node unexpected error: %v", err)
+	}
+	if err := WaitForNodeRegistered(fake.NewSimpleClientset(), "minikube-m02", time.Second); err == nil {
+		t.Errorf("WaitForNodeRegistered() of a missing node expected error but got none")
+	}
+}
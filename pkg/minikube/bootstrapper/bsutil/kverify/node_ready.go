@@ -62,3 +62,40 @@ func WaitForNodeReady(cs *kubernetes.Clientset, timeout time.Duration) error {
 	}
 	return nil
 }
+
+// WaitForNamedNodeReady waits for a single node to report the Ready condition
+func WaitForNamedNodeReady(cs kubernetes.Interface, nodeName string, timeout time.Duration) error {
+	glog.Infof("waiting %s for node %s to be ready ...", timeout, nodeName)
+	start := time.Now()
+	defer func() {
+		glog.Infof("duration metric: took %s to wait for node %s to be ready ...", time.Since(start), nodeName)
+	}()
+	checkReady := func() (bool, error) {
+		n, err := cs.CoreV1().Nodes().Get(nodeName, meta.GetOptions{})
+		if err != nil {
+			glog.Infof("error getting node %s will retry: %v", nodeName, err)
+			return false, nil
+		}
+		return nodeReady(*n), nil
+	}
+	if err := wait.PollImmediate(kconst.APICallRetryInterval, timeout, checkReady); err != nil {
+		return errors.Wrapf(err, "wait for node %s to be ready", nodeName)
+	}
+	return nil
+}
+
+// WaitForNodeRegistered waits for a node to be registered with the apiserver, whatever its conditions
+func WaitForNodeRegistered(cs kubernetes.Interface, nodeName string, timeout time.Duration) error {
+	glog.Infof("waiting %s for node %s to be registered ...", timeout, nodeName)
+	checkRegistered := func() (bool, error) {
+		if _, err := cs.CoreV1().Nodes().Get(nodeName, meta.GetOptions{}); err != nil {
+			glog.Infof("error getting node %s will retry: %v", nodeName, err)
+			return false, nil
+		}
+		return true, nil
+	}
+	if err := wait.PollImmediate(kconst.APICallRetryInterval, timeout, checkRegistered); err != nil {
+		return errors.Wrapf(err, "wait for node %s to be registered", nodeName)
+	}
+	return nil
+}
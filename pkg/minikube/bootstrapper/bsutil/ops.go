@@ -47,9 +47,9 @@ func AdjustResourceLimits(c command.Runner) error {
 	return nil
 }
 
-// ExistingConfig checks if there are config files from possible previous Kubernetes cluster
-func ExistingConfig(c command.Runner) error {
-	args := append([]string{"ls"}, expectedRemoteArtifacts...)
+// ExistingConfig checks if there are config files, and an etcd data dir, from possible previous Kubernetes cluster
+func ExistingConfig(c command.Runner, etcdDataDir string) error {
+	args := append(append([]string{"ls"}, expectedRemoteArtifacts...), etcdDataDir)
 	_, err := c.RunCmd(exec.Command("sudo", args...))
 	return err
 }
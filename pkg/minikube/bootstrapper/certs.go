@@ -18,6 +18,7 @@ package bootstrapper
 
 import (
 	"crypto/sha1"
+	"crypto/x509"
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
@@ -28,6 +29,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/otiai10/copy"
@@ -295,6 +297,107 @@ func generateProfileCerts(k8s config.KubernetesConfig, n config.Node, ccs CACert
 	return xfer, nil
 }
 
+// RemoveProfileCerts removes the certificates generated for a profile, so that they are generated again by SetupCerts.
+// The certificate authorities shared among profiles are kept.
+func RemoveProfileCerts(clusterName string) error {
+	profilePath := localpath.Profile(clusterName)
+	paths := []string{localpath.ClientCert(clusterName), localpath.ClientKey(clusterName)}
+	for _, pattern := range []string{"apiserver.crt*", "apiserver.key*", "proxy-client.crt", "proxy-client.key"} {
+		matches, err := filepath.Glob(filepath.Join(profilePath, pattern))
+		if err != nil {
+			return errors.Wrapf(err, "glob %s", pattern)
+		}
+		paths = append(paths, matches...)
+	}
+	for _, p := range paths {
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			return errors.Wrapf(err, "remove %s", p)
+		}
+	}
+	return nil
+}
+
+// CertExpiry is the expiry date of a certificate
+type CertExpiry struct {
+	Path     string
+	NotAfter time.Time
+	// CA is set for certificate authorities, which can not be renewed without recreating the cluster
+	CA bool
+}
+
+// isCACert returns whether a certificate is one of the certificate authorities of the cluster, such as ca.crt,
+// proxy-client-ca.crt, front-proxy-ca.crt or etcd/ca.crt
+func isCACert(path string) bool {
+	return strings.HasSuffix(filepath.Base(path), "ca.crt")
+}
+
+// opensslDate is the format of the dates printed by 'openssl x509 -enddate'
+const opensslDate = "Jan _2 15:04:05 2006 MST"
+
+// ExpiringCerts returns the certificates of a control plane which expire within the given duration, both those
+// generated by minikube on the host and those generated by kubeadm within the node.
+func ExpiringCerts(cmd command.Runner, k8s config.KubernetesConfig, within time.Duration) ([]CertExpiry, error) {
+	profilePath := localpath.Profile(k8s.ClusterName)
+	hostCerts := []string{
+		localpath.CACert(),
+		filepath.Join(localpath.MiniPath(), "proxy-client-ca.crt"),
+		localpath.ClientCert(k8s.ClusterName),
+		filepath.Join(profilePath, "apiserver.crt"),
+		filepath.Join(profilePath, "proxy-client.crt"),
+	}
+
+	expiring := []CertExpiry{}
+	deadline := time.Now().Add(within)
+	for _, p := range hostCerts {
+		if !canRead(p) {
+			continue
+		}
+		notAfter, err := certNotAfter(p)
+		if err != nil {
+			return expiring, errors.Wrapf(err, "reading %s", p)
+		}
+		if notAfter.Before(deadline) {
+			expiring = append(expiring, CertExpiry{Path: p, NotAfter: notAfter, CA: isCACert(p)})
+		}
+	}
+
+	rr, err := cmd.RunCmd(exec.Command("sudo", "find", vmpath.GuestKubernetesCertsDir, "-name", "*.crt"))
+	if err != nil {
+		return expiring, errors.Wrap(err, "listing certificates")
+	}
+	for _, p := range strings.Fields(rr.Stdout.String()) {
+		rr, err := cmd.RunCmd(exec.Command("sudo", "openssl", "x509", "-noout", "-enddate", "-in", p))
+		if err != nil {
+			return expiring, errors.Wrapf(err, "reading %s", p)
+		}
+		notAfter, err := time.Parse(opensslDate, strings.TrimPrefix(strings.TrimSpace(rr.Stdout.String()), "notAfter="))
+		if err != nil {
+			return expiring, errors.Wrapf(err, "parsing expiry of %s", p)
+		}
+		if notAfter.Before(deadline) {
+			expiring = append(expiring, CertExpiry{Path: p, NotAfter: notAfter, CA: isCACert(p)})
+		}
+	}
+	return expiring, nil
+}
+
+// certNotAfter returns the expiry date of the first certificate of a PEM file
+func certNotAfter(filePath string) (time.Time, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return time.Time{}, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return time.Time{}, fmt.Errorf("no certificate found in %s", filePath)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "parse certificate")
+	}
+	return cert.NotAfter, nil
+}
+
 // isValidPEMCertificate checks whether the input file is a valid PEM certificate (with at least one CERTIFICATE block)
 func isValidPEMCertificate(filePath string) (bool, error) {
 	fileBytes, err := ioutil.ReadFile(filePath)
@@ -20,6 +20,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"k8s.io/minikube/pkg/minikube/command"
 	"k8s.io/minikube/pkg/minikube/config"
@@ -62,3 +63,49 @@ func TestSetupCerts(t *testing.T) {
 		t.Fatalf("Error starting cluster: %v", err)
 	}
 }
+
+func TestCertNotAfter(t *testing.T) {
+	tempDir := tests.MakeTempDir()
+	defer tests.RemoveTempDir(tempDir)
+
+	certPath := filepath.Join(tempDir, "ca.crt")
+	keyPath := filepath.Join(tempDir, "ca.key")
+	if err := util.GenerateCACert(certPath, keyPath, "Test Certificate"); err != nil {
+		t.Fatalf("error generating certificate: %v", err)
+	}
+
+	notAfter, err := certNotAfter(certPath)
+	if err != nil {
+		t.Fatalf("certNotAfter(%s): %v", certPath, err)
+	}
+	// CA certificates are valid for ten years
+	if notAfter.Before(time.Now().Add(9*365*24*time.Hour)) || notAfter.After(time.Now().Add(11*365*24*time.Hour)) {
+		t.Errorf("certNotAfter(%s) = %s, want about ten years from now", certPath, notAfter)
+	}
+
+	if _, err := certNotAfter(keyPath); err == nil {
+		t.Errorf("certNotAfter(%s) expected an error for a key", keyPath)
+	}
+}
+
+func TestIsCACert(t *testing.T) {
+	var testCases = []struct {
+		path string
+		want bool
+	}{
+		{path: "/home/user/.minikube/ca.crt", want: true},
+		{path: "/home/user/.minikube/proxy-client-ca.crt", want: true},
+		{path: "/var/lib/minikube/certs/front-proxy-ca.crt", want: true},
+		{path: "/var/lib/minikube/certs/etcd/ca.crt", want: true},
+		{path: "/home/user/.minikube/profiles/minikube/apiserver.crt", want: false},
+		{path: "/var/lib/minikube/certs/etcd/server.crt", want: false},
+		{path: "/var/lib/minikube/certs/front-proxy-client.crt", want: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			if got := isCACert(tc.path); got != tc.want {
+				t.Errorf("isCACert(%s) = %v, want: %v", tc.path, got, tc.want)
+			}
+		})
+	}
+}
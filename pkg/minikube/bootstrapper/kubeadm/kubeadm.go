@@ -153,7 +153,7 @@ func (k *Bootstrapper) clearStaleConfigs(cfg config.ClusterConfig) error {
 		return err
 	}
 
-	endpoint := fmt.Sprintf("https://%s", net.JoinHostPort(constants.ControlPlaneAlias, strconv.Itoa(cp.Port)))
+	endpoint := fmt.Sprintf("https://%s", net.JoinHostPort(constants.ControlPlaneAlias, strconv.Itoa(bsutil.APIServerPort(cfg, cp))))
 	for _, path := range paths {
 		_, err := k.c.RunCmd(exec.Command("sudo", "grep", endpoint, path))
 		if err != nil {
@@ -183,7 +183,7 @@ func (k *Bootstrapper) init(cfg config.ClusterConfig) error {
 	ignore := []string{
 		fmt.Sprintf("DirAvailable-%s", strings.Replace(vmpath.GuestManifestsDir, "/", "-", -1)),
 		fmt.Sprintf("DirAvailable-%s", strings.Replace(vmpath.GuestPersistentDir, "/", "-", -1)),
-		fmt.Sprintf("DirAvailable-%s", strings.Replace(bsutil.EtcdDataDir(), "/", "-", -1)),
+		fmt.Sprintf("DirAvailable-%s", strings.Replace(bsutil.ClusterEtcdDataDir(cfg.KubernetesConfig), "/", "-", -1)),
 		"FileAvailable--etc-kubernetes-manifests-kube-scheduler.yaml",
 		"FileAvailable--etc-kubernetes-manifests-kube-apiserver.yaml",
 		"FileAvailable--etc-kubernetes-manifests-kube-controller-manager.yaml",
@@ -238,7 +238,7 @@ func (k *Bootstrapper) init(cfg config.ClusterConfig) error {
 	}
 
 	var wg sync.WaitGroup
-	wg.Add(3)
+	wg.Add(4)
 
 	go func() {
 		// we need to have cluster role binding before applying overlay to avoid #7428
@@ -255,6 +255,13 @@ func (k *Bootstrapper) init(cfg config.ClusterConfig) error {
 		wg.Done()
 	}()
 
+	go func() {
+		if err := k.applyControlPlaneTaint(cfg); err != nil {
+			glog.Warningf("unable to apply control plane taint: %v", err)
+		}
+		wg.Done()
+	}()
+
 	go func() {
 		if err := bsutil.AdjustResourceLimits(k.c); err != nil {
 			glog.Warningf("unable to adjust resource limits: %v", err)
@@ -293,6 +300,23 @@ func (k *Bootstrapper) applyCNI(cfg config.ClusterConfig) error {
 	return nil
 }
 
+// configureJoinedCRIO points the bridge network of CRI-O on a joining node at the pod CIDR of the cluster.
+// applyCNI only does this on the control plane, and CRI-O otherwise hands out addresses from its own default subnet.
+func (k *Bootstrapper) configureJoinedCRIO(cfg config.ClusterConfig) error {
+	if cfg.KubernetesConfig.ContainerRuntime != constants.CRIO {
+		return nil
+	}
+
+	cnm, err := cni.New(cfg)
+	if err != nil {
+		return errors.Wrap(err, "cni config")
+	}
+	if _, ok := cnm.(cni.Disabled); ok {
+		return nil
+	}
+	return cruntime.UpdateCRIONet(k.c, cnm.CIDR())
+}
+
 // unpause unpauses any Kubernetes backplane components
 func (k *Bootstrapper) unpause(cfg config.ClusterConfig) error {
 
@@ -327,7 +351,7 @@ func (k *Bootstrapper) StartCluster(cfg config.ClusterConfig) error {
 		glog.Warningf("unpause failed: %v", err)
 	}
 
-	if err := bsutil.ExistingConfig(k.c); err == nil {
+	if err := bsutil.ExistingConfig(k.c, bsutil.ClusterEtcdDataDir(cfg.KubernetesConfig)); err == nil {
 		glog.Infof("found existing configuration files, will attempt cluster restart")
 		rerr := k.restartControlPlane(cfg)
 		if rerr == nil {
@@ -560,6 +584,10 @@ func (k *Bootstrapper) restartControlPlane(cfg config.ClusterConfig) error {
 		fmt.Sprintf("%s phase %s all --config %s", baseCmd, controlPlane, conf),
 		fmt.Sprintf("%s phase etcd local --config %s", baseCmd, conf),
 	}
+	// control planes joining later are configured from the uploaded config, such as the etcd quota
+	if phase == "init" {
+		cmds = append(cmds, fmt.Sprintf("%s phase upload-config kubeadm --config %s", baseCmd, conf))
+	}
 
 	glog.Infof("reconfiguring cluster from %s", conf)
 	// Run commands one at a time so that it is easier to root cause failures.
@@ -613,6 +641,11 @@ func (k *Bootstrapper) restartControlPlane(cfg config.ClusterConfig) error {
 	if err := bsutil.AdjustResourceLimits(k.c); err != nil {
 		glog.Warningf("unable to adjust resource limits: %v", err)
 	}
+
+	// --reserve-control-plane may have been changed since the cluster was created
+	if err := k.applyControlPlaneTaint(cfg); err != nil {
+		glog.Warningf("unable to apply control plane taint: %v", err)
+	}
 	return nil
 }
 
@@ -624,8 +657,12 @@ func (k *Bootstrapper) JoinCluster(cc config.ClusterConfig, n config.Node, joinC
 		glog.Infof("JoinCluster complete in %s", time.Since(start))
 	}()
 
+	if err := k.configureJoinedCRIO(cc); err != nil {
+		return errors.Wrap(err, "update crio")
+	}
+
 	// Join the master by specifying its token
-	joinCmd = fmt.Sprintf("%s --node-name=%s", joinCmd, driver.MachineName(cc, n))
+	joinCmd = joinCommand(joinCmd, cc, n)
 
 	join := func() error {
 		// reset first to clear any possibly existing state
@@ -652,6 +689,11 @@ func (k *Bootstrapper) JoinCluster(cc config.ClusterConfig, n config.Node, joinC
 	return nil
 }
 
+// joinCommand returns the kubeadm join command of a node, registering it under the name its kubelet uses
+func joinCommand(joinCmd string, cc config.ClusterConfig, n config.Node) string {
+	return fmt.Sprintf("%s --node-name=%s", joinCmd, bsutil.KubeNodeName(cc, n))
+}
+
 // GenerateToken creates a token and returns the appropriate kubeadm join command to run, or the already existing token
 func (k *Bootstrapper) GenerateToken(cc config.ClusterConfig) (string, error) {
 	// Take that generated token and use it to get a kubeadm join command
@@ -662,6 +704,11 @@ func (k *Bootstrapper) GenerateToken(cc config.ClusterConfig) (string, error) {
 	}
 
 	joinCmd := r.Stdout.String()
+	cp, err := config.PrimaryControlPlane(&cc)
+	if err != nil {
+		return "", errors.Wrap(err, "control plane")
+	}
+	joinCmd = bsutil.SetJoinEndpoint(strings.TrimSpace(joinCmd), bsutil.APIServerPort(cc, cp))
 	joinCmd = strings.Replace(joinCmd, "kubeadm", bsutil.InvokeKubeadm(cc.KubernetesConfig.KubernetesVersion), 1)
 	joinCmd = fmt.Sprintf("%s --ignore-preflight-errors=all", strings.TrimSpace(joinCmd))
 	if cc.KubernetesConfig.CRISocket != "" {
@@ -722,6 +769,65 @@ func (k *Bootstrapper) SetupCerts(k8s config.KubernetesConfig, n config.Node) er
 	return err
 }
 
+// RenewCerts renews the certificates of a control plane, keeping the certificate authorities, and restarts its components to use them.
+func (k *Bootstrapper) RenewCerts(cfg config.ClusterConfig, n config.Node) error {
+	version, err := util.ParseKubernetesVersion(cfg.KubernetesConfig.KubernetesVersion)
+	if err != nil {
+		return errors.Wrap(err, "parsing Kubernetes version")
+	}
+
+	// kubeadm renews the certificates and kubeconfigs it generated, keeping their names and addresses
+	renew := "alpha certs renew"
+	if version.GTE(semver.MustParse("1.20.0")) {
+		renew = "certs renew"
+	}
+	c := fmt.Sprintf("%s %s all --config %s", bsutil.InvokeKubeadm(cfg.KubernetesConfig.KubernetesVersion), renew, bsutil.KubeadmYamlPath)
+	if _, err := k.c.RunCmd(exec.Command("/bin/bash", "-c", c)); err != nil {
+		return errors.Wrap(err, "kubeadm certs renew")
+	}
+
+	// the certificates generated by minikube are generated again, then copied over those renewed by kubeadm
+	if err := bootstrapper.RemoveProfileCerts(cfg.KubernetesConfig.ClusterName); err != nil {
+		return errors.Wrap(err, "removing profile certs")
+	}
+	if err := k.SetupCerts(cfg.KubernetesConfig, n); err != nil {
+		return errors.Wrap(err, "setting up certs")
+	}
+
+	cr, err := cruntime.New(cruntime.Config{Type: cfg.KubernetesConfig.ContainerRuntime, Runner: k.c})
+	if err != nil {
+		return errors.Wrap(err, "runtime")
+	}
+
+	// the kubelet recreates the static pods of the control plane once their containers are stopped
+	ids := []string{}
+	for _, name := range []string{"kube-apiserver", "kube-controller-manager", "kube-scheduler", "etcd"} {
+		cids, err := cr.ListContainers(cruntime.ListOptions{Name: name})
+		if err != nil {
+			return errors.Wrapf(err, "listing %s containers", name)
+		}
+		ids = append(ids, cids...)
+	}
+	if len(ids) > 0 {
+		if err := cr.StopContainers(ids); err != nil {
+			return errors.Wrap(err, "stopping control plane containers")
+		}
+	}
+
+	hostname, _, port, err := driver.ControlPlaneEndpoint(&cfg, &n, cfg.Driver)
+	if err != nil {
+		return errors.Wrap(err, "control plane")
+	}
+	client, err := k.client(hostname, port)
+	if err != nil {
+		return errors.Wrap(err, "kubernetes client")
+	}
+	if err := kverify.WaitForHealthyAPIServer(cr, k, cfg, k.c, client, time.Now(), hostname, port, kconst.DefaultControlPlaneTimeout); err != nil {
+		return errors.Wrap(err, "apiserver health")
+	}
+	return nil
+}
+
 // UpdateCluster updates the cluster.
 func (k *Bootstrapper) UpdateCluster(cfg config.ClusterConfig) error {
 	images, err := images.Kubeadm(cfg.KubernetesConfig.ImageRepository, cfg.KubernetesConfig.KubernetesVersion)
@@ -776,7 +882,7 @@ func (k *Bootstrapper) UpdateNode(cfg config.ClusterConfig, n config.Node, r cru
 
 	sm := sysinit.New(k.c)
 
-	if err := bsutil.TransferBinaries(cfg.KubernetesConfig, k.c, sm); err != nil {
+	if err := bsutil.TransferBinaries(cfg.KubernetesConfig, bsutil.NodeArch(n), k.c, sm); err != nil {
 		return errors.Wrap(err, "downloading binaries")
 	}
 
@@ -826,15 +932,19 @@ func (k *Bootstrapper) applyNodeLabels(cfg config.ClusterConfig) error {
 	verLbl := "minikube.k8s.io/version=" + version.GetVersion()
 	commitLbl := "minikube.k8s.io/commit=" + version.GetGitCommitID()
 	nameLbl := "minikube.k8s.io/name=" + cfg.Name
+	lbls := []string{verLbl, commitLbl, nameLbl, createdAtLbl}
+	if cfg.KVMGPU {
+		lbls = append(lbls, constants.GPUNodeLabel+"=true")
+	}
 
 	// Allow no more than 5 seconds for applying labels
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	// example:
 	// sudo /var/lib/minikube/binaries/<version>/kubectl label nodes minikube.k8s.io/version=<version> minikube.k8s.io/commit=aa91f39ffbcf27dcbb93c4ff3f457c54e585cf4a-dirty minikube.k8s.io/name=p1 minikube.k8s.io/updated_at=2020_02_20T12_05_35_0700 --all --overwrite --kubeconfig=/var/lib/minikube/kubeconfig
-	cmd := exec.CommandContext(ctx, "sudo", kubectlPath(cfg),
-		"label", "nodes", verLbl, commitLbl, nameLbl, createdAtLbl, "--all", "--overwrite",
-		fmt.Sprintf("--kubeconfig=%s", path.Join(vmpath.GuestPersistentDir, "kubeconfig")))
+	args := append([]string{kubectlPath(cfg), "label", "nodes"}, lbls...)
+	args = append(args, "--all", "--overwrite", fmt.Sprintf("--kubeconfig=%s", path.Join(vmpath.GuestPersistentDir, "kubeconfig")))
+	cmd := exec.CommandContext(ctx, "sudo", args...)
 
 	if _, err := k.c.RunCmd(cmd); err != nil {
 		return errors.Wrapf(err, "applying node labels")
@@ -842,6 +952,59 @@ func (k *Bootstrapper) applyNodeLabels(cfg config.ClusterConfig) error {
 	return nil
 }
 
+// applyControlPlaneTaint taints the primary control plane if it is reserved for system workloads, and untaints it otherwise
+func (k *Bootstrapper) applyControlPlaneTaint(cfg config.ClusterConfig) error {
+	cp, err := config.PrimaryControlPlane(&cfg)
+	if err != nil {
+		return errors.Wrap(err, "getting primary control plane")
+	}
+
+	taint := constants.ControlPlaneTaint
+	if !cfg.KubernetesConfig.ReserveControlPlane {
+		taint += "-"
+	} else if err := k.tolerateControlPlaneTaint(cfg); err != nil {
+		return errors.Wrap(err, "coredns tolerations")
+	}
+
+	// Allow no more than 5 seconds for applying the taint
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	// example:
+	// sudo /var/lib/minikube/binaries/<version>/kubectl taint nodes minikube node-role.kubernetes.io/control-plane:NoSchedule --overwrite --kubeconfig=/var/lib/minikube/kubeconfig
+	cmd := exec.CommandContext(ctx, "sudo", kubectlPath(cfg), "taint", "nodes", bsutil.KubeNodeName(cfg, cp), taint, "--overwrite",
+		fmt.Sprintf("--kubeconfig=%s", path.Join(vmpath.GuestPersistentDir, "kubeconfig")))
+
+	rr, err := k.c.RunCmd(cmd)
+	if err != nil {
+		// removing a taint the node does not have fails, which is expected on clusters which were never reserved
+		if !cfg.KubernetesConfig.ReserveControlPlane && strings.Contains(rr.Output(), "not found") {
+			return nil
+		}
+		return errors.Wrapf(err, "tainting control plane")
+	}
+	return nil
+}
+
+// coreDNSTolerations are the tolerations of CoreDNS, including the control plane taint which kubeadm versions before
+// v1.24 do not tolerate. Tolerations are replaced as a whole by a strategic merge patch, so applying them is idempotent.
+const coreDNSTolerations = `{"spec":{"template":{"spec":{"tolerations":[` +
+	`{"key":"CriticalAddonsOnly","operator":"Exists"},` +
+	`{"key":"node-role.kubernetes.io/master","effect":"NoSchedule"},` +
+	`{"key":"node-role.kubernetes.io/control-plane","effect":"NoSchedule"}]}}}}`
+
+// tolerateControlPlaneTaint lets CoreDNS run on a reserved control plane, as a cluster may have no worker for it
+func (k *Bootstrapper) tolerateControlPlaneTaint(cfg config.ClusterConfig) error {
+	// Allow no more than 5 seconds for patching CoreDNS
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, "sudo", kubectlPath(cfg), "-n", "kube-system", "patch", "deployment", "coredns", "--patch", coreDNSTolerations,
+		fmt.Sprintf("--kubeconfig=%s", path.Join(vmpath.GuestPersistentDir, "kubeconfig")))
+	if _, err := k.c.RunCmd(cmd); err != nil {
+		return errors.Wrap(err, "patching coredns")
+	}
+	return nil
+}
+
 // elevateKubeSystemPrivileges gives the kube-system service account cluster admin privileges to work with RBAC.
 func (k *Bootstrapper) elevateKubeSystemPrivileges(cfg config.ClusterConfig) error {
 	start := time.Now()
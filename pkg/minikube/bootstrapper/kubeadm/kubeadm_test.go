@@ -0,0 +1,42 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubeadm
+
+import (
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestJoinCommand(t *testing.T) {
+	cc := config.ClusterConfig{Name: "minikube", Driver: "docker"}
+	var tests = []struct {
+		description string
+		node        config.Node
+		want        string
+	}{
+		{"machine name", config.Node{Name: "m02", Worker: true}, "kubeadm join 192.168.49.2:8443 --node-name=minikube-m02"},
+		{"custom hostname", config.Node{Name: "m03", Worker: true, Hostname: "edge"}, "kubeadm join 192.168.49.2:8443 --node-name=edge"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			if got := joinCommand("kubeadm join 192.168.49.2:8443", cc, tc.node); got != tc.want {
+				t.Errorf("joinCommand() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
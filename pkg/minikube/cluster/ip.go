@@ -124,6 +124,12 @@ func DriverIP(api libmachine.API, machineName string) (net.IP, error) {
 	}
 	if driver.IsKIC(host.DriverName) {
 		ipStr = oci.DefaultBindIPV4
+		// the ports of kic nodes may be published on another host address
+		if d, ok := host.Driver.(interface{ GetExternalIP() (string, error) }); ok {
+			if ipStr, err = d.GetExternalIP(); err != nil {
+				return nil, errors.Wrap(err, "getting external IP")
+			}
+		}
 	}
 	ip := net.ParseIP(ipStr)
 	if ip == nil {
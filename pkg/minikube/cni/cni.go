@@ -89,6 +89,18 @@ func New(cc config.ClusterConfig) (Manager, error) {
 	}
 }
 
+// PodSelector returns the label selector of the pods a CNI runs on every node, or "" if it runs none that minikube knows of
+func PodSelector(m Manager) string {
+	switch m.(type) {
+	case KindNet:
+		return "app=kindnet"
+	case Flannel:
+		return "app=flannel"
+	default:
+		return ""
+	}
+}
+
 func chooseDefault(cc config.ClusterConfig) Manager {
 	// For backwards compatibility with older profiles using --enable-default-cni
 	if cc.KubernetesConfig.EnableDefaultCNI {
@@ -62,6 +62,9 @@ type Runner interface {
 
 	// Remove is a convenience method that runs a command to remove a file
 	Remove(assets.CopyableFile) error
+
+	// CopyFrom streams the contents of a file on the remote to w, without buffering them in memory
+	CopyFrom(src string, w io.Writer) error
 }
 
 // Command returns a human readable command string that does not induce eye fatigue
@@ -114,3 +114,17 @@ func (*execRunner) Remove(f assets.CopyableFile) error {
 	glog.Infof("rm: %s", dst)
 	return os.Remove(dst)
 }
+
+// CopyFrom streams the contents of a file to w
+func (*execRunner) CopyFrom(src string, w io.Writer) error {
+	glog.Infof("copy from: %s", src)
+
+	var errb bytes.Buffer
+	cmd := exec.Command("sudo", "cat", src)
+	cmd.Stdout = w
+	cmd.Stderr = &errb
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "cat %s: %s", src, errb.String())
+	}
+	return nil
+}
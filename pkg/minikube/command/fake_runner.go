@@ -107,6 +107,16 @@ func (f *FakeCommandRunner) Remove(file assets.CopyableFile) error {
 	return nil
 }
 
+// CopyFrom writes the stored contents of a file to w
+func (f *FakeCommandRunner) CopyFrom(src string, w io.Writer) error {
+	contents, err := f.GetFileToContents(src)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, contents)
+	return err
+}
+
 // SetFileToContents stores the file to contents map for the FakeCommandRunner
 func (f *FakeCommandRunner) SetFileToContents(fileToContents map[string]string) {
 	for k, v := range fileToContents {
@@ -245,6 +245,20 @@ func (k *kicRunner) Remove(f assets.CopyableFile) error {
 	return err
 }
 
+// CopyFrom streams the contents of a file in the container to w
+func (k *kicRunner) CopyFrom(src string, w io.Writer) error {
+	glog.Infof("copy from: %s", src)
+
+	var errb bytes.Buffer
+	cmd := oci.PrefixCmd(exec.Command(k.ociBin, "exec", "--privileged", k.nameOrID, "sudo", "cat", src))
+	cmd.Stdout = w
+	cmd.Stderr = &errb
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "cat %s: %s", src, errb.String())
+	}
+	return nil
+}
+
 // isTerminal returns true if the writer w is a terminal
 func isTerminal(w io.Writer) bool {
 	if v, ok := (w).(*os.File); ok {
@@ -107,6 +107,25 @@ func (s *SSHRunner) Remove(f assets.CopyableFile) error {
 	return sess.Run(fmt.Sprintf("sudo rm %s", dst))
 }
 
+// CopyFrom streams the contents of a file on the remote to w
+func (s *SSHRunner) CopyFrom(src string, w io.Writer) error {
+	glog.Infof("copy from: %s", src)
+
+	sess, err := s.session()
+	if err != nil {
+		return errors.Wrap(err, "getting ssh session")
+	}
+	defer sess.Close()
+
+	var errb bytes.Buffer
+	sess.Stdout = w
+	sess.Stderr = &errb
+	if err := sess.Run(fmt.Sprintf("sudo cat %s", shellquote.Join(src))); err != nil {
+		return errors.Wrapf(err, "cat %s: %s", src, errb.String())
+	}
+	return nil
+}
+
 // teeSSH runs an SSH command, streaming stdout, stderr to logs
 func teeSSH(s *ssh.Session, cmd string, outB io.Writer, errB io.Writer) error {
 	outPipe, err := s.StdoutPipe()
@@ -138,12 +157,24 @@ func teeSSH(s *ssh.Session, cmd string, outB io.Writer, errB io.Writer) error {
 	return err
 }
 
-// RunCmd implements the Command Runner interface to run a exec.Cmd object
-func (s *SSHRunner) RunCmd(cmd *exec.Cmd) (*RunResult, error) {
-	if cmd.Stdin != nil {
-		return nil, fmt.Errorf("SSHRunner does not support stdin - you could be the first to add it")
+// exitStatuser is implemented by *ssh.ExitError, returned when the remote command exits with a non-zero status
+type exitStatuser interface {
+	ExitStatus() int
+}
+
+// exitCode returns the status a command exited with, or 0 if it did not run to completion
+func exitCode(err error) int {
+	switch e := err.(type) {
+	case exitStatuser:
+		return e.ExitStatus()
+	case *exec.ExitError:
+		return e.ExitCode()
 	}
+	return 0
+}
 
+// RunCmd implements the Command Runner interface to run a exec.Cmd object
+func (s *SSHRunner) RunCmd(cmd *exec.Cmd) (*RunResult, error) {
 	rr := &RunResult{Args: cmd.Args}
 	glog.Infof("Run: %v", rr.Command())
 
@@ -177,12 +208,12 @@ func (s *SSHRunner) RunCmd(cmd *exec.Cmd) (*RunResult, error) {
 		}
 	}()
 
+	// the remote end of stdin is closed once cmd.Stdin reaches EOF
+	sess.Stdin = cmd.Stdin
 	err = teeSSH(sess, shellquote.Join(cmd.Args...), outb, errb)
 	elapsed := time.Since(start)
 
-	if exitError, ok := err.(*exec.ExitError); ok {
-		rr.ExitCode = exitError.ExitCode()
-	}
+	rr.ExitCode = exitCode(err)
 	// Decrease log spam
 	if elapsed > (1 * time.Second) {
 		glog.Infof("Completed: %s: (%s)", rr.Command(), elapsed)
@@ -19,9 +19,12 @@ package command
 import (
 	"bytes"
 	"fmt"
+	"os/exec"
 	"strings"
 	"sync"
 	"testing"
+
+	"golang.org/x/crypto/ssh"
 )
 
 func TestTeePrefix(t *testing.T) {
@@ -61,3 +64,32 @@ func TestTeePrefix(t *testing.T) {
 		t.Errorf("log=%q, want: %q", gotLog, wantLog)
 	}
 }
+
+// remoteExit behaves like an *ssh.ExitError, whose status can not be set outside of the ssh package
+type remoteExit int
+
+func (r remoteExit) Error() string   { return fmt.Sprintf("Process exited with status %d", int(r)) }
+func (r remoteExit) ExitStatus() int { return int(r) }
+
+func TestExitCode(t *testing.T) {
+	localErr := exec.Command("sh", "-c", "exit 3").Run()
+
+	var tests = []struct {
+		description string
+		err         error
+		want        int
+	}{
+		{"success", nil, 0},
+		{"remote exit status", remoteExit(42), 42},
+		{"zero ssh exit error", &ssh.ExitError{}, 0},
+		{"local exit status", localErr, 3},
+		{"connection error", fmt.Errorf("ssh: handshake failed"), 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			if got := exitCode(tc.err); got != tc.want {
+				t.Errorf("exitCode(%v) = %d, want %d", tc.err, got, tc.want)
+			}
+		})
+	}
+}
@@ -62,6 +62,17 @@ func (es *ExtraOptionSlice) Set(value string) error {
 	return nil
 }
 
+// Update sets an option, replacing the value of an existing option with the same component and key
+func (es *ExtraOptionSlice) Update(e ExtraOption) {
+	for i, opt := range *es {
+		if opt.Component == e.Component && opt.Key == e.Key {
+			(*es)[i].Value = e.Value
+			return
+		}
+	}
+	*es = append(*es, e)
+}
+
 // String converts the slice to a string value
 func (es *ExtraOptionSlice) String() string {
 	s := []string{}
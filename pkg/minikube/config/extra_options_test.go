@@ -132,3 +132,35 @@ func TestAsMap(t *testing.T) {
 		t.Errorf("Unexpected value. Expected %s, got %s", expectedRes, res)
 	}
 }
+
+func TestUpdate(t *testing.T) {
+	for _, tc := range []struct {
+		update ExtraOption
+		expRes ExtraOptionSlice
+	}{
+		{
+			ExtraOption{Component: "kubelet", Key: "eviction-hard", Value: "memory.available<500Mi"},
+			ExtraOptionSlice{
+				ExtraOption{Component: "kubelet", Key: "eviction-hard", Value: "memory.available<500Mi"},
+				ExtraOption{Component: "apiserver", Key: "eviction-hard", Value: "unused"},
+			},
+		},
+		{
+			ExtraOption{Component: "kubelet", Key: "eviction-soft", Value: "memory.available<1Gi"},
+			ExtraOptionSlice{
+				ExtraOption{Component: "kubelet", Key: "eviction-hard", Value: "memory.available<200Mi"},
+				ExtraOption{Component: "apiserver", Key: "eviction-hard", Value: "unused"},
+				ExtraOption{Component: "kubelet", Key: "eviction-soft", Value: "memory.available<1Gi"},
+			},
+		},
+	} {
+		es := ExtraOptionSlice{
+			ExtraOption{Component: "kubelet", Key: "eviction-hard", Value: "memory.available<200Mi"},
+			ExtraOption{Component: "apiserver", Key: "eviction-hard", Value: "unused"},
+		}
+		es.Update(tc.update)
+		if !reflect.DeepEqual(es, tc.expRes) {
+			t.Errorf("Unexpected value. Expected %s, got %s", tc.expRes, es)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"k8s.io/minikube/pkg/minikube/localpath"
+	"k8s.io/minikube/pkg/util/lock"
+)
+
+// NodeConfigPath returns the path the node configuration of a deleted profile is kept at
+func NodeConfigPath(profile string, miniHome ...string) string {
+	miniPath := localpath.MiniPath()
+	if len(miniHome) > 0 {
+		miniPath = miniHome[0]
+	}
+	return filepath.Join(miniPath, "node-config", profile+".json")
+}
+
+// SaveNodeConfig keeps the per-node configuration of a cluster, so that it can be recreated with the same nodes
+func SaveNodeConfig(cc *ClusterConfig, miniHome ...string) error {
+	var nodes []Node
+	for _, n := range cc.Nodes {
+		// addresses are assigned again when the node is recreated
+		n.IP = ""
+		nodes = append(nodes, n)
+	}
+
+	data, err := json.MarshalIndent(nodes, "", "    ")
+	if err != nil {
+		return err
+	}
+	path := NodeConfigPath(cc.Name, miniHome...)
+	glog.Infof("Saving node config to %s ...", path)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return lock.WriteFile(path, data, 0600)
+}
+
+// LoadNodeConfig returns the node configuration saved by SaveNodeConfig
+func LoadNodeConfig(profile string, miniHome ...string) ([]Node, error) {
+	path := NodeConfigPath(profile, miniHome...)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []Node
+	if err := json.Unmarshal(data, &nodes); err != nil {
+		return nil, errors.Wrapf(err, "parse %s", path)
+	}
+	return nodes, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestNodeConfig(t *testing.T) {
+	miniDir, err := ioutil.TempDir("", "node-config")
+	if err != nil {
+		t.Fatalf("tempdir: %v", err)
+	}
+	defer os.RemoveAll(miniDir)
+
+	if _, err := LoadNodeConfig("p1", miniDir); !os.IsNotExist(err) {
+		t.Errorf("LoadNodeConfig() of a missing profile = %v, want a not exist error", err)
+	}
+
+	cc := &ClusterConfig{
+		Name: "p1",
+		Nodes: []Node{
+			{Name: "", IP: "192.168.39.2", Port: 8443, ControlPlane: true, Worker: true},
+			{Name: "gpu", IP: "192.168.39.3", Worker: true, GPUs: "all", Sysctls: []string{"vm.max_map_count=262144"}, KubeletExtraArgs: []string{"--node-labels=accelerator=nvidia"}},
+		},
+	}
+	if err := SaveNodeConfig(cc, miniDir); err != nil {
+		t.Fatalf("SaveNodeConfig() unexpected error: %v", err)
+	}
+
+	got, err := LoadNodeConfig("p1", miniDir)
+	if err != nil {
+		t.Fatalf("LoadNodeConfig() unexpected error: %v", err)
+	}
+	want := []Node{
+		{Name: "", Port: 8443, ControlPlane: true, Worker: true},
+		{Name: "gpu", Worker: true, GPUs: "all", Sysctls: []string{"vm.max_map_count=262144"}, KubeletExtraArgs: []string{"--node-labels=accelerator=nvidia"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("LoadNodeConfig() = %+v, want: %+v", got, want)
+	}
+}
+
+func TestSaveProfileRefreshesNodeConfig(t *testing.T) {
+	miniDir, err := ioutil.TempDir("", "node-config")
+	if err != nil {
+		t.Fatalf("tempdir: %v", err)
+	}
+	defer os.RemoveAll(miniDir)
+
+	cc := &ClusterConfig{Name: "p1", Nodes: []Node{{Name: "", ControlPlane: true, Worker: true}}}
+	if err := SaveProfile("p1", cc, miniDir); err != nil {
+		t.Fatalf("SaveProfile() unexpected error: %v", err)
+	}
+	// nothing is kept until the cluster is deleted
+	if _, err := LoadNodeConfig("p1", miniDir); !os.IsNotExist(err) {
+		t.Errorf("LoadNodeConfig() before deletion = %v, want a not exist error", err)
+	}
+
+	if err := SaveNodeConfig(cc, miniDir); err != nil {
+		t.Fatalf("SaveNodeConfig() unexpected error: %v", err)
+	}
+	// the recreated cluster gains a node
+	cc.Nodes = append(cc.Nodes, Node{Name: "m02", Worker: true})
+	if err := SaveProfile("p1", cc, miniDir); err != nil {
+		t.Fatalf("SaveProfile() unexpected error: %v", err)
+	}
+
+	got, err := LoadNodeConfig("p1", miniDir)
+	if err != nil {
+		t.Fatalf("LoadNodeConfig() unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, cc.Nodes) {
+		t.Errorf("LoadNodeConfig() = %+v, want: %+v", got, cc.Nodes)
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/golang/glog"
 	"github.com/spf13/viper"
@@ -31,6 +32,9 @@ import (
 	"k8s.io/minikube/pkg/util/lock"
 )
 
+// profileMu serializes writing profiles, such as by the nodes of 'minikube node add --count' saving themselves concurrently
+var profileMu sync.Mutex
+
 var keywords = []string{"start", "stop", "status", "delete", "config", "open", "profile", "addons", "cache", "logs"}
 
 // IsValid checks if the profile has the essential info needed for a profile
@@ -142,6 +146,22 @@ func SaveNode(cfg *ClusterConfig, node *Node) error {
 
 // SaveProfile creates an profile out of the cfg and stores in $MINIKUBE_HOME/profiles/<profilename>/config.json
 func SaveProfile(name string, cfg *ClusterConfig, miniHome ...string) error {
+	profileMu.Lock()
+	defer profileMu.Unlock()
+
+	if err := saveProfile(name, cfg, miniHome...); err != nil {
+		return err
+	}
+	// a node config kept by a deletion follows the nodes of the recreated cluster, so that it is never restored stale
+	if _, err := os.Stat(NodeConfigPath(name, miniHome...)); err == nil && cfg.Name == name {
+		if err := SaveNodeConfig(cfg, miniHome...); err != nil {
+			glog.Warningf("unable to refresh the node config of %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+func saveProfile(name string, cfg *ClusterConfig, miniHome ...string) error {
 	data, err := json.MarshalIndent(cfg, "", "    ")
 	if err != nil {
 		return err
@@ -174,10 +194,7 @@ func SaveProfile(name string, cfg *ClusterConfig, miniHome ...string) error {
 		return err
 	}
 
-	if err = os.Remove(path); err != nil {
-		return err
-	}
-
+	// Rename replaces the config atomically, so that it is never missing for commands reading it concurrently
 	if err = os.Rename(tf.Name(), path); err != nil {
 		return err
 	}
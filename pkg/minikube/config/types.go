@@ -27,6 +27,17 @@ type Profile struct {
 	Name   string
 	Status string // running, stopped
 	Config *ClusterConfig
+	Nodes  []NodeStatus `json:",omitempty"` // only set by 'minikube profile list -o json'
+	// NodesReady is the number of Ready nodes out of all nodes, such as "2/3", only set by 'minikube profile list --show-health'
+	NodesReady string `json:",omitempty"`
+}
+
+// NodeStatus is the role, address and host status of a node of a profile
+type NodeStatus struct {
+	Name   string
+	Role   string // control-plane, worker
+	IP     string
+	Status string // running, stopped
 }
 
 // ClusterConfig contains the parameters used to start a cluster.
@@ -54,6 +65,9 @@ type ClusterConfig struct {
 	KVMGPU                  bool     // Only used by kvm2
 	KVMHidden               bool     // Only used by kvm2
 	DockerOpt               []string // Each entry is formatted as KEY=VALUE.
+	Sysctls                 []string // Each entry is formatted as KEY=VALUE, applied to every node.
+	PrepullImages           []string // Cached on the host and loaded into every node as it starts.
+	MaxNodes                int      // Nodes can not be added past it, 0 meaning no limit.
 	DisableDriverMounts     bool     // Only used by virtualbox
 	NFSShare                []string
 	NFSSharesRoot           string
@@ -71,21 +85,29 @@ type ClusterConfig struct {
 
 // KubernetesConfig contains the parameters used to configure the VM Kubernetes.
 type KubernetesConfig struct {
-	KubernetesVersion   string
-	ClusterName         string
-	APIServerName       string
-	APIServerNames      []string
-	APIServerIPs        []net.IP
-	DNSDomain           string
-	ContainerRuntime    string
-	CRISocket           string
-	NetworkPlugin       string
-	FeatureGates        string // https://kubernetes.io/docs/reference/command-line-tools-reference/feature-gates/
-	ServiceCIDR         string // the subnet which Kubernetes services will be deployed to
-	ImageRepository     string
-	LoadBalancerStartIP string // currently only used by MetalLB addon
-	LoadBalancerEndIP   string // currently only used by MetalLB addon
-	ExtraOptions        ExtraOptionSlice
+	KubernetesVersion     string
+	ClusterName           string
+	APIServerName         string
+	APIServerNames        []string
+	APIServerIPs          []net.IP
+	DNSDomain             string
+	ContainerRuntime      string
+	CRISocket             string
+	NetworkPlugin         string
+	FeatureGates          string // https://kubernetes.io/docs/reference/command-line-tools-reference/feature-gates/
+	ServiceCIDR           string // the subnet which Kubernetes services will be deployed to
+	ImageRepository       string
+	BinaryMirror          string // base URL Kubernetes binaries are downloaded from, laid out like the release bucket. Empty means the release bucket.
+	LoadBalancerStartIP   string // currently only used by MetalLB addon
+	LoadBalancerEndIP     string // currently only used by MetalLB addon
+	ProvisionerNode       string // Kubernetes node the storage-provisioner addon is pinned to, empty means any node
+	ReserveControlPlane   bool   // taint the control plane so that user workloads are scheduled on workers
+	NodeCIDRMaskSize      int    // prefix length of the pod CIDR allocated to each node, 0 means the controller-manager default
+	ContainerLogMaxSize   string // size a container log is rotated at on every node, e.g. 10Mi, empty means the kubelet default
+	ContainerLogMaxFiles  int    // number of log files kept per container on every node, 0 means the kubelet default
+	EtcdDataDir           string // where etcd stores its data on every control plane, empty means /var/lib/minikube/etcd
+	EtcdQuotaBackendBytes int64  // space quota of the etcd database on every control plane, 0 means the etcd default
+	ExtraOptions          ExtraOptionSlice
 
 	ShouldLoadCachedImages bool
 
@@ -100,12 +122,34 @@ type KubernetesConfig struct {
 
 // Node contains information about specific nodes in a cluster
 type Node struct {
-	Name              string
-	IP                string
-	Port              int
-	KubernetesVersion string
-	ControlPlane      bool
-	Worker            bool
+	Name                 string
+	IP                   string
+	Port                 int
+	KubernetesVersion    string
+	ControlPlane         bool
+	Worker               bool
+	CgroupDriver         string   // cgroup driver shared by the kubelet and container runtime, empty means runtime default
+	CPUs                 int      // number of CPUs of the node, 0 means the cluster-wide value
+	Memory               int      // memory of the node in MB, 0 means the cluster-wide value
+	Sysctls              []string // Each entry is formatted as KEY=VALUE, overriding the cluster-wide value.
+	ExtraDisks           int      // number of additional raw disks attached to the node
+	ExtraDiskSize        int      // size of each additional disk, in MB
+	KubeletExtraArgs     []string // Each entry is formatted as --flag=value, overriding the cluster-wide kubelet options.
+	GPUs                 string   // GPUs passed through to the node, empty means none
+	Arch                 string   // CPU architecture of the node, emulated if it differs from the host, empty means the architecture of the host
+	Annotations          []string // Each entry is formatted as KEY=VALUE, set on the Kubernetes node whenever it starts.
+	Labels               []string // Each entry is formatted as KEY=VALUE, set on the Kubernetes node whenever it starts.
+	ExtendedResources    []string // Each entry is formatted as NAME=QUANTITY, advertised in the capacity of the Kubernetes node whenever it starts.
+	Mount                string   // Formatted as <source directory>:<target directory>, mounted into the node whenever it starts.
+	PauseImage           string   // pause (sandbox) image of the runtime and kubelet, empty means the one of the Kubernetes version
+	ContainerLogMaxSize  string   // size a container log is rotated at on the node, empty means the cluster-wide one
+	ContainerLogMaxFiles int      // number of log files kept per container on the node, 0 means the cluster-wide one
+	MinikubeISO          string   // ISO the VM of the node boots from (kvm2 driver only), empty means the cluster-wide one
+	ListenAddress        string   // host address the ports of the node are published on (docker driver only), empty means 127.0.0.1
+	RuntimeConfig        []string // Each entry is formatted as RUNTIME.KEY=VALUE, set in the container runtime configuration whenever the node starts.
+	PostJoinScript       string   // local path of a script run as root on the node whenever it restarts, empty means none
+	Hostname             string   // hostname and Kubernetes node name of the node, empty means the machine name
+	RegisterDNS          bool     // resolve the hostname of the node within the cluster through CoreDNS, updated whenever it starts
 }
 
 // VersionedExtraOption holds information on flags to apply to a specific range
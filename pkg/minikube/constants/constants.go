@@ -55,6 +55,10 @@ const (
 	HostAlias = "host.minikube.internal"
 	// ControlPlaneAlias is a DNS alias pointing to the apiserver frontend
 	ControlPlaneAlias = "control-plane.minikube.internal"
+	// GPUNodeLabel marks the nodes with GPUs passed through, which the NVIDIA device plugin is scheduled on
+	GPUNodeLabel = "minikube.k8s.io/gpu"
+	// ControlPlaneTaint keeps user workloads off control planes when --reserve-control-plane is set
+	ControlPlaneTaint = "node-role.kubernetes.io/control-plane:NoSchedule"
 
 	// DockerHostEnv is used for docker daemon settings
 	DockerHostEnv = "DOCKER_HOST"
@@ -97,7 +97,7 @@ oom_score = 0
     [plugins.cri.registry]
       [plugins.cri.registry.mirrors]
         [plugins.cri.registry.mirrors."docker.io"]
-          endpoint = ["https://registry-1.docker.io"]
+          endpoint = [{{range .RegistryMirrors}}"{{.}}", {{end}}"https://registry-1.docker.io"]
   [plugins.diff-service]
     default = ["walking"]
   [plugins.linux]
@@ -121,6 +121,10 @@ type Containerd struct {
 	Runner            CommandRunner
 	ImageRepository   string
 	KubernetesVersion semver.Version
+	RegistryMirrors   []string
+	Options           []Option
+	PauseImage        string
+	ProxyEnv          []string
 	Init              sysinit.Manager
 }
 
@@ -172,25 +176,65 @@ func (r *Containerd) Available() error {
 }
 
 // generateContainerdConfig sets up /etc/containerd/config.toml
-func generateContainerdConfig(cr CommandRunner, imageRepository string, kv semver.Version) error {
+func generateContainerdConfig(cr CommandRunner, pauseImage string, mirrors []string, options []Option) error {
 	cPath := containerdConfigFile
 	t, err := template.New("containerd.config.toml").Parse(containerdConfigTemplate)
 	if err != nil {
 		return err
 	}
-	pauseImage := images.Pause(kv, imageRepository)
-	opts := struct{ PodInfraContainerImage string }{PodInfraContainerImage: pauseImage}
+	opts := struct {
+		PodInfraContainerImage string
+		RegistryMirrors        []string
+	}{
+		PodInfraContainerImage: pauseImage,
+		RegistryMirrors:        mirrors,
+	}
 	var b bytes.Buffer
 	if err := t.Execute(&b, opts); err != nil {
 		return err
 	}
-	c := exec.Command("/bin/bash", "-c", fmt.Sprintf("sudo mkdir -p %s && printf %%s \"%s\" | base64 -d | sudo tee %s", path.Dir(cPath), base64.StdEncoding.EncodeToString(b.Bytes()), cPath))
+	conf := setCRIOptions(b.String(), options)
+	c := exec.Command("/bin/bash", "-c", fmt.Sprintf("sudo mkdir -p %s && printf %%s \"%s\" | base64 -d | sudo tee %s", path.Dir(cPath), base64.StdEncoding.EncodeToString([]byte(conf)), cPath))
 	if _, err := cr.RunCmd(c); err != nil {
 		return errors.Wrap(err, "generate containerd cfg.")
 	}
 	return nil
 }
 
+// setCRIOptions sets options in the [plugins.cri] table of a containerd configuration, replacing the defaults of the same keys
+func setCRIOptions(conf string, options []Option) string {
+	if len(options) == 0 {
+		return conf
+	}
+	set := map[string]bool{}
+	for _, o := range options {
+		set[o.Key] = true
+	}
+
+	var lines []string
+	inTable := false
+	for _, l := range strings.Split(conf, "\n") {
+		trimmed := strings.TrimSpace(l)
+		if strings.HasPrefix(trimmed, "[") {
+			inTable = trimmed == "[plugins.cri]"
+			lines = append(lines, l)
+			if inTable {
+				for _, o := range options {
+					lines = append(lines, fmt.Sprintf("    %s = %s", o.Key, o.Value))
+				}
+			}
+			continue
+		}
+		if inTable {
+			if key := strings.TrimSpace(strings.SplitN(trimmed, "=", 2)[0]); set[key] {
+				continue
+			}
+		}
+		lines = append(lines, l)
+	}
+	return strings.Join(lines, "\n")
+}
+
 // Enable idempotently enables containerd on a host
 func (r *Containerd) Enable(disOthers, _ bool) error {
 	if disOthers {
@@ -201,7 +245,10 @@ func (r *Containerd) Enable(disOthers, _ bool) error {
 	if err := populateCRIConfig(r.Runner, r.SocketPath()); err != nil {
 		return err
 	}
-	if err := generateContainerdConfig(r.Runner, r.ImageRepository, r.KubernetesVersion); err != nil {
+	if err := generateContainerdConfig(r.Runner, pauseImage(r.PauseImage, r.KubernetesVersion, r.ImageRepository), r.RegistryMirrors, r.Options); err != nil {
+		return err
+	}
+	if err := configureProxy(r.Runner, "containerd", r.ProxyEnv); err != nil {
 		return err
 	}
 	if err := enableIPForwarding(r.Runner); err != nil {
@@ -219,7 +266,12 @@ func (r *Containerd) Disable() error {
 
 // ImageExists checks if an image exists, expected input format
 func (r *Containerd) ImageExists(name string, sha string) bool {
-	c := exec.Command("/bin/bash", "-c", fmt.Sprintf("sudo ctr -n=k8s.io images check | grep %s | grep %s", name, sha))
+	check := fmt.Sprintf("sudo ctr -n=k8s.io images check | grep %s", name)
+	// without a sha, any image of that name will do
+	if sha != "" {
+		check += " | grep " + sha
+	}
+	c := exec.Command("/bin/bash", "-c", check)
 	if _, err := r.Runner.RunCmd(c); err != nil {
 		return false
 	}
@@ -236,6 +288,17 @@ func (r *Containerd) LoadImage(path string) error {
 	return nil
 }
 
+// SaveImage saves an image from this runtime to a tarball
+func (r *Containerd) SaveImage(name string, path string) error {
+	glog.Infof("Saving image %s: %s", name, path)
+	// ctr only knows images by their fully qualified reference
+	c := exec.Command("sudo", "ctr", "-n=k8s.io", "images", "export", path, normalizedImageName(name))
+	if _, err := r.Runner.RunCmd(c); err != nil {
+		return errors.Wrapf(err, "ctr images export")
+	}
+	return nil
+}
+
 // CGroupDriver returns cgroup driver ("cgroupfs" or "systemd")
 func (r *Containerd) CGroupDriver() (string, error) {
 	info, err := getCRIInfo(r.Runner)
@@ -413,6 +476,23 @@ func containerdImagesPreloaded(runner command.Runner, images []string) bool {
 	return true
 }
 
+// normalizedImageName returns the fully qualified reference of an image, as docker expands it,
+// for example "busybox" is "docker.io/library/busybox:latest"
+func normalizedImageName(name string) string {
+	parts := strings.SplitN(name, "/", 2)
+	switch {
+	case len(parts) == 1:
+		name = "docker.io/library/" + name
+	case !strings.ContainsAny(parts[0], ".:") && parts[0] != "localhost":
+		name = "docker.io/" + name
+	}
+	last := name[strings.LastIndex(name, "/")+1:]
+	if !strings.ContainsAny(last, ":@") {
+		name += ":latest"
+	}
+	return name
+}
+
 // addRepoTagToImageName makes sure the image name has a repo tag in it.
 // in crictl images list have the repo tag prepended to them
 // for example "kubernetesui/dashboard:v2.0.0 will show up as "docker.io/kubernetesui/dashboard:v2.0.0"
@@ -38,3 +38,58 @@ func TestAddRepoTagToImageName(t *testing.T) {
 		})
 	}
 }
+
+func TestSetCRIOptions(t *testing.T) {
+	conf := `[plugins]
+  [plugins.cri]
+    stream_server_port = "10010"
+    max_container_log_line_size = 16384
+    [plugins.cri.containerd]
+      snapshotter = "overlayfs"
+  [plugins.linux]
+    runtime = "runc"
+`
+	var tests = []struct {
+		name    string
+		options []Option
+		want    string
+	}{
+		{"none", nil, conf},
+		{
+			name:    "new and replaced",
+			options: []Option{{Key: "max_concurrent_downloads", Value: "10"}, {Key: "max_container_log_line_size", Value: "-1"}},
+			want: `[plugins]
+  [plugins.cri]
+    max_concurrent_downloads = 10
+    max_container_log_line_size = -1
+    stream_server_port = "10010"
+    [plugins.cri.containerd]
+      snapshotter = "overlayfs"
+  [plugins.linux]
+    runtime = "runc"
+`,
+		},
+		{
+			name:    "nested key untouched",
+			options: []Option{{Key: "snapshotter", Value: `"native"`}},
+			want: `[plugins]
+  [plugins.cri]
+    snapshotter = "native"
+    stream_server_port = "10010"
+    max_container_log_line_size = 16384
+    [plugins.cri.containerd]
+      snapshotter = "overlayfs"
+  [plugins.linux]
+    runtime = "runc"
+`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := setCRIOptions(conf, tc.options)
+			if got != tc.want {
+				t.Errorf("setCRIOptions() = %q, want: %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package cruntime
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"html/template"
@@ -225,6 +226,34 @@ image-endpoint: unix://{{.Socket}}
 	return nil
 }
 
+// proxyDropIn returns the path of the systemd drop-in setting the proxy environment of a service
+func proxyDropIn(svc string) string {
+	return path.Join("/etc/systemd/system", svc+".service.d", "10-proxy.conf")
+}
+
+// configureProxy sets the proxy environment of a runtime service, or removes it without a proxy.
+// It is rewritten whenever the runtime is enabled, for NO_PROXY to cover the nodes added since.
+func configureProxy(cr CommandRunner, svc string, env []string) error {
+	dropIn := proxyDropIn(svc)
+	if len(env) == 0 {
+		if rr, err := cr.RunCmd(exec.Command("sudo", "rm", "-f", dropIn)); err != nil {
+			return errors.Wrapf(err, "Run: %q", rr.Command())
+		}
+		return nil
+	}
+
+	var b strings.Builder
+	b.WriteString("[Service]\n")
+	for _, e := range env {
+		fmt.Fprintf(&b, "Environment=\"%s\"\n", e)
+	}
+	c := exec.Command("/bin/bash", "-c", fmt.Sprintf("sudo mkdir -p %s && printf %%s \"%s\" | base64 -d | sudo tee %s", path.Dir(dropIn), base64.StdEncoding.EncodeToString([]byte(b.String())), dropIn))
+	if rr, err := cr.RunCmd(c); err != nil {
+		return errors.Wrapf(err, "Run: %q", rr.Command())
+	}
+	return nil
+}
+
 // getCRIInfo returns current information
 func getCRIInfo(cr CommandRunner) (map[string]interface{}, error) {
 	args := []string{"crictl", "info"}
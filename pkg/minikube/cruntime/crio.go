@@ -25,7 +25,6 @@ import (
 	"github.com/blang/semver"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
-	"k8s.io/minikube/pkg/minikube/bootstrapper/images"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/download"
 	"k8s.io/minikube/pkg/minikube/out"
@@ -43,18 +42,31 @@ type CRIO struct {
 	Runner            CommandRunner
 	ImageRepository   string
 	KubernetesVersion semver.Version
+	Options           []Option
+	PauseImage        string
+	ProxyEnv          []string
 	Init              sysinit.Manager
 }
 
 // generateCRIOConfig sets up /etc/crio/crio.conf
-func generateCRIOConfig(cr CommandRunner, imageRepository string, kv semver.Version) error {
+func generateCRIOConfig(cr CommandRunner, pauseImage string, options []Option) error {
 	cPath := crioConfigFile
-	pauseImage := images.Pause(kv, imageRepository)
 
 	c := exec.Command("/bin/bash", "-c", fmt.Sprintf("sudo sed -e 's|^pause_image = .*$|pause_image = \"%s\"|' -i %s", pauseImage, cPath))
 	if _, err := cr.RunCmd(c); err != nil {
 		return errors.Wrap(err, "generateCRIOConfig.")
 	}
+
+	// crio.conf documents every key, so options replace the value of an existing line
+	for _, o := range options {
+		if _, err := cr.RunCmd(exec.Command("sudo", "grep", "-q", fmt.Sprintf("^%s = ", o.Key), cPath)); err != nil {
+			return errors.Errorf("%s is not a key of %s", o.Key, cPath)
+		}
+		c := exec.Command("sudo", "sed", "-e", fmt.Sprintf("s|^%s = .*$|%s = %s|", o.Key, o.Key, strings.Replace(o.Value, "|", `\|`, -1)), "-i", cPath)
+		if _, err := cr.RunCmd(c); err != nil {
+			return errors.Wrapf(err, "set %s", o.Key)
+		}
+	}
 	return nil
 }
 
@@ -114,12 +126,19 @@ func (r *CRIO) Enable(disOthers, _ bool) error {
 	if err := populateCRIConfig(r.Runner, r.SocketPath()); err != nil {
 		return err
 	}
-	if err := generateCRIOConfig(r.Runner, r.ImageRepository, r.KubernetesVersion); err != nil {
+	if err := generateCRIOConfig(r.Runner, pauseImage(r.PauseImage, r.KubernetesVersion, r.ImageRepository), r.Options); err != nil {
+		return err
+	}
+	if err := configureProxy(r.Runner, "crio", r.ProxyEnv); err != nil {
 		return err
 	}
 	if err := enableIPForwarding(r.Runner); err != nil {
 		return err
 	}
+	// a running crio only picks up its proxy settings once restarted
+	if r.Init.Active("crio") {
+		return r.Init.Restart("crio")
+	}
 	return r.Init.Start("crio")
 }
 
@@ -152,6 +171,16 @@ func (r *CRIO) LoadImage(path string) error {
 	return nil
 }
 
+// SaveImage saves an image from this runtime to a tarball
+func (r *CRIO) SaveImage(name string, path string) error {
+	glog.Infof("Saving image %s: %s", name, path)
+	c := exec.Command("sudo", "podman", "save", "-o", path, name)
+	if _, err := r.Runner.RunCmd(c); err != nil {
+		return errors.Wrap(err, "crio save image")
+	}
+	return nil
+}
+
 // CGroupDriver returns cgroup driver ("cgroupfs" or "systemd")
 func (r *CRIO) CGroupDriver() (string, error) {
 	c := exec.Command("crio", "config")
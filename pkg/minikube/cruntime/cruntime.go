@@ -20,11 +20,15 @@ package cruntime
 import (
 	"fmt"
 	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/blang/semver"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/images"
 	"k8s.io/minikube/pkg/minikube/command"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/out"
@@ -52,6 +56,11 @@ func ValidRuntimes() []string {
 	return []string{"docker", "cri-o", "containerd"}
 }
 
+// ValidCgroupDrivers lists the supported cgroup drivers
+func ValidCgroupDrivers() []string {
+	return []string{"cgroupfs", "systemd"}
+}
+
 // CommandRunner is the subset of command.Runner this package consumes
 type CommandRunner interface {
 	RunCmd(cmd *exec.Cmd) (*command.RunResult, error)
@@ -87,6 +96,8 @@ type Manager interface {
 
 	// Load an image idempotently into the runtime on a host
 	LoadImage(string) error
+	// SaveImage saves an image of the runtime to a tarball on the host
+	SaveImage(string, string) error
 
 	// ImageExists takes image name and image sha checks if an it exists
 	ImageExists(string, string) bool
@@ -121,6 +132,73 @@ type Config struct {
 	ImageRepository string
 	// KubernetesVersion Kubernetes version
 	KubernetesVersion semver.Version
+	// RegistryMirrors are the mirrors used when pulling from docker.io
+	RegistryMirrors []string
+	// Options are set in the configuration file of the runtime
+	Options []Option
+	// PauseImage overrides the pause (sandbox) image of the Kubernetes version
+	PauseImage string
+	// ProxyEnv are the proxy settings of the runtime, formatted as KEY=VALUE. Docker gets them from its engine options instead.
+	ProxyEnv []string
+}
+
+// Option is a setting of the configuration file of a container runtime
+type Option struct {
+	Key   string
+	Value string
+}
+
+// ParseOptions parses entries of the form runtime.key=value, such as containerd.max_concurrent_downloads=10,
+// returning the options of the given runtime. Docker is configured with --docker-opt instead.
+func ParseOptions(runtime string, entries []string) ([]Option, error) {
+	var prefixes []string
+	switch runtime {
+	case "containerd":
+		prefixes = []string{"containerd."}
+	case "crio", "cri-o":
+		prefixes = []string{"crio.", "cri-o."}
+	default:
+		return nil, fmt.Errorf("the %s runtime can not be configured per node, use --docker-opt instead", runtime)
+	}
+
+	var opts []Option
+	for _, e := range entries {
+		kv := strings.SplitN(e, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("%q is not of the form runtime.key=value", e)
+		}
+		key := ""
+		for _, p := range prefixes {
+			if strings.HasPrefix(kv[0], p) {
+				key = strings.TrimPrefix(kv[0], p)
+			}
+		}
+		if key == "" {
+			return nil, fmt.Errorf("%q does not configure the %s runtime of the cluster", e, runtime)
+		}
+		if !optionKey.MatchString(key) {
+			return nil, fmt.Errorf("%q is not a valid key of the %s configuration", key, runtime)
+		}
+		opts = append(opts, Option{Key: key, Value: tomlValue(kv[1])})
+	}
+	return opts, nil
+}
+
+// optionKey matches the keys of TOML tables, which both containerd and CRI-O are configured with
+var optionKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// tomlValue quotes values which are not already numbers, booleans, quoted strings or arrays
+func tomlValue(v string) string {
+	if _, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return v
+	}
+	if _, err := strconv.ParseFloat(v, 64); err == nil {
+		return v
+	}
+	if v == "true" || v == "false" || strings.HasPrefix(v, `"`) || strings.HasPrefix(v, "[") {
+		return v
+	}
+	return strconv.Quote(v)
 }
 
 // ListOptions are the options to use for listing containers
@@ -150,6 +228,9 @@ func New(c Config) (Manager, error) {
 			Runner:            c.Runner,
 			ImageRepository:   c.ImageRepository,
 			KubernetesVersion: c.KubernetesVersion,
+			Options:           c.Options,
+			PauseImage:        c.PauseImage,
+			ProxyEnv:          c.ProxyEnv,
 			Init:              sm,
 		}, nil
 	case "containerd":
@@ -158,6 +239,10 @@ func New(c Config) (Manager, error) {
 			Runner:            c.Runner,
 			ImageRepository:   c.ImageRepository,
 			KubernetesVersion: c.KubernetesVersion,
+			RegistryMirrors:   c.RegistryMirrors,
+			Options:           c.Options,
+			PauseImage:        c.PauseImage,
+			ProxyEnv:          c.ProxyEnv,
 			Init:              sm,
 		}, nil
 	default:
@@ -165,6 +250,14 @@ func New(c Config) (Manager, error) {
 	}
 }
 
+// pauseImage returns the pause image to configure a runtime with, the one of the Kubernetes version unless overridden
+func pauseImage(override string, kv semver.Version, imageRepository string) string {
+	if override != "" {
+		return override
+	}
+	return images.Pause(kv, imageRepository)
+}
+
 // ContainerStatusCommand works across container runtimes with good formatting
 func ContainerStatusCommand() string {
 	// Fallback to 'docker ps' if it fails (none driver)
@@ -18,7 +18,9 @@ package cruntime
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
+	"io"
 	"os/exec"
 	"strings"
 	"testing"
@@ -83,6 +85,54 @@ func TestImageExists(t *testing.T) {
 	}
 }
 
+func TestSaveImage(t *testing.T) {
+	var tests = []struct {
+		runtime string
+		want    []string
+	}{
+		{"docker", []string{"docker", "save", "-o", "/tmp/image.tar", "busybox:1.28"}},
+		{"crio", []string{"sudo", "podman", "save", "-o", "/tmp/image.tar", "busybox:1.28"}},
+		{"containerd", []string{"sudo", "ctr", "-n=k8s.io", "images", "export", "/tmp/image.tar", "docker.io/library/busybox:1.28"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.runtime, func(t *testing.T) {
+			runner := NewFakeRunner(t)
+			r, err := New(Config{Type: tc.runtime, Runner: runner})
+			if err != nil {
+				t.Fatalf("New(%s): %v", tc.runtime, err)
+			}
+
+			if err := r.SaveImage("busybox:1.28", "/tmp/image.tar"); err != nil {
+				t.Fatalf("SaveImage(%s): %v", tc.runtime, err)
+			}
+			if diff := cmp.Diff(tc.want, runner.cmds); diff != "" {
+				t.Errorf("SaveImage(%s) commands returned diff (-want +got):\n%s", tc.runtime, diff)
+			}
+		})
+	}
+}
+
+func TestNormalizedImageName(t *testing.T) {
+	var tests = []struct {
+		name string
+		want string
+	}{
+		{"busybox", "docker.io/library/busybox:latest"},
+		{"busybox:1.28", "docker.io/library/busybox:1.28"},
+		{"kubernetesui/dashboard:v2.0.0", "docker.io/kubernetesui/dashboard:v2.0.0"},
+		{"k8s.gcr.io/pause:3.2", "k8s.gcr.io/pause:3.2"},
+		{"localhost:5000/app", "localhost:5000/app:latest"},
+		{"localhost/app@sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", "localhost/app@sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := normalizedImageName(tc.name); got != tc.want {
+				t.Errorf("normalizedImageName(%q) = %q, want: %q", tc.name, got, tc.want)
+			}
+		})
+	}
+}
+
 func TestCGroupDriver(t *testing.T) {
 	var tests = []struct {
 		runtime string
@@ -225,6 +275,10 @@ func (f *FakeRunner) Remove(assets.CopyableFile) error {
 	return nil
 }
 
+func (f *FakeRunner) CopyFrom(string, io.Writer) error {
+	return nil
+}
+
 func (f *FakeRunner) dockerPs(args []string) (string, error) {
 	// ps -a --filter="name=apiserver" --format="{{.ID}}"
 	if args[1] == "-a" && strings.HasPrefix(args[2], "--filter") {
@@ -630,6 +684,29 @@ func TestEnable(t *testing.T) {
 	}
 }
 
+func TestConfigureProxy(t *testing.T) {
+	var tests = []struct {
+		name string
+		env  []string
+		want string
+	}{
+		{"no proxy", nil, "sudo rm -f /etc/systemd/system/containerd.service.d/10-proxy.conf"},
+		{"proxy", []string{"HTTP_PROXY=http://proxy:3128", "NO_PROXY=192.168.39.2,10.96.0.0/12"},
+			base64.StdEncoding.EncodeToString([]byte("[Service]\nEnvironment=\"HTTP_PROXY=http://proxy:3128\"\nEnvironment=\"NO_PROXY=192.168.39.2,10.96.0.0/12\"\n"))},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runner := NewFakeRunner(t)
+			if err := configureProxy(runner, "containerd", tc.env); err != nil {
+				t.Fatalf("configureProxy() unexpected error: %v", err)
+			}
+			if got := strings.Join(runner.cmds, " "); !strings.Contains(got, tc.want) {
+				t.Errorf("configureProxy() ran %q, want: %q", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestContainerFunctions(t *testing.T) {
 	var tests = []struct {
 		runtime string
@@ -704,3 +781,31 @@ func TestContainerFunctions(t *testing.T) {
 		})
 	}
 }
+
+func TestParseOptions(t *testing.T) {
+	var tests = []struct {
+		runtime string
+		entries []string
+		want    []Option
+		wantErr bool
+	}{
+		{"containerd", []string{"containerd.max_concurrent_downloads=10"}, []Option{{Key: "max_concurrent_downloads", Value: "10"}}, false},
+		{"containerd", []string{"containerd.enable_selinux=true", "containerd.sandbox_image=k8s.gcr.io/pause:3.2"}, []Option{{Key: "enable_selinux", Value: "true"}, {Key: "sandbox_image", Value: `"k8s.gcr.io/pause:3.2"`}}, false},
+		{"crio", []string{"cri-o.log_level=debug", `crio.pids_limit=2048`}, []Option{{Key: "log_level", Value: `"debug"`}, {Key: "pids_limit", Value: "2048"}}, false},
+		{"containerd", []string{"crio.log_level=debug"}, nil, true},
+		{"containerd", []string{"containerd.max_concurrent_downloads"}, nil, true},
+		{"containerd", []string{"containerd.plugins.cri=1"}, nil, true},
+		{"docker", []string{"docker.max-concurrent-downloads=10"}, nil, true},
+	}
+	for _, tc := range tests {
+		t.Run(strings.Join(tc.entries, ","), func(t *testing.T) {
+			got, err := ParseOptions(tc.runtime, tc.entries)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ParseOptions(%s, %v) error = %v, wantErr: %v", tc.runtime, tc.entries, err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ParseOptions(%s, %v) mismatch (-want +got):\n%s", tc.runtime, tc.entries, diff)
+			}
+		})
+	}
+}
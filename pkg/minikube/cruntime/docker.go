@@ -157,6 +157,16 @@ func (r *Docker) LoadImage(path string) error {
 
 }
 
+// SaveImage saves an image from this runtime to a tarball
+func (r *Docker) SaveImage(name string, path string) error {
+	glog.Infof("Saving image %s: %s", name, path)
+	c := exec.Command("docker", "save", "-o", path, name)
+	if _, err := r.Runner.RunCmd(c); err != nil {
+		return errors.Wrap(err, "saveimage docker.")
+	}
+	return nil
+}
+
 // CGroupDriver returns cgroup driver ("cgroupfs" or "systemd")
 func (r *Docker) CGroupDriver() (string, error) {
 	// Note: the server daemon has to be running, for this call to return successfully
@@ -21,6 +21,7 @@ import (
 	"os"
 	"path"
 	"runtime"
+	"strings"
 
 	"github.com/blang/semver"
 	"github.com/golang/glog"
@@ -28,9 +29,15 @@ import (
 	"k8s.io/minikube/pkg/minikube/localpath"
 )
 
-// binaryWithChecksumURL gets the location of a Kubernetes binary
-func binaryWithChecksumURL(binaryName, version, osName, archName string) (string, error) {
-	base := fmt.Sprintf("https://storage.googleapis.com/kubernetes-release/release/%s/bin/%s/%s/%s", version, osName, archName, binaryName)
+// DefaultBinaryMirror is where Kubernetes binaries are downloaded from by default
+const DefaultBinaryMirror = "https://storage.googleapis.com/kubernetes-release/release"
+
+// binaryWithChecksumURL gets the location of a Kubernetes binary, on a mirror laid out like the release bucket if set
+func binaryWithChecksumURL(binaryName, version, osName, archName, mirror string) (string, error) {
+	if mirror == "" {
+		mirror = DefaultBinaryMirror
+	}
+	base := fmt.Sprintf("%s/%s/bin/%s/%s/%s", strings.TrimSuffix(mirror, "/"), version, osName, archName, binaryName)
 	v, err := semver.Make(version[1:])
 	if err != nil {
 		return "", err
@@ -42,12 +49,13 @@ func binaryWithChecksumURL(binaryName, version, osName, archName string) (string
 	return fmt.Sprintf("%s?checksum=file:%s.sha1", base, base), nil
 }
 
-// Binary will download a binary onto the host
-func Binary(binary, version, osName, archName string) (string, error) {
-	targetDir := localpath.MakeMiniPath("cache", osName, version)
+// Binary will download a binary onto the host, from mirror if set.
+// Binaries are cached per architecture, as nodes added with --arch need binaries built for another one.
+func Binary(binary, version, osName, archName, mirror string) (string, error) {
+	targetDir := localpath.MakeMiniPath("cache", osName, archName, version)
 	targetFilepath := path.Join(targetDir, binary)
 
-	url, err := binaryWithChecksumURL(binary, version, osName, archName)
+	url, err := binaryWithChecksumURL(binary, version, osName, archName, mirror)
 	if err != nil {
 		return "", err
 	}
@@ -21,6 +21,7 @@ import (
 	"os"
 	"runtime"
 	"runtime/debug"
+	"strings"
 
 	"github.com/golang/glog"
 	"k8s.io/minikube/pkg/minikube/out"
@@ -41,15 +42,35 @@ const (
 	Permissions = 77 // Permissions represents a permissions error
 )
 
+// onFailure is called before exiting with an error, see SetOnFailure
+var onFailure func(code int, msg string)
+
+// SetOnFailure registers a function which is called with the exit code and message before exiting with an error,
+// so that commands can report the failure in a structured form.
+func SetOnFailure(f func(code int, msg string)) {
+	onFailure = f
+}
+
+// failed reports a failure to the function registered with SetOnFailure, if any
+func failed(code int, format string, a ...out.V) {
+	if onFailure == nil {
+		return
+	}
+	msg := out.ApplyTemplateFormatting(out.Empty, false, format, a...)
+	onFailure(code, strings.Replace(strings.TrimSpace(msg), "%%", "%", -1))
+}
+
 // UsageT outputs a templated usage error and exits with error code 64
 func UsageT(format string, a ...out.V) {
 	out.ErrT(out.Usage, format, a...)
+	failed(BadUsage, format, a...)
 	os.Exit(BadUsage)
 }
 
 // WithCodeT outputs a templated fatal error message and exits with the supplied error code.
 func WithCodeT(code int, format string, a ...out.V) {
 	out.FatalT(format, a...)
+	failed(code, format, a...)
 	os.Exit(code)
 }
 
@@ -61,6 +82,7 @@ func WithError(msg string, err error) {
 		WithProblem(msg, err, p)
 	}
 	out.DisplayError(msg, err)
+	failed(Software, "{{.msg}}: {{.error}}", out.V{"msg": msg, "error": err})
 	os.Exit(Software)
 }
 
@@ -74,5 +96,6 @@ func WithProblem(msg string, err error, p *problem.Problem) {
 		out.ErrT(out.Sad, "If the above advice does not help, please let us know: ")
 		out.ErrT(out.URL, "https://github.com/kubernetes/minikube/issues/new/choose")
 	}
+	failed(Config, "[{{.id}}] {{.msg}} {{.error}}", out.V{"msg": msg, "id": p.ID, "error": p.Err})
 	os.Exit(Config)
 }
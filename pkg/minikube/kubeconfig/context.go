@@ -62,6 +62,33 @@ func SetCurrentContext(name string, configPath ...string) error {
 	return nil
 }
 
+// CurrentContext returns the kubectl's current-context
+func CurrentContext(configPath ...string) (string, error) {
+	fPath := PathFromEnv()
+	if configPath != nil {
+		fPath = configPath[0]
+	}
+	kcfg, err := readOrNew(fPath)
+	if err != nil {
+		return "", errors.Wrap(err, "Error getting kubeconfig status")
+	}
+	return kcfg.CurrentContext, nil
+}
+
+// ContextExists returns whether the kubeconfig has a context with the given name
+func ContextExists(name string, configPath ...string) (bool, error) {
+	fPath := PathFromEnv()
+	if configPath != nil {
+		fPath = configPath[0]
+	}
+	kcfg, err := readOrNew(fPath)
+	if err != nil {
+		return false, errors.Wrap(err, "Error getting kubeconfig status")
+	}
+	_, ok := kcfg.Contexts[name]
+	return ok, nil
+}
+
 // DeleteContext deletes the specified machine's kubeconfig context
 func DeleteContext(machineName string, configPath ...string) error {
 	fPath := PathFromEnv()
@@ -147,3 +147,34 @@ func TestUnsetCurrentContextOnlyChangesIfProfileIsTheCurrentContext(t *testing.T
 		t.Errorf("Expected context name %s but got %s", contextName, cfg.CurrentContext)
 	}
 }
+
+func TestCurrentContext(t *testing.T) {
+	// See kubeconfig_test
+	fn := tempFile(t, kubeConfigWithoutHTTPS)
+	defer os.Remove(fn)
+
+	got, err := CurrentContext(fn)
+	if err != nil {
+		t.Fatalf("Error not expected but got %v", err)
+	}
+	if got != "la-croix" {
+		t.Errorf("Expected context name la-croix but got %s", got)
+	}
+
+	var tests = []struct {
+		name string
+		want bool
+	}{
+		{"la-croix", true},
+		{"minikube", false},
+	}
+	for _, tc := range tests {
+		got, err := ContextExists(tc.name, fn)
+		if err != nil {
+			t.Fatalf("Error not expected but got %v", err)
+		}
+		if got != tc.want {
+			t.Errorf("ContextExists(%q) = %v, want: %v", tc.name, got, tc.want)
+		}
+	}
+}
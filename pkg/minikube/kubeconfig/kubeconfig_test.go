@@ -204,6 +204,39 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestMarshal(t *testing.T) {
+	cfg := &Settings{
+		ClusterName:          "test-m02",
+		ClusterServerAddress: "https://192.168.39.3:8443",
+		ClientCertificate:    "/home/apiserver.crt",
+		ClientKey:            "/home/apiserver.key",
+		CertificateAuthority: "/home/ca.crt",
+		KeepContext:          true,
+	}
+
+	data, err := Marshal(cfg)
+	if err != nil {
+		t.Fatalf("Marshal() unexpected error: %v", err)
+	}
+	config, err := decode(data)
+	if err != nil {
+		t.Fatalf("decode() unexpected error: %v", err)
+	}
+	if len(config.Clusters) != 1 || len(config.Contexts) != 1 || len(config.AuthInfos) != 1 {
+		t.Errorf("Marshal() = %d clusters, %d contexts, %d users, want one of each", len(config.Clusters), len(config.Contexts), len(config.AuthInfos))
+	}
+	c, ok := config.Clusters[cfg.ClusterName]
+	if !ok {
+		t.Fatalf("Marshal() is missing cluster %q", cfg.ClusterName)
+	}
+	if c.Server != cfg.ClusterServerAddress {
+		t.Errorf("server = %q, want: %q", c.Server, cfg.ClusterServerAddress)
+	}
+	if config.CurrentContext != "" {
+		t.Errorf("current context = %q, want none as KeepContext is set", config.CurrentContext)
+	}
+}
+
 func TestVerifyEndpoint(t *testing.T) {
 
 	var tests = []struct {
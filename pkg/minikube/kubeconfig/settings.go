@@ -24,7 +24,9 @@ import (
 	"github.com/golang/glog"
 	"github.com/juju/mutex"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/tools/clientcmd/api/latest"
 	"k8s.io/minikube/pkg/util/lock"
 )
 
@@ -145,3 +147,16 @@ func Update(kcs *Settings) error {
 	}
 	return nil
 }
+
+// Marshal encodes a standalone kubeconfig holding only the minikube settings, without touching the kubeconfig on disk
+func Marshal(kcs *Settings) ([]byte, error) {
+	kcfg := api.NewConfig()
+	if err := PopulateFromSettings(kcs, kcfg); err != nil {
+		return nil, err
+	}
+	data, err := runtime.Encode(latest.Codec, kcfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "encoding kubeconfig")
+	}
+	return data, nil
+}
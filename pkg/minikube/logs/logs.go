@@ -21,6 +21,7 @@ import (
 	"bufio"
 	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"regexp"
@@ -78,6 +79,15 @@ var importantPods = []string{
 	"kube-controller-manager",
 }
 
+// Entry is the logs of a single component, used for structured output
+type Entry struct {
+	Component string
+	Node      string
+	Lines     []string
+	// Error is set if the logs of the component could not be fetched
+	Error string `json:",omitempty"`
+}
+
 // logRunner is the subset of CommandRunner used for logging
 type logRunner interface {
 	RunCmd(*exec.Cmd) (*command.RunResult, error)
@@ -153,35 +163,35 @@ func OutputProblems(problems map[string][]string, maxLines int) {
 	}
 }
 
-// Output displays logs from multiple sources in tail(1) format
-func Output(r cruntime.Manager, bs bootstrapper.Bootstrapper, cfg config.ClusterConfig, runner command.Runner, lines int) error {
-	cmds := logCommands(r, bs, cfg, lines, false)
-	cmds["kernel"] = "uptime && uname -a && grep PRETTY /etc/os-release"
-
-	names := []string{}
-	for k := range cmds {
-		names = append(names, k)
+// ProblemEntries converts discovered problems into entries sorted by component, keeping at most maxLines per component
+func ProblemEntries(problems map[string][]string, node string, maxLines int) []Entry {
+	entries := []Entry{}
+	for name, lines := range problems {
+		if len(lines) > maxLines {
+			lines = lines[len(lines)-maxLines:]
+		}
+		entries = append(entries, Entry{Component: name, Node: node, Lines: lines})
 	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Component < entries[j].Component })
+	return entries
+}
 
-	sort.Strings(names)
+// Output displays logs from multiple sources in tail(1) format
+func Output(r cruntime.Manager, bs bootstrapper.Bootstrapper, cfg config.ClusterConfig, runner command.Runner, lines int) error {
+	cmds := outputCommands(r, bs, cfg, lines)
 	failed := []string{}
-	for i, name := range names {
+	for i, name := range sortedNames(cmds) {
 		if i > 0 {
 			out.T(out.Empty, "")
 		}
 		out.T(out.Empty, "==> {{.name}} <==", out.V{"name": name})
-		var b bytes.Buffer
-		c := exec.Command("/bin/bash", "-c", cmds[name])
-		c.Stdout = &b
-		c.Stderr = &b
-		if rr, err := runner.RunCmd(c); err != nil {
-			glog.Errorf("command %s failed with error: %v output: %q", rr.Command(), err, rr.Output())
+		ls, err := readLines(runner, cmds[name])
+		if err != nil {
 			failed = append(failed, name)
 			continue
 		}
-		scanner := bufio.NewScanner(&b)
-		for scanner.Scan() {
-			out.T(out.Empty, scanner.Text())
+		for _, l := range ls {
+			out.T(out.Empty, l)
 		}
 	}
 
@@ -191,6 +201,82 @@ func Output(r cruntime.Manager, bs bootstrapper.Bootstrapper, cfg config.Cluster
 	return nil
 }
 
+// WriteEntries writes entries in the same format as Output
+func WriteEntries(w io.Writer, entries []Entry) error {
+	for i, e := range entries {
+		if i > 0 {
+			if _, err := fmt.Fprintln(w); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintf(w, "==> %s <==\n", e.Component); err != nil {
+			return err
+		}
+		for _, l := range e.Lines {
+			if _, err := fmt.Fprintln(w, l); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Entries gathers the same logs as Output, as one entry per component
+func Entries(r cruntime.Manager, bs bootstrapper.Bootstrapper, cfg config.ClusterConfig, runner command.Runner, node string, lines int) ([]Entry, error) {
+	cmds := outputCommands(r, bs, cfg, lines)
+	entries := []Entry{}
+	failed := []string{}
+	for _, name := range sortedNames(cmds) {
+		e := Entry{Component: name, Node: node}
+		ls, err := readLines(runner, cmds[name])
+		if err != nil {
+			e.Error = err.Error()
+			failed = append(failed, name)
+		}
+		e.Lines = ls
+		entries = append(entries, e)
+	}
+
+	if len(failed) > 0 {
+		return entries, fmt.Errorf("unable to fetch logs for: %s", strings.Join(failed, ", "))
+	}
+	return entries, nil
+}
+
+// outputCommands returns the commands run by Output, which include details of the kernel
+func outputCommands(r cruntime.Manager, bs bootstrapper.Bootstrapper, cfg config.ClusterConfig, lines int) map[string]string {
+	cmds := logCommands(r, bs, cfg, lines, false)
+	cmds["kernel"] = "uptime && uname -a && grep PRETTY /etc/os-release"
+	return cmds
+}
+
+func sortedNames(cmds map[string]string) []string {
+	names := []string{}
+	for k := range cmds {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// readLines runs a log command, returning its combined output line by line
+func readLines(runner command.Runner, cmd string) ([]string, error) {
+	var b bytes.Buffer
+	c := exec.Command("/bin/bash", "-c", cmd)
+	c.Stdout = &b
+	c.Stderr = &b
+	if rr, err := runner.RunCmd(c); err != nil {
+		glog.Errorf("command %s failed with error: %v output: %q", rr.Command(), err, rr.Output())
+		return nil, err
+	}
+	lines := []string{}
+	scanner := bufio.NewScanner(&b)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, nil
+}
+
 // logCommands returns a list of commands that would be run to receive the anticipated logs
 func logCommands(r cruntime.Manager, bs bootstrapper.Bootstrapper, cfg config.ClusterConfig, length int, follow bool) map[string]string {
 	cmds := bs.LogCommands(cfg, bootstrapper.LogOptions{Lines: length, Follow: follow})
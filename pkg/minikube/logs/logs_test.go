@@ -17,6 +17,8 @@ limitations under the License.
 package logs
 
 import (
+	"bytes"
+	"reflect"
 	"testing"
 )
 
@@ -59,3 +61,38 @@ func TestIsProblem(t *testing.T) {
 		})
 	}
 }
+
+func TestProblemEntries(t *testing.T) {
+	problems := map[string][]string{
+		"kubelet":        {"error: one", "error: two", "error: three"},
+		"kube-apiserver": {"unknown flag: --foo"},
+	}
+	want := []Entry{
+		{Component: "kube-apiserver", Node: "m01", Lines: []string{"unknown flag: --foo"}},
+		{Component: "kubelet", Node: "m01", Lines: []string{"error: two", "error: three"}},
+	}
+	got := ProblemEntries(problems, "m01", 2)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProblemEntries() = %+v, want: %+v", got, want)
+	}
+
+	if got := ProblemEntries(nil, "m01", 2); len(got) != 0 {
+		t.Errorf("ProblemEntries(nil) = %+v, want none", got)
+	}
+}
+
+func TestWriteEntries(t *testing.T) {
+	entries := []Entry{
+		{Component: "dmesg", Node: "m03", Lines: []string{"[Jun 1 10:00] warning"}},
+		{Component: "kubelet", Node: "m03", Lines: []string{"I0601 started", "E0601 failed"}},
+		{Component: "describe nodes", Node: "m03", Error: "exit status 1"},
+	}
+	var b bytes.Buffer
+	if err := WriteEntries(&b, entries); err != nil {
+		t.Fatalf("WriteEntries() unexpected error: %v", err)
+	}
+	want := "==> dmesg <==\n[Jun 1 10:00] warning\n\n==> kubelet <==\nI0601 started\nE0601 failed\n\n==> describe nodes <==\n"
+	if got := b.String(); got != want {
+		t.Errorf("WriteEntries() = %q, want: %q", got, want)
+	}
+}
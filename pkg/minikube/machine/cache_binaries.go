@@ -28,15 +28,15 @@ import (
 	"k8s.io/minikube/pkg/minikube/download"
 )
 
-// CacheBinariesForBootstrapper will cache binaries for a bootstrapper
-func CacheBinariesForBootstrapper(version string, clusterBootstrapper string) error {
+// CacheBinariesForBootstrapper will cache binaries for a bootstrapper, downloading them from mirror if set
+func CacheBinariesForBootstrapper(version string, clusterBootstrapper string, mirror string) error {
 	binaries := bootstrapper.GetCachedBinaryList(clusterBootstrapper)
 
 	var g errgroup.Group
 	for _, bin := range binaries {
 		bin := bin // https://golang.org/doc/faq#closures_and_goroutines
 		g.Go(func() error {
-			if _, err := download.Binary(bin, version, "linux", runtime.GOARCH); err != nil {
+			if _, err := download.Binary(bin, version, "linux", runtime.GOARCH, mirror); err != nil {
 				return errors.Wrapf(err, "caching binary %s", bin)
 			}
 			return nil
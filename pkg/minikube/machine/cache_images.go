@@ -21,6 +21,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"sync"
 	"time"
@@ -62,6 +63,11 @@ func CacheImagesForBootstrapper(imageRepository string, version string, clusterB
 	return nil
 }
 
+// ForeignArch returns whether a node runs another architecture than the host, which the image cache is not built for
+func ForeignArch(n config.Node) bool {
+	return n.Arch != "" && n.Arch != runtime.GOARCH
+}
+
 // LoadImages loads previously cached images into the container runtime
 func LoadImages(cc *config.ClusterConfig, runner command.Runner, images []string, cacheDir string) error {
 	// Skip loading images if images already exist
@@ -193,6 +199,10 @@ func CacheAndLoadImages(images []string) error {
 
 		for _, n := range c.Nodes {
 			m := driver.MachineName(*c, n)
+			if ForeignArch(n) {
+				glog.Infof("Node %s is %s, skipping loading cached images", m, n.Arch)
+				continue
+			}
 
 			status, err := Status(api, m)
 			if err != nil {
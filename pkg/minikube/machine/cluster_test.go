@@ -115,6 +115,32 @@ func TestCreateHost(t *testing.T) {
 	}
 }
 
+func TestNodeSized(t *testing.T) {
+	cc := config.ClusterConfig{CPUs: 2, Memory: 2200}
+	var tests = []struct {
+		description string
+		node        config.Node
+		cpus        int
+		memory      int
+	}{
+		{"cluster-wide", config.Node{Name: "m02"}, 2, 2200},
+		{"cpus", config.Node{Name: "m02", CPUs: 8}, 8, 2200},
+		{"memory", config.Node{Name: "m02", Memory: 16000}, 2, 16000},
+		{"both", config.Node{Name: "m02", CPUs: 4, Memory: 8000}, 4, 8000},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			got := nodeSized(cc, tc.node)
+			if got.CPUs != tc.cpus || got.Memory != tc.memory {
+				t.Errorf("nodeSized() = CPUs=%d, Memory=%d, want: CPUs=%d, Memory=%d", got.CPUs, got.Memory, tc.cpus, tc.memory)
+			}
+		})
+	}
+	if cc.CPUs != 2 || cc.Memory != 2200 {
+		t.Errorf("nodeSized() modified the cluster config: CPUs=%d, Memory=%d", cc.CPUs, cc.Memory)
+	}
+}
+
 func TestStartHostExists(t *testing.T) {
 	download.EnableMock(true)
 
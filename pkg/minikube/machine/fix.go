@@ -74,6 +74,8 @@ func fixHost(api libmachine.API, cc *config.ClusterConfig, n *config.Node) (*hos
 	if !driver.BareMetal(h.Driver.DriverName()) {
 		e := engineOptions(*cc)
 		h.HostOptions.EngineOptions.Env = e.Env
+		// keep mirrors in sync with the cluster config, which may have changed since this node was created
+		h.HostOptions.EngineOptions.RegistryMirror = e.RegistryMirror
 		err = provisionDockerMachine(h)
 		if err != nil {
 			return h, errors.Wrap(err, "provision")
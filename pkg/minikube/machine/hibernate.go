@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"os/exec"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"k8s.io/minikube/pkg/drivers/kic/oci"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+)
+
+// Hibernate suspends a machine without shutting it down. VMs are saved to disk, releasing their memory,
+// while containers are frozen in place.
+func Hibernate(cc config.ClusterConfig, machineName string) error {
+	if driver.IsKIC(cc.Driver) {
+		return oci.PauseContainer(cc.Driver, machineName)
+	}
+	args, err := suspendArgs(cc, machineName)
+	if err != nil {
+		return err
+	}
+	return runHostCmd(args)
+}
+
+// Resume brings back a machine suspended by Hibernate
+func Resume(cc config.ClusterConfig, machineName string) error {
+	if driver.IsKIC(cc.Driver) {
+		return oci.UnpauseContainer(cc.Driver, machineName)
+	}
+	args, err := resumeArgs(cc, machineName)
+	if err != nil {
+		return err
+	}
+	return runHostCmd(args)
+}
+
+// suspendArgs returns the host command which saves the state of a VM to disk and releases its memory
+func suspendArgs(cc config.ClusterConfig, machineName string) ([]string, error) {
+	switch cc.Driver {
+	case driver.KVM2:
+		return []string{"virsh", "-c", qemuURI(cc), "managedsave", machineName}, nil
+	case driver.VirtualBox:
+		return []string{driver.VBoxManagePath(), "controlvm", machineName, "savestate"}, nil
+	}
+	return nil, errors.Errorf("hibernation is not supported by the %s driver", cc.Driver)
+}
+
+// resumeArgs returns the host command which restores a VM saved by suspendArgs
+func resumeArgs(cc config.ClusterConfig, machineName string) ([]string, error) {
+	switch cc.Driver {
+	case driver.KVM2:
+		// starting a domain restores its managed save image
+		return []string{"virsh", "-c", qemuURI(cc), "start", machineName}, nil
+	case driver.VirtualBox:
+		return []string{driver.VBoxManagePath(), "startvm", machineName, "--type", "headless"}, nil
+	}
+	return nil, errors.Errorf("hibernation is not supported by the %s driver", cc.Driver)
+}
+
+func qemuURI(cc config.ClusterConfig) string {
+	if cc.KVMQemuURI != "" {
+		return cc.KVMQemuURI
+	}
+	return "qemu:///system"
+}
+
+func runHostCmd(args []string) error {
+	glog.Infof("Run: %s", strings.Join(args, " "))
+	o, err := exec.Command(args[0], args[1:]...).CombinedOutput()
+	if err != nil {
+		return errors.Wrapf(err, "%s: %s", strings.Join(args, " "), o)
+	}
+	return nil
+}
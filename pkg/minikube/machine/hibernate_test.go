@@ -0,0 +1,76 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+)
+
+func TestHibernateArgs(t *testing.T) {
+	var tests = []struct {
+		name    string
+		cc      config.ClusterConfig
+		suspend []string
+		resume  []string
+	}{
+		{
+			name:    "kvm2",
+			cc:      config.ClusterConfig{Driver: driver.KVM2},
+			suspend: []string{"virsh", "-c", "qemu:///system", "managedsave", "minikube-m03"},
+			resume:  []string{"virsh", "-c", "qemu:///system", "start", "minikube-m03"},
+		},
+		{
+			name:    "kvm2 session",
+			cc:      config.ClusterConfig{Driver: driver.KVM2, KVMQemuURI: "qemu:///session"},
+			suspend: []string{"virsh", "-c", "qemu:///session", "managedsave", "minikube-m03"},
+			resume:  []string{"virsh", "-c", "qemu:///session", "start", "minikube-m03"},
+		},
+		{
+			name:    "virtualbox",
+			cc:      config.ClusterConfig{Driver: driver.VirtualBox},
+			suspend: []string{driver.VBoxManagePath(), "controlvm", "minikube-m03", "savestate"},
+			resume:  []string{driver.VBoxManagePath(), "startvm", "minikube-m03", "--type", "headless"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := suspendArgs(tc.cc, "minikube-m03")
+			if err != nil {
+				t.Fatalf("suspendArgs() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.suspend) {
+				t.Errorf("suspendArgs() = %q, want: %q", got, tc.suspend)
+			}
+
+			got, err = resumeArgs(tc.cc, "minikube-m03")
+			if err != nil {
+				t.Fatalf("resumeArgs() unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.resume) {
+				t.Errorf("resumeArgs() = %q, want: %q", got, tc.resume)
+			}
+		})
+	}
+
+	if _, err := suspendArgs(config.ClusterConfig{Driver: driver.HyperKit}, "minikube-m03"); err == nil {
+		t.Errorf("suspendArgs() expected an error for an unsupported driver")
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package machine
 
 import (
+	"encoding/json"
+
 	"github.com/docker/machine/libmachine"
 	"github.com/docker/machine/libmachine/host"
 	"github.com/docker/machine/libmachine/state"
@@ -77,3 +79,18 @@ func LoadHost(api libmachine.API, machineName string) (*host.Host, error) {
 	}
 	return h, nil
 }
+
+// BootImage returns the ISO a VM host was created from, as stored by its driver, empty if unknown
+func BootImage(h *host.Host) string {
+	var d struct {
+		Boot2DockerURL string
+	}
+	if h == nil || len(h.RawDriver) == 0 {
+		return ""
+	}
+	if err := json.Unmarshal(h.RawDriver, &d); err != nil {
+		glog.Warningf("unable to parse the driver of %s: %v", h.Name, err)
+		return ""
+	}
+	return d.Boot2DockerURL
+}
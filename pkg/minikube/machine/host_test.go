@@ -0,0 +1,44 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"testing"
+
+	"github.com/docker/machine/libmachine/host"
+)
+
+func TestBootImage(t *testing.T) {
+	var tests = []struct {
+		description string
+		h           *host.Host
+		want        string
+	}{
+		{"no host", nil, ""},
+		{"no driver", &host.Host{Name: "minikube"}, ""},
+		{"kvm2", &host.Host{Name: "minikube", RawDriver: []byte(`{"MachineName":"minikube","Boot2DockerURL":"file:///home/user/.minikube/cache/iso/minikube-v1.10.0.iso"}`)}, "file:///home/user/.minikube/cache/iso/minikube-v1.10.0.iso"},
+		{"kic", &host.Host{Name: "minikube", RawDriver: []byte(`{"MachineName":"minikube","NodeConfig":{}}`)}, ""},
+		{"invalid", &host.Host{Name: "minikube", RawDriver: []byte(`{`)}, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			if got := BootImage(tc.h); got != tc.want {
+				t.Errorf("BootImage() = %q, want: %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/cruntime"
+)
+
+// LoadImage streams an image tarball to a node and loads it into its container runtime
+func LoadImage(cc config.ClusterConfig, runner command.Runner, src io.Reader) error {
+	r, err := cruntime.New(cruntime.Config{Type: cc.KubernetesConfig.ContainerRuntime, Runner: runner})
+	if err != nil {
+		return errors.Wrap(err, "runtime")
+	}
+
+	// the tarball is written by the shell, as the output of a command is also buffered by the runner
+	dst := path.Join(saveRoot, fmt.Sprintf("minikube-load-%d.tar", os.Getpid()))
+	c := exec.Command("sudo", "sh", "-c", "cat > "+dst)
+	c.Stdin = src
+	if _, err := runner.RunCmd(c); err != nil {
+		return errors.Wrapf(err, "transferring %s", dst)
+	}
+	defer func() {
+		if _, err := runner.RunCmd(exec.Command("sudo", "rm", "-f", dst)); err != nil {
+			glog.Warningf("unable to remove %s: %v", dst, err)
+		}
+	}()
+
+	if err := r.LoadImage(dst); err != nil {
+		return errors.Wrapf(err, "%s load %s", r.Name(), dst)
+	}
+	glog.Infof("Loaded %s", dst)
+	return nil
+}
+
+// LoadImageToNodes streams an image tarball to every runner at once, reading src a single time,
+// and returns the error of each of them. A failing node does not interrupt the others.
+func LoadImageToNodes(cc config.ClusterConfig, runners []command.Runner, src io.Reader) []error {
+	errs := make([]error, len(runners))
+	writers := make([]*io.PipeWriter, len(runners))
+	done := make(chan struct{}, len(runners))
+	for i, runner := range runners {
+		pr, pw := io.Pipe()
+		writers[i] = pw
+		go func(i int, runner command.Runner) {
+			errs[i] = LoadImage(cc, runner, pr)
+			// unblocks the copy if the node stopped reading early
+			pr.CloseWithError(errors.New("node stopped reading the image"))
+			done <- struct{}{}
+		}(i, runner)
+	}
+
+	ws := make([]io.Writer, len(writers))
+	for i, w := range writers {
+		ws[i] = w
+	}
+	f := newFanOut(ws)
+	_, err := io.Copy(f, src)
+	for _, w := range writers {
+		if err != nil {
+			w.CloseWithError(err)
+			continue
+		}
+		w.Close()
+	}
+	for range runners {
+		<-done
+	}
+
+	for i := range errs {
+		if errs[i] == nil && f.errs[i] != nil {
+			errs[i] = errors.Wrap(f.errs[i], "streaming image")
+		}
+		if errs[i] == nil && err != nil {
+			errs[i] = errors.Wrap(err, "reading image")
+		}
+	}
+	return errs
+}
+
+// fanOut writes to every one of its writers, dropping those which fail so that the others still receive everything
+type fanOut struct {
+	writers []io.Writer
+	errs    []error
+}
+
+func newFanOut(writers []io.Writer) *fanOut {
+	return &fanOut{writers: writers, errs: make([]error, len(writers))}
+}
+
+func (f *fanOut) Write(p []byte) (int, error) {
+	ok := false
+	for i, w := range f.writers {
+		if f.errs[i] != nil {
+			continue
+		}
+		if _, err := w.Write(p); err != nil {
+			f.errs[i] = err
+			continue
+		}
+		ok = true
+	}
+	if !ok {
+		return 0, fmt.Errorf("every destination failed")
+	}
+	return len(p), nil
+}
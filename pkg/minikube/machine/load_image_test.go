@@ -0,0 +1,67 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+// failingWriter accepts n writes, failing afterwards
+type failingWriter struct {
+	n int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.n == 0 {
+		return 0, fmt.Errorf("closed")
+	}
+	w.n--
+	return len(p), nil
+}
+
+func TestFanOut(t *testing.T) {
+	var a, b bytes.Buffer
+	failing := &failingWriter{n: 1}
+	f := newFanOut([]io.Writer{&a, failing, &b})
+
+	src := strings.Repeat("layer", 10)
+	for i := 0; i < len(src); i += 5 {
+		if _, err := f.Write([]byte(src[i : i+5])); err != nil {
+			t.Fatalf("Write() unexpected error: %v", err)
+		}
+	}
+	if a.String() != src || b.String() != src {
+		t.Errorf("writers got %q and %q, want: %q", a.String(), b.String(), src)
+	}
+	if f.errs[0] != nil || f.errs[2] != nil {
+		t.Errorf("unexpected errors: %v", f.errs)
+	}
+	if f.errs[1] == nil {
+		t.Errorf("expected the failing writer to be dropped")
+	}
+}
+
+func TestFanOutAllFailed(t *testing.T) {
+	f := newFanOut([]io.Writer{&failingWriter{}, &failingWriter{}})
+	if _, err := f.Write([]byte("layer")); err == nil {
+		t.Errorf("Write() expected an error once every writer failed")
+	}
+}
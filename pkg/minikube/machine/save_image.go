@@ -0,0 +1,83 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/cruntime"
+)
+
+// saveRoot is where images are saved to within the guest VM, writable by the docker user as well as root
+const saveRoot = "/tmp"
+
+// ImageNotPresentError is returned when an image is not present in the container runtime of a node
+type ImageNotPresentError struct {
+	Image string
+}
+
+func (e *ImageNotPresentError) Error() string {
+	return fmt.Sprintf("image %s is not present", e.Image)
+}
+
+// SaveImage saves an image as it exists in the container runtime of a node to the local tarball dst
+func SaveImage(cc config.ClusterConfig, runner command.Runner, img string, dst string) error {
+	r, err := cruntime.New(cruntime.Config{Type: cc.KubernetesConfig.ContainerRuntime, Runner: runner})
+	if err != nil {
+		return errors.Wrap(err, "runtime")
+	}
+	if !r.ImageExists(img, "") {
+		return &ImageNotPresentError{Image: img}
+	}
+
+	src := path.Join(saveRoot, savedImageName(img))
+	if err := r.SaveImage(img, src); err != nil {
+		return errors.Wrapf(err, "%s save %s", r.Name(), img)
+	}
+	defer func() {
+		if _, err := runner.RunCmd(exec.Command("sudo", "rm", "-f", src)); err != nil {
+			glog.Warningf("unable to remove %s: %v", src, err)
+		}
+	}()
+
+	f, err := os.Create(dst)
+	if err != nil {
+		return errors.Wrap(err, "create")
+	}
+	if err := runner.CopyFrom(src, f); err != nil {
+		f.Close()
+		if err := os.Remove(dst); err != nil {
+			glog.Warningf("unable to remove partial %s: %v", dst, err)
+		}
+		return errors.Wrapf(err, "transferring %s", src)
+	}
+	glog.Infof("Saved %s from %s to %s", img, src, dst)
+	return f.Close()
+}
+
+// savedImageName returns the name of the tarball an image is saved to within the guest VM
+func savedImageName(img string) string {
+	return "minikube-save-" + strings.NewReplacer("/", "_", ":", "_", "@", "_").Replace(img) + ".tar"
+}
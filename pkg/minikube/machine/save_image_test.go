@@ -0,0 +1,38 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import "testing"
+
+func TestSavedImageName(t *testing.T) {
+	var tests = []struct {
+		img  string
+		want string
+	}{
+		{"busybox", "minikube-save-busybox.tar"},
+		{"busybox:1.28", "minikube-save-busybox_1.28.tar"},
+		{"gcr.io/k8s-minikube/storage-provisioner:v1.8.1", "minikube-save-gcr.io_k8s-minikube_storage-provisioner_v1.8.1.tar"},
+		{"busybox@sha256:e3b0c442", "minikube-save-busybox_sha256_e3b0c442.tar"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.img, func(t *testing.T) {
+			if got := savedImageName(tc.img); got != tc.want {
+				t.Errorf("savedImageName(%s) = %s, want: %s", tc.img, got, tc.want)
+			}
+		})
+	}
+}
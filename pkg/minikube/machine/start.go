@@ -20,9 +20,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/docker/machine/libmachine"
@@ -33,6 +35,8 @@ import (
 	"github.com/juju/mutex"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/cni"
 	"k8s.io/minikube/pkg/minikube/command"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
@@ -45,6 +49,9 @@ import (
 	"k8s.io/minikube/pkg/util/lock"
 )
 
+// sysctlConf is where sysctl settings are persisted so that they are reapplied on boot
+const sysctlConf = "/etc/sysctl.d/99-minikube.conf"
+
 var (
 	// requiredDirectories are directories to create on the host during setup
 	requiredDirectories = []string{
@@ -107,6 +114,9 @@ func engineOptions(cfg config.ClusterConfig) *engine.Options {
 		}
 	}
 
+	// intra-cluster traffic must never go through the proxy, including traffic to nodes added later
+	uniqueEnvs = proxy.AddNoProxy(uniqueEnvs, noProxyEntries(cfg)...)
+
 	o := engine.Options{
 		Env:              uniqueEnvs,
 		InsecureRegistry: append([]string{constants.DefaultServiceCIDR}, cfg.InsecureRegistry...),
@@ -117,6 +127,54 @@ func engineOptions(cfg config.ClusterConfig) *engine.Options {
 	return &o
 }
 
+// ProxyEnv returns the proxy settings of the host for the container runtime of a node, formatted as KEY=VALUE,
+// with the addresses within the cluster added to NO_PROXY. It is empty without a proxy.
+func ProxyEnv(cfg config.ClusterConfig) []string {
+	var env []string
+	seen := map[string]bool{}
+	for _, k := range proxy.EnvVars {
+		v := os.Getenv(k)
+		k = strings.ToUpper(k)
+		if v == "" || seen[k] {
+			continue
+		}
+		// a proxy on the loopback of the host is not reachable from the node
+		if (k == "HTTP_PROXY" || k == "HTTPS_PROXY") && (strings.HasPrefix(v, "localhost") || strings.HasPrefix(v, "127.0")) {
+			continue
+		}
+		seen[k] = true
+		env = append(env, fmt.Sprintf("%s=%s", k, v))
+	}
+	return proxy.AddNoProxy(env, noProxyEntries(cfg)...)
+}
+
+// noProxyEntries returns the addresses within the cluster which must not be reached through a proxy
+func noProxyEntries(cfg config.ClusterConfig) []string {
+	entries := []string{constants.ControlPlaneAlias}
+	for _, n := range cfg.Nodes {
+		if n.IP != "" {
+			entries = append(entries, n.IP)
+		}
+	}
+
+	serviceCIDR := cfg.KubernetesConfig.ServiceCIDR
+	if serviceCIDR == "" {
+		serviceCIDR = constants.DefaultServiceCIDR
+	}
+	entries = append(entries, serviceCIDR)
+
+	podCIDR := cfg.KubernetesConfig.ExtraOptions.Get("pod-network-cidr", "kubeadm")
+	if podCIDR == "" {
+		cnm, err := cni.New(cfg)
+		if err != nil {
+			glog.Warningf("unable to get pod CIDR: %v", err)
+			return entries
+		}
+		podCIDR = cnm.CIDR()
+	}
+	return append(entries, podCIDR)
+}
+
 func createHost(api libmachine.API, cfg *config.ClusterConfig, n *config.Node) (*host.Host, error) {
 	glog.Infof("createHost starting for %q (driver=%q)", n.Name, cfg.Driver)
 	start := time.Now()
@@ -130,12 +188,13 @@ func createHost(api libmachine.API, cfg *config.ClusterConfig, n *config.Node) (
 			See https://minikube.sigs.k8s.io/docs/reference/drivers/vmware/ for more information.
 			To disable this message, run [minikube config set ShowDriverDeprecationNotification false]`)
 	}
-	showHostInfo(*cfg)
+	sized := nodeSized(*cfg, *n)
+	showHostInfo(sized)
 	def := registry.Driver(cfg.Driver)
 	if def.Empty() {
 		return nil, fmt.Errorf("unsupported/missing driver: %s", cfg.Driver)
 	}
-	dd, err := def.Config(*cfg, *n)
+	dd, err := def.Config(sized, *n)
 	if err != nil {
 		return nil, errors.Wrap(err, "config")
 	}
@@ -171,6 +230,17 @@ func createHost(api libmachine.API, cfg *config.ClusterConfig, n *config.Node) (
 	return h, nil
 }
 
+// nodeSized returns the cluster config with the CPUs and memory of the node, which the drivers size machines with
+func nodeSized(cfg config.ClusterConfig, n config.Node) config.ClusterConfig {
+	if n.CPUs > 0 {
+		cfg.CPUs = n.CPUs
+	}
+	if n.Memory > 0 {
+		cfg.Memory = n.Memory
+	}
+	return cfg
+}
+
 func timedCreateHost(h *host.Host, api libmachine.API, t time.Duration) error {
 	timeout := make(chan bool, 1)
 	go func() {
@@ -277,3 +347,47 @@ func AddHostAlias(c command.Runner, name string, ip net.IP) error {
 	}
 	return nil
 }
+
+// SetHostname sets the hostname of the guest, replacing the one set when provisioning, and resolves it through /etc/hosts
+func SetHostname(c command.Runner, name string) error {
+	script := fmt.Sprintf(`sudo hostname %[1]s && echo %[1]s | sudo tee /etc/hostname && { grep -v '^127.0.1.1\s' /etc/hosts; echo "127.0.1.1\t%[1]s"; } > /tmp/h.$$ && sudo cp /tmp/h.$$ /etc/hosts`, name)
+	if _, err := c.RunCmd(exec.Command("/bin/bash", "-c", script)); err != nil {
+		return errors.Wrap(err, "hostname")
+	}
+	return nil
+}
+
+// LoadKernelModules loads kernel modules within the guest, returning those which are neither loaded, built in nor loadable
+func LoadKernelModules(c command.Runner, modules []string) []string {
+	var missing []string
+	for _, m := range modules {
+		// modprobe fails within containers, which share the modules loaded by the host kernel
+		script := fmt.Sprintf("test -d /sys/module/%[1]s || grep -q '/%[1]s.ko' /lib/modules/$(uname -r)/modules.builtin || sudo modprobe %[1]s", m)
+		if _, err := c.RunCmd(exec.Command("/bin/bash", "-c", script)); err != nil {
+			glog.Warningf("kernel module %s is not available: %v", m, err)
+			missing = append(missing, m)
+		}
+	}
+	return missing
+}
+
+// ApplySysctls persists sysctl settings within the guest and applies them. The file is rewritten on every start,
+// and removed when there are no settings, so that settings dropped since the last start are not reapplied on boot.
+func ApplySysctls(c command.Runner, sysctls []string) error {
+	if len(sysctls) == 0 {
+		if _, err := c.RunCmd(exec.Command("sudo", "rm", "-f", sysctlConf)); err != nil {
+			return errors.Wrap(err, "remove")
+		}
+		return nil
+	}
+
+	conf := assets.NewMemoryAssetTarget([]byte(strings.Join(sysctls, "\n")+"\n"), sysctlConf, "0644")
+	if err := c.Copy(conf); err != nil {
+		return errors.Wrap(err, "copy")
+	}
+
+	if _, err := c.RunCmd(exec.Command("sudo", "sysctl", "-p", sysctlConf)); err != nil {
+		return errors.Wrap(err, "sysctl")
+	}
+	return nil
+}
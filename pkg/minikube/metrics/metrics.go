@@ -0,0 +1,153 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics records how long minikube commands take, for performance tracking
+package metrics
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// StartDuration is the time taken by "minikube start"
+	StartDuration = "minikube_start_duration_seconds"
+	// StopDuration is the time taken by "minikube stop"
+	StopDuration = "minikube_stop_duration_seconds"
+	// NodeProvisionDuration is the time taken to create or restart the machine of a node
+	NodeProvisionDuration = "minikube_node_provision_duration_seconds"
+	// NodeStartDuration is the time taken to start Kubernetes on a provisioned node
+	NodeStartDuration = "minikube_node_start_duration_seconds"
+	// NodeStopDuration is the time taken to stop the machine of a node
+	NodeStopDuration = "minikube_node_stop_duration_seconds"
+)
+
+var help = map[string]string{
+	StartDuration:         "Time taken by minikube start.",
+	StopDuration:          "Time taken by minikube stop.",
+	NodeProvisionDuration: "Time taken to provision the machine of a node.",
+	NodeStartDuration:     "Time taken to start Kubernetes on a node.",
+	NodeStopDuration:      "Time taken to stop the machine of a node.",
+}
+
+// Metric is a single duration measured while running a command
+type Metric struct {
+	Name    string            `json:"name"`
+	Labels  map[string]string `json:"labels"`
+	Seconds float64           `json:"seconds"`
+}
+
+var (
+	mu       sync.Mutex
+	recorded []Metric
+)
+
+// Record records a duration under name, nodes are started concurrently so this is safe to call from goroutines
+func Record(name string, d time.Duration, labels map[string]string) {
+	mu.Lock()
+	defer mu.Unlock()
+	recorded = append(recorded, Metric{Name: name, Labels: labels, Seconds: d.Seconds()})
+}
+
+// Recorded returns every metric recorded so far
+func Recorded() []Metric {
+	mu.Lock()
+	defer mu.Unlock()
+	return append([]Metric{}, recorded...)
+}
+
+// Write writes the recorded metrics to path, as JSON if path ends in .json and in the Prometheus text format otherwise
+func Write(path string) error {
+	var b bytes.Buffer
+	var err error
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = JSON(&b, Recorded())
+	} else {
+		err = Prometheus(&b, Recorded())
+	}
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path, b.Bytes(), 0644); err != nil {
+		return errors.Wrapf(err, "writing %s", path)
+	}
+	return nil
+}
+
+// JSON writes metrics as a JSON array
+func JSON(w io.Writer, ms []Metric) error {
+	if ms == nil {
+		ms = []Metric{}
+	}
+	js, err := json.MarshalIndent(ms, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", js)
+	return err
+}
+
+// Prometheus writes metrics in the Prometheus text exposition format, grouping samples by name
+func Prometheus(w io.Writer, ms []Metric) error {
+	var names []string
+	samples := map[string][]Metric{}
+	for _, m := range ms {
+		if _, ok := samples[m.Name]; !ok {
+			names = append(names, m.Name)
+		}
+		samples[m.Name] = append(samples[m.Name], m)
+	}
+
+	for _, name := range names {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s gauge\n", name, help[name], name); err != nil {
+			return err
+		}
+		for _, m := range samples[name] {
+			if _, err := fmt.Fprintf(w, "%s%s %g\n", name, labels(m.Labels), m.Seconds); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// labels formats labels sorted by key, so that the output is stable
+func labels(ls map[string]string) string {
+	if len(ls) == 0 {
+		return ""
+	}
+	var keys []string
+	for k := range ls {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var pairs []string
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", k, ls[k]))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"bytes"
+	"testing"
+)
+
+var sample = []Metric{
+	{Name: NodeProvisionDuration, Labels: map[string]string{"profile": "p1", "node": "p1"}, Seconds: 12.5},
+	{Name: NodeProvisionDuration, Labels: map[string]string{"profile": "p1", "node": "p1-m02"}, Seconds: 9},
+	{Name: StartDuration, Labels: map[string]string{"profile": "p1"}, Seconds: 42.25},
+}
+
+func TestPrometheus(t *testing.T) {
+	var tests = []struct {
+		name    string
+		metrics []Metric
+		want    string
+	}{
+		{
+			name: "none",
+			want: "",
+		},
+		{
+			name:    "grouped",
+			metrics: sample,
+			want: `# HELP minikube_node_provision_duration_seconds Time taken to provision the machine of a node.
+# TYPE minikube_node_provision_duration_seconds gauge
+minikube_node_provision_duration_seconds{node="p1",profile="p1"} 12.5
+minikube_node_provision_duration_seconds{node="p1-m02",profile="p1"} 9
+# HELP minikube_start_duration_seconds Time taken by minikube start.
+# TYPE minikube_start_duration_seconds gauge
+minikube_start_duration_seconds{profile="p1"} 42.25
+`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := Prometheus(&b, tc.metrics); err != nil {
+				t.Fatalf("Prometheus(%+v) error: %v", tc.metrics, err)
+			}
+			if got := b.String(); got != tc.want {
+				t.Errorf("Prometheus(%+v) = %q, want: %q", tc.metrics, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var tests = []struct {
+		name    string
+		metrics []Metric
+		want    string
+	}{
+		{
+			name: "none",
+			want: "[]\n",
+		},
+		{
+			name:    "start",
+			metrics: sample[2:],
+			want: `[
+  {
+    "name": "minikube_start_duration_seconds",
+    "labels": {
+      "profile": "p1"
+    },
+    "seconds": 42.25
+  }
+]
+`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var b bytes.Buffer
+			if err := JSON(&b, tc.metrics); err != nil {
+				t.Fatalf("JSON(%+v) error: %v", tc.metrics, err)
+			}
+			if got := b.String(); got != tc.want {
+				t.Errorf("JSON(%+v) = %q, want: %q", tc.metrics, got, tc.want)
+			}
+		})
+	}
+}
@@ -23,12 +23,14 @@ import (
 	"github.com/spf13/viper"
 	"k8s.io/minikube/pkg/drivers/kic/oci"
 	"k8s.io/minikube/pkg/minikube/bootstrapper/kubeadm"
+	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/out"
 )
 
-// MaybeExitWithAdvice before exiting will try to check for different error types and provide advice if we know for sure what the error is
-func MaybeExitWithAdvice(err error) {
+// MaybeExitWithAdvice before exiting will try to check for different error types and provide advice if we know for sure what the error is.
+// cc and n are the cluster and node which failed, if known.
+func MaybeExitWithAdvice(err error, cc *config.ClusterConfig, n *config.Node) {
 	if err == nil {
 		return
 	}
@@ -50,11 +52,14 @@ func MaybeExitWithAdvice(err error) {
 			out.T(out.Warning, "Please consider changing your Docker Desktop's resources.")
 			out.T(out.Documentation, "https://docs.docker.com/config/containers/resource_constraints/")
 		} else {
-			cpuCount := viper.GetInt(cpus)
-			if cpuCount == 2 {
-				out.T(out.Tip, "Please ensure your system has {{.cpu_counts}} CPU cores.", out.V{"cpu_counts": viper.GetInt(cpus)})
-			} else {
-				out.T(out.Tip, "Please ensure your {{.driver_name}} system has access to {{.cpu_counts}} CPU cores or reduce the number of the specified CPUs", out.V{"driver_name": viper.GetString("driver"), "cpu_counts": viper.GetInt(cpus)})
+			cpuCount := requestedCPUs(cc, n)
+			switch {
+			case cpuCount == 0:
+				out.T(out.Tip, "Please ensure your {{.driver_name}} system has access to the requested CPU cores or reduce the number of the specified CPUs", out.V{"driver_name": viper.GetString("driver")})
+			case cpuCount == 2:
+				out.T(out.Tip, "Please ensure your system has {{.cpu_counts}} CPU cores.", out.V{"cpu_counts": cpuCount})
+			default:
+				out.T(out.Tip, "Please ensure your {{.driver_name}} system has access to {{.cpu_counts}} CPU cores or reduce the number of the specified CPUs", out.V{"driver_name": viper.GetString("driver"), "cpu_counts": cpuCount})
 			}
 		}
 		exit.UsageT("Ensure your {{.driver_name}} system has enough CPUs. The minimum allowed is 2 CPUs.", out.V{"driver_name": viper.GetString("driver")})
@@ -72,3 +77,14 @@ func MaybeExitWithAdvice(err error) {
 	}
 
 }
+
+// requestedCPUs returns the resolved number of CPUs of a node, 0 if unknown
+func requestedCPUs(cc *config.ClusterConfig, n *config.Node) int {
+	if n != nil && n.CPUs > 0 {
+		return n.CPUs
+	}
+	if cc != nil {
+		return cc.CPUs
+	}
+	return 0
+}
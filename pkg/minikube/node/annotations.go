@@ -0,0 +1,63 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ValidateAnnotations checks that every annotation is formatted as KEY=VALUE, with a valid key
+func ValidateAnnotations(annotations []string) error {
+	for _, a := range annotations {
+		kv := strings.SplitN(a, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("invalid annotation %q, expected KEY=VALUE", a)
+		}
+		if errs := validation.IsQualifiedName(kv[0]); len(errs) > 0 {
+			return fmt.Errorf("invalid annotation key %q: %s", kv[0], strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+// annotateNode sets the given KEY=VALUE annotations on a Kubernetes node, overwriting existing values
+func annotateNode(client kubernetes.Interface, name string, annotations []string) error {
+	n, err := client.CoreV1().Nodes().Get(name, meta.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "get node %s", name)
+	}
+	if n.Annotations == nil {
+		n.Annotations = map[string]string{}
+	}
+	for _, a := range annotations {
+		kv := strings.SplitN(a, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		n.Annotations[kv[0]] = kv[1]
+	}
+	if _, err := client.CoreV1().Nodes().Update(n); err != nil {
+		return errors.Wrapf(err, "annotate node %s", name)
+	}
+	return nil
+}
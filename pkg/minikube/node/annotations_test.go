@@ -0,0 +1,66 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"reflect"
+	"testing"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestValidateAnnotations(t *testing.T) {
+	var tests = []struct {
+		annotations []string
+		shouldErr   bool
+	}{
+		{nil, false},
+		{[]string{"example.com/zone=a"}, false},
+		{[]string{"example.com/zone="}, false},
+		{[]string{"note=a=b"}, false},
+		{[]string{"example.com/zone"}, true},
+		{[]string{"=a"}, true},
+		{[]string{"example.com/zone a=b"}, true},
+	}
+	for _, tc := range tests {
+		err := ValidateAnnotations(tc.annotations)
+		if err != nil && !tc.shouldErr {
+			t.Errorf("ValidateAnnotations(%v) unexpected error: %v", tc.annotations, err)
+		}
+		if err == nil && tc.shouldErr {
+			t.Errorf("ValidateAnnotations(%v) expected error but got none", tc.annotations)
+		}
+	}
+}
+
+func TestAnnotateNode(t *testing.T) {
+	client := fake.NewSimpleClientset(&core.Node{ObjectMeta: meta.ObjectMeta{Name: "minikube-m02", Annotations: map[string]string{"example.com/zone": "b", "example.com/rack": "1"}}})
+	if err := annotateNode(client, "minikube-m02", []string{"example.com/zone=a", "note=a=b"}); err != nil {
+		t.Fatalf("annotateNode() unexpected error: %v", err)
+	}
+
+	n, err := client.CoreV1().Nodes().Get("minikube-m02", meta.GetOptions{})
+	if err != nil {
+		t.Fatalf("get node: %v", err)
+	}
+	want := map[string]string{"example.com/zone": "a", "example.com/rack": "1", "note": "a=b"}
+	if !reflect.DeepEqual(n.Annotations, want) {
+		t.Errorf("annotations = %v, want: %v", n.Annotations, want)
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"golang.org/x/sync/errgroup"
 	cmdcfg "k8s.io/minikube/cmd/minikube/cmd/config"
 	"k8s.io/minikube/pkg/drivers/kic"
+	"k8s.io/minikube/pkg/minikube/command"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
 	"k8s.io/minikube/pkg/minikube/download"
@@ -67,15 +68,15 @@ func beginCacheKubernetesImages(g *errgroup.Group, imageRepository string, k8sVe
 }
 
 // HandleDownloadOnly caches appropariate binaries and images
-func handleDownloadOnly(cacheGroup, kicGroup *errgroup.Group, k8sVersion string) {
+func handleDownloadOnly(cacheGroup, kicGroup *errgroup.Group, k8sVersion string, binaryMirror string) {
 	// If --download-only, complete the remaining downloads and exit.
 	if !viper.GetBool("download-only") {
 		return
 	}
-	if err := doCacheBinaries(k8sVersion); err != nil {
+	if err := doCacheBinaries(k8sVersion, binaryMirror); err != nil {
 		exit.WithError("Failed to cache binaries", err)
 	}
-	if _, err := CacheKubectlBinary(k8sVersion); err != nil {
+	if _, err := CacheKubectlBinary(k8sVersion, binaryMirror); err != nil {
 		exit.WithError("Failed to cache kubectl", err)
 	}
 	waitCacheRequiredImages(cacheGroup)
@@ -87,19 +88,19 @@ func handleDownloadOnly(cacheGroup, kicGroup *errgroup.Group, k8sVersion string)
 	os.Exit(0)
 }
 
-// CacheKubectlBinary caches the kubectl binary
-func CacheKubectlBinary(k8sVerison string) (string, error) {
+// CacheKubectlBinary caches the kubectl binary, downloading it from binaryMirror if set
+func CacheKubectlBinary(k8sVerison string, binaryMirror string) (string, error) {
 	binary := "kubectl"
 	if runtime.GOOS == "windows" {
 		binary = "kubectl.exe"
 	}
 
-	return download.Binary(binary, k8sVerison, runtime.GOOS, runtime.GOARCH)
+	return download.Binary(binary, k8sVerison, runtime.GOOS, runtime.GOARCH, binaryMirror)
 }
 
 // doCacheBinaries caches Kubernetes binaries in the foreground
-func doCacheBinaries(k8sVersion string) error {
-	return machine.CacheBinariesForBootstrapper(k8sVersion, viper.GetString(cmdcfg.Bootstrapper))
+func doCacheBinaries(k8sVersion string, binaryMirror string) error {
+	return machine.CacheBinariesForBootstrapper(k8sVersion, viper.GetString(cmdcfg.Bootstrapper), binaryMirror)
 }
 
 // beginDownloadKicBaseImage downloads the kic image
@@ -214,6 +215,18 @@ func CacheAndLoadImagesInConfig() error {
 	return machine.CacheAndLoadImages(images)
 }
 
+// loadPrepullImages caches the images of --prepull-images on the host and loads them into a node,
+// so that they are available to the node even if the registry later becomes unreachable.
+func loadPrepullImages(cc *config.ClusterConfig, runner command.Runner) error {
+	if len(cc.PrepullImages) == 0 {
+		return nil
+	}
+	if err := image.SaveToDir(cc.PrepullImages, constants.ImageCacheDir); err != nil {
+		return errors.Wrap(err, "caching images")
+	}
+	return machine.LoadImages(cc, runner, cc.PrepullImages, constants.ImageCacheDir)
+}
+
 func imagesInConfigFile() ([]string, error) {
 	configFile, err := config.ReadConfig(localpath.ConfigFile())
 	if err != nil {
@@ -22,6 +22,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/golang/glog"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/constants"
 	"k8s.io/minikube/pkg/minikube/cruntime"
+	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/exit"
 	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/out"
@@ -46,22 +48,30 @@ func showVersionInfo(k8sVersion string, cr cruntime.Manager) {
 	}
 }
 
-// configureMounts configures any requested filesystem mounts
-func configureMounts(wg *sync.WaitGroup) {
+// configureMounts configures any requested filesystem mounts, a mount of the node itself takes precedence over --mount
+func configureMounts(wg *sync.WaitGroup, cc config.ClusterConfig, n config.Node) {
 	wg.Add(1)
 	defer wg.Done()
 
-	if !viper.GetBool(createMount) {
-		return
+	mount := n.Mount
+	pidPath := MountPidPath(driver.MachineName(cc, n))
+	args := []string{"mount", "--node", n.Name, "--profile", cc.Name}
+	if mount == "" {
+		if !viper.GetBool(createMount) {
+			return
+		}
+		mount = viper.GetString(mountString)
+		pidPath = filepath.Join(localpath.MiniPath(), constants.MountProcessFileName)
+		args = []string{"mount"}
 	}
 
-	out.T(out.Mounting, "Creating mount {{.name}} ...", out.V{"name": viper.GetString(mountString)})
+	out.T(out.Mounting, "Creating mount {{.name}} ...", out.V{"name": mount})
 	path := os.Args[0]
 	mountDebugVal := 0
 	if glog.V(8) {
 		mountDebugVal = 1
 	}
-	mountCmd := exec.Command(path, "mount", fmt.Sprintf("--v=%d", mountDebugVal), viper.GetString(mountString))
+	mountCmd := exec.Command(path, append(args, fmt.Sprintf("--v=%d", mountDebugVal), mount)...)
 	mountCmd.Env = append(os.Environ(), constants.IsMinikubeChildProcess+"=true")
 	if glog.V(8) {
 		mountCmd.Stdout = os.Stdout
@@ -70,7 +80,24 @@ func configureMounts(wg *sync.WaitGroup) {
 	if err := mountCmd.Start(); err != nil {
 		exit.WithError("Error starting mount", err)
 	}
-	if err := lock.WriteFile(filepath.Join(localpath.MiniPath(), constants.MountProcessFileName), []byte(strconv.Itoa(mountCmd.Process.Pid)), 0644); err != nil {
+	if err := lock.WriteFile(pidPath, []byte(strconv.Itoa(mountCmd.Process.Pid)), 0644); err != nil {
 		exit.WithError("Error writing mount pid", err)
 	}
 }
+
+// MountPidPath returns the path of the file holding the pid of the mount process of a node, set with 'minikube node add --mount'
+func MountPidPath(machineName string) string {
+	return filepath.Join(localpath.MiniPath(), fmt.Sprintf("%s-%s", constants.MountProcessFileName, machineName))
+}
+
+// ValidateMount checks that a mount is formatted as <source directory>:<target directory>, with an absolute target
+func ValidateMount(mount string) error {
+	idx := strings.LastIndex(mount, ":")
+	if idx == -1 {
+		return fmt.Errorf("mount %q must be in form: <source directory>:<target directory>", mount)
+	}
+	if !strings.HasPrefix(mount[idx+1:], "/") {
+		return fmt.Errorf("target directory %q must be an absolute path", mount[idx+1:])
+	}
+	return nil
+}
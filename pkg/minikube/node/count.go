@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+// AddResult is the outcome of adding one of several nodes
+type AddResult struct {
+	Node config.Node
+	Err  error
+}
+
+// addNode adds a single node, replaced in tests
+var addNode = AddWithOptions
+
+// AddConcurrently adds several nodes in parallel, reporting the outcome of each.
+// Every add works on its own copy of the cluster config so that they do not race, the nodes are saved beforehand
+// so that an interrupted add leaves them in the config, and their final state is merged back into cc and saved.
+// The adds share the viper settings of the command, which are only read.
+func AddConcurrently(cc *config.ClusterConfig, ns []config.Node, delOnFail bool, opts AddOptions) ([]AddResult, error) {
+	for i := range ns {
+		if err := config.SaveNode(cc, &ns[i]); err != nil {
+			return nil, errors.Wrapf(err, "save node %s", ns[i].Name)
+		}
+	}
+
+	results := make([]AddResult, len(ns))
+	var wg sync.WaitGroup
+	for i, n := range ns {
+		c := *cc
+		c.Nodes = append([]config.Node{}, cc.Nodes...)
+		wg.Add(1)
+		go func(i int, c config.ClusterConfig, n config.Node) {
+			defer wg.Done()
+			err := addNode(&c, n, delOnFail, opts)
+			if err == nil {
+				if added, _, rerr := Retrieve(c, n.Name); rerr == nil {
+					n = *added
+				}
+			}
+			results[i] = AddResult{Node: n, Err: err}
+		}(i, c, n)
+	}
+	wg.Wait()
+
+	// each add saved its own copy of the config, so the copy saved last lacks what the others changed
+	mergeAdded(cc, results)
+	if err := config.SaveProfile(cc.Name, cc); err != nil {
+		return results, errors.Wrap(err, "save config")
+	}
+	return results, nil
+}
+
+// mergeAdded updates the config of the nodes which were added successfully, such as with their IP
+func mergeAdded(cc *config.ClusterConfig, results []AddResult) {
+	for _, r := range results {
+		if r.Err != nil {
+			continue
+		}
+		for i := range cc.Nodes {
+			if cc.Nodes[i].Name == r.Node.Name {
+				cc.Nodes[i] = r.Node
+			}
+		}
+	}
+}
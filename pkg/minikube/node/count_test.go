@@ -0,0 +1,103 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/viper"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/tests"
+)
+
+func TestMergeAdded(t *testing.T) {
+	cc := config.ClusterConfig{
+		Nodes: []config.Node{
+			{Name: "", IP: "192.168.39.2", ControlPlane: true},
+			{Name: "m02", Worker: true},
+			{Name: "m03", Worker: true},
+		},
+	}
+	results := []AddResult{
+		{Node: config.Node{Name: "m02", IP: "192.168.39.3", Worker: true}},
+		{Node: config.Node{Name: "m03", IP: "192.168.39.4", Worker: true}, Err: errors.New("join failed")},
+	}
+
+	mergeAdded(&cc, results)
+
+	want := []config.Node{
+		{Name: "", IP: "192.168.39.2", ControlPlane: true},
+		{Name: "m02", IP: "192.168.39.3", Worker: true},
+		{Name: "m03", Worker: true},
+	}
+	if !reflect.DeepEqual(cc.Nodes, want) {
+		t.Errorf("mergeAdded() nodes = %+v, want: %+v", cc.Nodes, want)
+	}
+}
+
+func TestAddConcurrently(t *testing.T) {
+	tempDir := tests.MakeTempDir()
+	defer os.RemoveAll(tempDir)
+	viper.Set(config.ProfileName, "concurrent")
+	defer viper.Set(config.ProfileName, "")
+
+	orig := addNode
+	defer func() { addNode = orig }()
+	// like a real add, each node saves its IP from its own copy of the config
+	addNode = func(cc *config.ClusterConfig, n config.Node, delOnFail bool, opts AddOptions) error {
+		if n.Name == "m04" {
+			return errors.New("join failed")
+		}
+		n.IP = fmt.Sprintf("192.168.39.1%s", n.Name[1:])
+		return config.SaveNode(cc, &n)
+	}
+
+	cc := config.ClusterConfig{
+		Name:  "concurrent",
+		Nodes: []config.Node{{Name: "", IP: "192.168.39.2", ControlPlane: true, Worker: true}},
+	}
+	ns := []config.Node{{Name: "m02", Worker: true}, {Name: "m03", Worker: true}, {Name: "m04", Worker: true}}
+
+	results, err := AddConcurrently(&cc, ns, false, AddOptions{})
+	if err != nil {
+		t.Fatalf("AddConcurrently() unexpected error: %v", err)
+	}
+	for _, r := range results {
+		if (r.Err != nil) != (r.Node.Name == "m04") {
+			t.Errorf("AddConcurrently() result of %s: %v", r.Node.Name, r.Err)
+		}
+	}
+
+	saved, err := config.Load("concurrent")
+	if err != nil {
+		t.Fatalf("Load() unexpected error: %v", err)
+	}
+	want := []config.Node{
+		{Name: "", IP: "192.168.39.2", ControlPlane: true, Worker: true},
+		{Name: "m02", IP: "192.168.39.102", Worker: true},
+		{Name: "m03", IP: "192.168.39.103", Worker: true},
+		{Name: "m04", Worker: true},
+	}
+	if !reflect.DeepEqual(saved.Nodes, want) {
+		t.Errorf("saved nodes = %+v, want: %+v", saved.Nodes, want)
+	}
+}
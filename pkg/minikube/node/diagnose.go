@@ -0,0 +1,126 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/docker/machine/libmachine"
+	"github.com/pkg/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/machine"
+)
+
+// NotReadyError is returned when a joined node or its system pods did not become Ready in time
+type NotReadyError struct {
+	Err error
+}
+
+func (e *NotReadyError) Error() string {
+	return e.Err.Error()
+}
+
+// Conditions describes the conditions of a Kubernetes node, one per line
+func Conditions(client kubernetes.Interface, name string) (string, error) {
+	n, err := client.CoreV1().Nodes().Get(name, meta.GetOptions{})
+	if err != nil {
+		return "", errors.Wrapf(err, "get node %s", name)
+	}
+
+	var b strings.Builder
+	for _, c := range n.Status.Conditions {
+		fmt.Fprintf(&b, "%s=%s", c.Type, c.Status)
+		if c.Reason != "" {
+			fmt.Fprintf(&b, " (%s)", c.Reason)
+		}
+		if c.Message != "" {
+			fmt.Fprintf(&b, ": %s", c.Message)
+		}
+		b.WriteString("\n")
+	}
+	for _, t := range n.Spec.Taints {
+		fmt.Fprintf(&b, "Taint: %s\n", t.ToString())
+	}
+	return b.String(), nil
+}
+
+// KubeletLogs returns the last lines of the kubelet logs of a node
+func KubeletLogs(api libmachine.API, cc config.ClusterConfig, n config.Node, lines int) (string, error) {
+	h, err := machine.LoadHost(api, driver.MachineName(cc, n))
+	if err != nil {
+		return "", errors.Wrap(err, "load host")
+	}
+	r, err := machine.CommandRunner(h)
+	if err != nil {
+		return "", errors.Wrap(err, "command runner")
+	}
+	return JournalLogs(r, JournalFilter{Units: []string{"kubelet"}, Lines: lines})
+}
+
+// JournalFilter selects the journald entries of a node
+type JournalFilter struct {
+	Units []string
+	// Since and Until are either durations before now, such as 10m, or timestamps understood by journalctl
+	Since string
+	Until string
+	// Lines is the number of most recent entries to return, 0 meaning all of them
+	Lines int
+}
+
+// JournalArgs returns the journalctl arguments applying a filter
+func JournalArgs(f JournalFilter) []string {
+	args := []string{"journalctl", "--no-pager"}
+	for _, u := range f.Units {
+		args = append(args, "-u", u)
+	}
+	if f.Since != "" {
+		args = append(args, "--since="+journalTime(f.Since))
+	}
+	if f.Until != "" {
+		args = append(args, "--until="+journalTime(f.Until))
+	}
+	if f.Lines > 0 {
+		args = append(args, "-n", fmt.Sprint(f.Lines))
+	}
+	return args
+}
+
+// journalTime turns a duration such as 10m into the time that long ago, passing timestamps through unchanged
+func journalTime(t string) string {
+	d, err := time.ParseDuration(t)
+	if err != nil {
+		return t
+	}
+	return fmt.Sprintf("-%ds", int(d.Seconds()))
+}
+
+// JournalLogs returns the journald entries of a node selected by a filter
+func JournalLogs(r command.Runner, f JournalFilter) (string, error) {
+	rr, err := r.RunCmd(exec.Command("sudo", JournalArgs(f)...))
+	if err != nil {
+		return "", errors.Wrap(err, "journalctl")
+	}
+	return rr.Stdout.String(), nil
+}
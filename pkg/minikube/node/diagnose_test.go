@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"reflect"
+	"testing"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestConditions(t *testing.T) {
+	client := fake.NewSimpleClientset(&core.Node{
+		ObjectMeta: meta.ObjectMeta{Name: "minikube-m02"},
+		Spec:       core.NodeSpec{Taints: []core.Taint{{Key: "node.kubernetes.io/not-ready", Effect: core.TaintEffectNoSchedule}}},
+		Status: core.NodeStatus{Conditions: []core.NodeCondition{
+			{Type: core.NodeMemoryPressure, Status: core.ConditionFalse},
+			{Type: core.NodeReady, Status: core.ConditionFalse, Reason: "KubeletNotReady", Message: "runtime network not ready"},
+		}},
+	})
+
+	got, err := Conditions(client, "minikube-m02")
+	if err != nil {
+		t.Fatalf("Conditions() unexpected error: %v", err)
+	}
+	want := "MemoryPressure=False\nReady=False (KubeletNotReady): runtime network not ready\nTaint: node.kubernetes.io/not-ready:NoSchedule\n"
+	if got != want {
+		t.Errorf("Conditions() = %q, want: %q", got, want)
+	}
+
+	if _, err := Conditions(client, "minikube-m03"); err == nil {
+		t.Errorf("Conditions() of a missing node expected error but got none")
+	}
+}
+
+func TestJournalArgs(t *testing.T) {
+	var tests = []struct {
+		name   string
+		filter JournalFilter
+		want   []string
+	}{
+		{"unfiltered", JournalFilter{}, []string{"journalctl", "--no-pager"}},
+		{"kubelet tail", JournalFilter{Units: []string{"kubelet"}, Lines: 50}, []string{"journalctl", "--no-pager", "-u", "kubelet", "-n", "50"}},
+		{"duration", JournalFilter{Units: []string{"kubelet", "docker"}, Since: "10m", Until: "90s"}, []string{"journalctl", "--no-pager", "-u", "kubelet", "-u", "docker", "--since=-600s", "--until=-90s"}},
+		{"timestamp", JournalFilter{Since: "2020-06-01 10:00:00"}, []string{"journalctl", "--no-pager", "--since=2020-06-01 10:00:00"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := JournalArgs(tc.filter)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("JournalArgs() = %v, want: %v", got, tc.want)
+			}
+		})
+	}
+}
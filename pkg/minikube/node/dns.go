@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+// corefileKey is the key of the Corefile in the coredns ConfigMap
+const corefileKey = "Corefile"
+
+// RegisterDNS makes the hostname of a node resolve to ip within the cluster, through the hosts plugin of CoreDNS.
+// CoreDNS reloads the Corefile on its own once the ConfigMap changes.
+func RegisterDNS(client kubernetes.Interface, name string, ip string) error {
+	return updateCorefile(client, func(corefile string) (string, error) {
+		return addHostsEntry(corefile, name, ip)
+	})
+}
+
+// UnregisterDNS removes the hostname of a node registered with RegisterDNS
+func UnregisterDNS(client kubernetes.Interface, name string) error {
+	return updateCorefile(client, func(corefile string) (string, error) {
+		return removeHostsEntry(corefile, name), nil
+	})
+}
+
+// updateCorefile rewrites the Corefile of the coredns ConfigMap with fn,
+// starting over when nodes joining at the same time updated it first
+func updateCorefile(client kubernetes.Interface, fn func(string) (string, error)) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := client.CoreV1().ConfigMaps("kube-system").Get("coredns", meta.GetOptions{})
+		if err != nil {
+			return errors.Wrap(err, "get coredns configmap")
+		}
+		corefile, err := fn(cm.Data[corefileKey])
+		if err != nil {
+			return err
+		}
+		if corefile == cm.Data[corefileKey] {
+			return nil
+		}
+		glog.Infof("updating Corefile:\n%s", corefile)
+		cm.Data[corefileKey] = corefile
+		// the conflict is returned unwrapped, so that it is retried
+		_, err = client.CoreV1().ConfigMaps("kube-system").Update(cm)
+		return err
+	})
+}
+
+// addHostsEntry maps name to ip in the hosts block of the root server block of a Corefile, adding the block if needed
+func addHostsEntry(corefile string, name string, ip string) (string, error) {
+	lines := strings.Split(removeHostsEntry(corefile, name), "\n")
+	entry := fmt.Sprintf("%s %s", ip, name)
+
+	if start, _ := hostsBlock(lines); start >= 0 {
+		indent := lines[start][:len(lines[start])-len(strings.TrimLeft(lines[start], " \t"))]
+		res := append([]string{}, lines[:start+1]...)
+		res = append(res, indent+"   "+entry)
+		return strings.Join(append(res, lines[start+1:]...), "\n"), nil
+	}
+
+	for i, l := range lines {
+		if strings.HasPrefix(strings.TrimSpace(l), ".:") && strings.HasSuffix(strings.TrimSpace(l), "{") {
+			res := append([]string{}, lines[:i+1]...)
+			res = append(res, "    hosts {", "       "+entry, "       fallthrough", "    }")
+			return strings.Join(append(res, lines[i+1:]...), "\n"), nil
+		}
+	}
+	return "", fmt.Errorf("no root server block in Corefile:\n%s", corefile)
+}
+
+// removeHostsEntry removes the entries for name from the hosts block of a Corefile,
+// and the block itself once it is left with no entries
+func removeHostsEntry(corefile string, name string) string {
+	lines := strings.Split(corefile, "\n")
+	start, end := hostsBlock(lines)
+	if start < 0 {
+		return corefile
+	}
+	matches := func(l string) bool {
+		fields := strings.Fields(l)
+		return len(fields) == 2 && fields[1] == name
+	}
+
+	// a block reading hosts from a file is kept, even without entries of its own
+	empty := strings.TrimSpace(lines[start]) == "hosts {"
+	for _, l := range lines[start+1 : end] {
+		if fields := strings.Fields(l); len(fields) > 0 && fields[0] != "fallthrough" && !matches(l) {
+			empty = false
+		}
+	}
+
+	var res []string
+	for i, l := range lines {
+		if empty && i >= start && i <= end {
+			continue
+		}
+		if i > start && i < end && matches(l) {
+			continue
+		}
+		res = append(res, l)
+	}
+	return strings.Join(res, "\n")
+}
+
+// hostsBlock returns the line of the hosts plugin of a Corefile and the line closing its block, or -1 if there is none
+func hostsBlock(lines []string) (int, int) {
+	for i, l := range lines {
+		l = strings.TrimSpace(l)
+		if !strings.HasPrefix(l, "hosts") || !strings.HasSuffix(l, "{") {
+			continue
+		}
+		for j := i + 1; j < len(lines); j++ {
+			if strings.TrimSpace(lines[j]) == "}" {
+				return i, j
+			}
+		}
+	}
+	return -1, -1
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"testing"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testCorefile = `.:53 {
+    errors
+    health {
+       lameduck 5s
+    }
+    ready
+    kubernetes cluster.local in-addr.arpa ip6.arpa {
+       pods insecure
+       fallthrough in-addr.arpa ip6.arpa
+       ttl 30
+    }
+    forward . /etc/resolv.conf
+    cache 30
+    reload
+}
+`
+
+func TestAddHostsEntry(t *testing.T) {
+	got, err := addHostsEntry(testCorefile, "minikube-m02", "192.168.39.3")
+	if err != nil {
+		t.Fatalf("addHostsEntry() unexpected error: %v", err)
+	}
+	want := ".:53 {\n    hosts {\n       192.168.39.3 minikube-m02\n       fallthrough\n    }\n    errors\n"
+	if got[:len(want)] != want {
+		t.Errorf("addHostsEntry() = %q, want it to start with %q", got, want)
+	}
+
+	// a second node joins the same block, and a new IP replaces the old one
+	got, err = addHostsEntry(got, "minikube-m03", "192.168.39.4")
+	if err != nil {
+		t.Fatalf("addHostsEntry() unexpected error: %v", err)
+	}
+	got, err = addHostsEntry(got, "minikube-m02", "192.168.39.5")
+	if err != nil {
+		t.Fatalf("addHostsEntry() unexpected error: %v", err)
+	}
+	want = ".:53 {\n    hosts {\n       192.168.39.5 minikube-m02\n       192.168.39.4 minikube-m03\n       fallthrough\n    }\n    errors\n"
+	if got[:len(want)] != want {
+		t.Errorf("addHostsEntry() = %q, want it to start with %q", got, want)
+	}
+
+	got = removeHostsEntry(got, "minikube-m02")
+	want = ".:53 {\n    hosts {\n       192.168.39.4 minikube-m03\n       fallthrough\n    }\n    errors\n"
+	if got[:len(want)] != want {
+		t.Errorf("removeHostsEntry() = %q, want it to start with %q", got, want)
+	}
+
+	if got = removeHostsEntry(got, "minikube-m03"); got != testCorefile {
+		t.Errorf("removeHostsEntry() of the last entry = %q, want: %q", got, testCorefile)
+	}
+
+	if _, err := addHostsEntry("example.org {\n    whoami\n}\n", "minikube-m02", "192.168.39.3"); err == nil {
+		t.Errorf("addHostsEntry() without a root server block expected error but got none")
+	}
+}
+
+func TestRemoveHostsEntryWithoutBlock(t *testing.T) {
+	if got := removeHostsEntry(testCorefile, "minikube-m02"); got != testCorefile {
+		t.Errorf("removeHostsEntry() = %q, want the Corefile unchanged", got)
+	}
+}
+
+func TestRemoveHostsEntryKeepsFileBlock(t *testing.T) {
+	corefile := ".:53 {\n    hosts /etc/coredns/hosts {\n       192.168.39.3 minikube-m02\n       fallthrough\n    }\n    errors\n}\n"
+	want := ".:53 {\n    hosts /etc/coredns/hosts {\n       fallthrough\n    }\n    errors\n}\n"
+	if got := removeHostsEntry(corefile, "minikube-m02"); got != want {
+		t.Errorf("removeHostsEntry() = %q, want: %q", got, want)
+	}
+}
+
+func TestRegisterDNS(t *testing.T) {
+	client := fake.NewSimpleClientset(&core.ConfigMap{
+		ObjectMeta: meta.ObjectMeta{Name: "coredns", Namespace: "kube-system"},
+		Data:       map[string]string{corefileKey: testCorefile},
+	})
+	if err := RegisterDNS(client, "minikube-m02", "192.168.39.3"); err != nil {
+		t.Fatalf("RegisterDNS() unexpected error: %v", err)
+	}
+	if err := UnregisterDNS(client, "minikube-m02"); err != nil {
+		t.Fatalf("UnregisterDNS() unexpected error: %v", err)
+	}
+	cm, err := client.CoreV1().ConfigMaps("kube-system").Get("coredns", meta.GetOptions{})
+	if err != nil {
+		t.Fatalf("get configmap: %v", err)
+	}
+	if got := cm.Data[corefileKey]; got != testCorefile {
+		t.Errorf("Corefile = %q, want the empty hosts block removed: %q", got, testCorefile)
+	}
+}
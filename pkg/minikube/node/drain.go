@@ -0,0 +1,187 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1beta1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+// drainInterval is how often evictions are retried and pod removal is checked
+const drainInterval = 2 * time.Second
+
+// Cordon marks a Kubernetes node as unschedulable
+func Cordon(client kubernetes.Interface, name string) error {
+	n, err := client.CoreV1().Nodes().Get(name, meta.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "get node %s", name)
+	}
+	if n.Spec.Unschedulable {
+		return nil
+	}
+	n.Spec.Unschedulable = true
+	if _, err := client.CoreV1().Nodes().Update(n); err != nil {
+		return errors.Wrapf(err, "cordon node %s", name)
+	}
+	return nil
+}
+
+// DrainOptions selects the pods evicted by a drain, mirroring the flags of 'kubectl drain'
+type DrainOptions struct {
+	// DeleteEmptyDir evicts pods using emptyDir volumes, whose data is lost, rather than leaving them on the node
+	DeleteEmptyDir bool
+	// Force also evicts pods not managed by a controller, which are not recreated anywhere
+	Force bool
+}
+
+// DrainWithOptions evicts every evictable pod from a Kubernetes node, respecting pod disruption budgets.
+// Like 'kubectl drain', DaemonSet pods are left alone, as well as pods using emptyDir volumes or not managed by a controller unless opts say otherwise.
+// It returns the pods which are still on the node once the grace period has passed, along with those left on it because of opts.
+func DrainWithOptions(client kubernetes.Interface, name string, grace time.Duration, opts DrainOptions) ([]string, error) {
+	pods, skipped, err := drainablePods(client, name, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	seconds := int64(grace.Seconds())
+	pending := map[string]core.Pod{}
+	for _, p := range pods {
+		pending[p.Namespace+"/"+p.Name] = p
+	}
+
+	// evictions refused because of a disruption budget are retried until the grace period runs out
+	err = wait.PollImmediate(drainInterval, grace, func() (bool, error) {
+		for key, p := range pending {
+			err := client.CoreV1().Pods(p.Namespace).Evict(&policy.Eviction{
+				ObjectMeta:    meta.ObjectMeta{Name: p.Name, Namespace: p.Namespace},
+				DeleteOptions: &meta.DeleteOptions{GracePeriodSeconds: &seconds},
+			})
+			switch {
+			case err == nil, apierr.IsNotFound(err):
+				glog.Infof("evicted %s", key)
+				delete(pending, key)
+			case apierr.IsTooManyRequests(err):
+				glog.Infof("eviction of %s blocked by a disruption budget, will retry", key)
+			default:
+				return false, errors.Wrapf(err, "evict %s", key)
+			}
+		}
+		return len(pending) == 0, nil
+	})
+	if err != nil && err != wait.ErrWaitTimeout {
+		return nil, err
+	}
+
+	stuck := append([]string{}, skipped...)
+	for key := range pending {
+		stuck = append(stuck, key)
+	}
+	if len(pending) > 0 {
+		return stuck, nil
+	}
+
+	// wait for the evicted pods to actually terminate, pods recreated in their place get new names
+	evicted := map[string]bool{}
+	for _, p := range pods {
+		evicted[p.Namespace+"/"+p.Name] = true
+	}
+	err = wait.PollImmediate(drainInterval, grace, func() (bool, error) {
+		remaining, _, err := drainablePods(client, name, opts)
+		if err != nil {
+			glog.Infof("unable to list pods on %s, will retry: %v", name, err)
+			return false, nil
+		}
+		stuck = append([]string{}, skipped...)
+		terminating := false
+		for _, p := range remaining {
+			if key := p.Namespace + "/" + p.Name; evicted[key] {
+				stuck = append(stuck, key)
+				terminating = true
+			}
+		}
+		return !terminating, nil
+	})
+	if err != nil && err != wait.ErrWaitTimeout {
+		return nil, err
+	}
+	return stuck, nil
+}
+
+// drainablePods returns the pods on a node which have to be evicted, skipping mirror pods and DaemonSet pods, which their
+// controller would recreate on the node. It also returns the pods left on the node because of opts.
+func drainablePods(client kubernetes.Interface, name string, opts DrainOptions) ([]core.Pod, []string, error) {
+	selector := fields.OneTermEqualSelector("spec.nodeName", name).String()
+	pl, err := client.CoreV1().Pods("").List(meta.ListOptions{FieldSelector: selector})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "list pods on %s", name)
+	}
+
+	var pods []core.Pod
+	var skipped []string
+	for _, p := range pl.Items {
+		// not every client honours field selectors
+		if p.Spec.NodeName != name {
+			continue
+		}
+		if _, mirror := p.Annotations[core.MirrorPodAnnotationKey]; mirror {
+			continue
+		}
+		if p.Status.Phase == core.PodSucceeded || p.Status.Phase == core.PodFailed {
+			continue
+		}
+		if ownedByDaemonSet(p) {
+			continue
+		}
+		if meta.GetControllerOf(&p) == nil && !opts.Force {
+			skipped = append(skipped, p.Namespace+"/"+p.Name)
+			continue
+		}
+		if usesEmptyDir(p) && !opts.DeleteEmptyDir {
+			skipped = append(skipped, p.Namespace+"/"+p.Name)
+			continue
+		}
+		pods = append(pods, p)
+	}
+	return pods, skipped, nil
+}
+
+func usesEmptyDir(p core.Pod) bool {
+	for _, v := range p.Spec.Volumes {
+		if v.EmptyDir != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func ownedByDaemonSet(p core.Pod) bool {
+	for _, o := range p.OwnerReferences {
+		if o.Kind == "DaemonSet" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCordon(t *testing.T) {
+	client := fake.NewSimpleClientset(&core.Node{ObjectMeta: meta.ObjectMeta{Name: "minikube-m02"}})
+	if err := Cordon(client, "minikube-m02"); err != nil {
+		t.Fatalf("Cordon() unexpected error: %v", err)
+	}
+
+	n, err := client.CoreV1().Nodes().Get("minikube-m02", meta.GetOptions{})
+	if err != nil {
+		t.Fatalf("get node: %v", err)
+	}
+	if !n.Spec.Unschedulable {
+		t.Errorf("node was not cordoned")
+	}
+
+	if err := Cordon(client, "minikube-m03"); err == nil {
+		t.Errorf("Cordon() of a missing node expected error but got none")
+	}
+}
+
+func TestDrainablePods(t *testing.T) {
+	onNode := func(name string) *core.Pod {
+		return &core.Pod{
+			ObjectMeta: meta.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       core.PodSpec{NodeName: "minikube-m02"},
+			Status:     core.PodStatus{Phase: core.PodRunning},
+		}
+	}
+
+	controller := true
+	managed := func(name string) *core.Pod {
+		p := onNode(name)
+		p.OwnerReferences = []meta.OwnerReference{{Kind: "ReplicaSet", Name: name, Controller: &controller}}
+		return p
+	}
+
+	app := managed("app")
+	bare := onNode("bare")
+	other := onNode("other")
+	other.Spec.NodeName = "minikube"
+	mirror := onNode("static")
+	mirror.Annotations = map[string]string{core.MirrorPodAnnotationKey: "hash"}
+	ds := onNode("kindnet")
+	ds.OwnerReferences = []meta.OwnerReference{{Kind: "DaemonSet", Name: "kindnet", Controller: &controller}}
+	done := onNode("job")
+	done.Status.Phase = core.PodSucceeded
+
+	scratch := managed("scratch")
+	scratch.Spec.Volumes = []core.Volume{{Name: "tmp", VolumeSource: core.VolumeSource{EmptyDir: &core.EmptyDirVolumeSource{}}}}
+
+	var tests = []struct {
+		name        string
+		opts        DrainOptions
+		want        []string
+		wantSkipped []string
+	}{
+		{"default", DrainOptions{}, []string{"app"}, []string{"default/bare", "default/scratch"}},
+		{"delete emptydir", DrainOptions{DeleteEmptyDir: true}, []string{"app", "scratch"}, []string{"default/bare"}},
+		{"force", DrainOptions{Force: true}, []string{"app", "bare"}, []string{"default/scratch"}},
+		{"force and delete emptydir", DrainOptions{DeleteEmptyDir: true, Force: true}, []string{"app", "bare", "scratch"}, nil},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(app, bare, other, mirror, ds, done, scratch)
+			pods, skipped, err := drainablePods(client, "minikube-m02", tc.opts)
+			if err != nil {
+				t.Fatalf("drainablePods() unexpected error: %v", err)
+			}
+
+			var got []string
+			for _, p := range pods {
+				got = append(got, p.Name)
+			}
+			sort.Strings(got)
+			sort.Strings(skipped)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("drainablePods() = %v, want: %v", got, tc.want)
+			}
+			if !reflect.DeepEqual(skipped, tc.wantSkipped) {
+				t.Errorf("drainablePods() skipped = %v, want: %v", skipped, tc.wantSkipped)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"github.com/pkg/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/minikube/pkg/addons"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/constants"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+// gpuAddon advertises the GPUs of a node to the scheduler
+const gpuAddon = "nvidia-gpu-device-plugin"
+
+// HasGPU returns whether GPUs are passed through to the node
+func HasGPU(cc config.ClusterConfig, n config.Node) bool {
+	return n.GPUs != "" || cc.KVMGPU
+}
+
+// enableGPU labels a Kubernetes node so that the device plugin is scheduled on it, enabling the plugin if needed
+func enableGPU(client kubernetes.Interface, cc *config.ClusterConfig, name string) error {
+	if err := labelNode(client, name, constants.GPUNodeLabel, "true"); err != nil {
+		return err
+	}
+	if cc.Addons[gpuAddon] {
+		return nil
+	}
+
+	out.T(out.Enabling, "Enabling {{.addon}} for the GPUs of {{.name}}", out.V{"addon": gpuAddon, "name": name})
+	if err := addons.RunCallbacks(cc, gpuAddon, "true"); err != nil {
+		return errors.Wrapf(err, "enable %s", gpuAddon)
+	}
+	return addons.Set(cc, gpuAddon, "true")
+}
+
+// labelNode sets a label on a Kubernetes node
+func labelNode(client kubernetes.Interface, name string, key string, value string) error {
+	n, err := client.CoreV1().Nodes().Get(name, meta.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "get node %s", name)
+	}
+	if n.Labels == nil {
+		n.Labels = map[string]string{}
+	}
+	n.Labels[key] = value
+	if _, err := client.CoreV1().Nodes().Update(n); err != nil {
+		return errors.Wrapf(err, "label node %s", name)
+	}
+	return nil
+}
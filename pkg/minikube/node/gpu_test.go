@@ -0,0 +1,69 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"testing"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestHasGPU(t *testing.T) {
+	var tests = []struct {
+		name string
+		cc   config.ClusterConfig
+		n    config.Node
+		want bool
+	}{
+		{"none", config.ClusterConfig{}, config.Node{Name: "m02"}, false},
+		{"node", config.ClusterConfig{}, config.Node{Name: "m02", GPUs: "all"}, true},
+		{"cluster", config.ClusterConfig{KVMGPU: true}, config.Node{Name: "m02"}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := HasGPU(tc.cc, tc.n)
+			if got != tc.want {
+				t.Errorf("HasGPU(%+v, %+v) = %v, want: %v", tc.cc, tc.n, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLabelNode(t *testing.T) {
+	client := fake.NewSimpleClientset(&core.Node{ObjectMeta: meta.ObjectMeta{Name: "minikube-m02", Labels: map[string]string{"kubernetes.io/os": "linux"}}})
+	if err := labelNode(client, "minikube-m02", "minikube.k8s.io/gpu", "true"); err != nil {
+		t.Fatalf("labelNode() unexpected error: %v", err)
+	}
+
+	n, err := client.CoreV1().Nodes().Get("minikube-m02", meta.GetOptions{})
+	if err != nil {
+		t.Fatalf("get node: %v", err)
+	}
+	if n.Labels["minikube.k8s.io/gpu"] != "true" {
+		t.Errorf("labels = %v, want minikube.k8s.io/gpu=true", n.Labels)
+	}
+	if n.Labels["kubernetes.io/os"] != "linux" {
+		t.Errorf("labels = %v, existing labels were not kept", n.Labels)
+	}
+
+	if err := labelNode(client, "minikube-m03", "minikube.k8s.io/gpu", "true"); err == nil {
+		t.Errorf("labelNode() of a missing node expected an error")
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"os/exec"
+	"path"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"k8s.io/minikube/pkg/minikube/assets"
+	"k8s.io/minikube/pkg/minikube/command"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/vmpath"
+)
+
+// hookDir is where post-join scripts are copied to within the guest VM
+var hookDir = path.Join(vmpath.GuestPersistentDir, "hooks")
+
+// PostJoinEnv returns the environment variables describing the node to its post-join script
+func PostJoinEnv(cc config.ClusterConfig, n config.Node) []string {
+	role := RoleWorker
+	if n.ControlPlane {
+		role = RoleControlPlane
+	}
+	return []string{
+		"MINIKUBE_PROFILE=" + cc.Name,
+		"MINIKUBE_NODE_NAME=" + n.Name,
+		"MINIKUBE_NODE_ROLE=" + role,
+	}
+}
+
+// RunPostJoinScript copies a local script onto the node and runs it as root
+func RunPostJoinScript(r command.Runner, cc config.ClusterConfig, n config.Node, script string) error {
+	f, err := assets.NewFileAsset(script, hookDir, "post-join.sh", "0755")
+	if err != nil {
+		return errors.Wrap(err, "script asset")
+	}
+	if err := r.Copy(f); err != nil {
+		return errors.Wrapf(err, "copying %s", script)
+	}
+
+	args := append([]string{"env"}, PostJoinEnv(cc, n)...)
+	args = append(args, "/bin/bash", path.Join(hookDir, "post-join.sh"))
+	if _, err := r.RunCmd(exec.Command("sudo", args...)); err != nil {
+		return errors.Wrapf(err, "running %s", script)
+	}
+	glog.Infof("post-join script %s succeeded on %s", script, n.Name)
+	return nil
+}
+
+// PostJoin runs a post-join script on a running node of the cluster
+func PostJoin(cc config.ClusterConfig, n config.Node, script string) error {
+	api, err := machine.NewAPIClient()
+	if err != nil {
+		return errors.Wrap(err, "api client")
+	}
+	defer api.Close()
+
+	h, err := machine.LoadHost(api, driver.MachineName(cc, n))
+	if err != nil {
+		return errors.Wrap(err, "load host")
+	}
+	r, err := machine.CommandRunner(h)
+	if err != nil {
+		return errors.Wrap(err, "command runner")
+	}
+	return RunPostJoinScript(r, cc, n, script)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestPostJoinEnv(t *testing.T) {
+	cc := config.ClusterConfig{Name: "multinode"}
+	var tests = []struct {
+		description string
+		node        config.Node
+		want        []string
+	}{
+		{"worker", config.Node{Name: "m02", Worker: true}, []string{"MINIKUBE_PROFILE=multinode", "MINIKUBE_NODE_NAME=m02", "MINIKUBE_NODE_ROLE=worker"}},
+		{"control plane", config.Node{Name: "m03", ControlPlane: true, Worker: true}, []string{"MINIKUBE_PROFILE=multinode", "MINIKUBE_NODE_NAME=m03", "MINIKUBE_NODE_ROLE=control-plane"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			if got := PostJoinEnv(cc, tc.node); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("PostJoinEnv() = %v, want: %v", got, tc.want)
+			}
+		})
+	}
+}
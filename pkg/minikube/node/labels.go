@@ -0,0 +1,133 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+// ValidateLabels checks that every label is formatted as KEY=VALUE, with a valid key and value
+func ValidateLabels(labels []string) error {
+	for _, l := range labels {
+		kv := strings.SplitN(l, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("invalid label %q, expected KEY=VALUE", l)
+		}
+		if errs := validation.IsQualifiedName(kv[0]); len(errs) > 0 {
+			return fmt.Errorf("invalid label key %q: %s", kv[0], strings.Join(errs, ", "))
+		}
+		if errs := validation.IsValidLabelValue(kv[1]); len(errs) > 0 {
+			return fmt.Errorf("invalid label value %q: %s", kv[1], strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+// SetLabels stores KEY=VALUE labels in the node config, setting them on the Kubernetes node too if live
+func SetLabels(cc *config.ClusterConfig, n *config.Node, labels []string, live bool) error {
+	if err := ValidateLabels(labels); err != nil {
+		return err
+	}
+	if live {
+		client, err := kapi.Client(cc.Name)
+		if err != nil {
+			return errors.Wrap(err, "kubernetes client")
+		}
+		if err := relabelNode(client, bsutil.KubeNodeName(*cc, *n), labels, nil); err != nil {
+			return err
+		}
+	}
+	n.Labels = mergeLabels(n.Labels, labels)
+	return config.SaveNode(cc, n)
+}
+
+// RemoveLabels drops labels by key from the node config, removing them from the Kubernetes node too if live
+func RemoveLabels(cc *config.ClusterConfig, n *config.Node, keys []string, live bool) error {
+	if live {
+		client, err := kapi.Client(cc.Name)
+		if err != nil {
+			return errors.Wrap(err, "kubernetes client")
+		}
+		if err := relabelNode(client, bsutil.KubeNodeName(*cc, *n), nil, keys); err != nil {
+			return err
+		}
+	}
+	n.Labels = dropLabels(n.Labels, keys)
+	return config.SaveNode(cc, n)
+}
+
+// mergeLabels returns existing with the KEY=VALUE labels in set added, replacing entries with the same key
+func mergeLabels(existing []string, set []string) []string {
+	var keys []string
+	for _, l := range set {
+		keys = append(keys, strings.SplitN(l, "=", 2)[0])
+	}
+	return append(dropLabels(existing, keys), set...)
+}
+
+// dropLabels returns existing without the labels whose key is in keys
+func dropLabels(existing []string, keys []string) []string {
+	var kept []string
+	for _, l := range existing {
+		drop := false
+		for _, k := range keys {
+			if strings.SplitN(l, "=", 2)[0] == k {
+				drop = true
+				break
+			}
+		}
+		if !drop {
+			kept = append(kept, l)
+		}
+	}
+	return kept
+}
+
+// relabelNode sets the KEY=VALUE labels in set on a Kubernetes node, and removes the labels whose key is in remove
+func relabelNode(client kubernetes.Interface, name string, set []string, remove []string) error {
+	n, err := client.CoreV1().Nodes().Get(name, meta.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "get node %s", name)
+	}
+	if n.Labels == nil {
+		n.Labels = map[string]string{}
+	}
+	for _, l := range set {
+		kv := strings.SplitN(l, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		n.Labels[kv[0]] = kv[1]
+	}
+	for _, k := range remove {
+		delete(n.Labels, k)
+	}
+	if _, err := client.CoreV1().Nodes().Update(n); err != nil {
+		return errors.Wrapf(err, "label node %s", name)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"reflect"
+	"testing"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestValidateLabels(t *testing.T) {
+	var tests = []struct {
+		labels    []string
+		shouldErr bool
+	}{
+		{nil, false},
+		{[]string{"disktype=ssd"}, false},
+		{[]string{"example.com/zone="}, false},
+		{[]string{"disktype"}, true},
+		{[]string{"=ssd"}, true},
+		{[]string{"disktype=fast ssd"}, true},
+		{[]string{"note=a=b"}, true},
+	}
+	for _, tc := range tests {
+		err := ValidateLabels(tc.labels)
+		if err != nil && !tc.shouldErr {
+			t.Errorf("ValidateLabels(%v) unexpected error: %v", tc.labels, err)
+		}
+		if err == nil && tc.shouldErr {
+			t.Errorf("ValidateLabels(%v) expected error but got none", tc.labels)
+		}
+	}
+}
+
+func TestMergeLabels(t *testing.T) {
+	var tests = []struct {
+		description string
+		existing    []string
+		set         []string
+		remove      []string
+		want        []string
+	}{
+		{"add", nil, []string{"disktype=ssd"}, nil, []string{"disktype=ssd"}},
+		{"replace", []string{"disktype=hdd", "zone=a"}, []string{"disktype=ssd"}, nil, []string{"zone=a", "disktype=ssd"}},
+		{"remove", []string{"disktype=hdd", "zone=a"}, nil, []string{"disktype"}, []string{"zone=a"}},
+		{"remove missing", []string{"zone=a"}, nil, []string{"disktype"}, []string{"zone=a"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			got := dropLabels(mergeLabels(tc.existing, tc.set), tc.remove)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("labels = %v, want: %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRelabelNode(t *testing.T) {
+	client := fake.NewSimpleClientset(&core.Node{ObjectMeta: meta.ObjectMeta{Name: "minikube-m03", Labels: map[string]string{"disktype": "hdd", "zone": "a"}}})
+	if err := relabelNode(client, "minikube-m03", []string{"disktype=ssd", "rack=1"}, []string{"zone"}); err != nil {
+		t.Fatalf("relabelNode() unexpected error: %v", err)
+	}
+
+	n, err := client.CoreV1().Nodes().Get("minikube-m03", meta.GetOptions{})
+	if err != nil {
+		t.Fatalf("get node: %v", err)
+	}
+	want := map[string]string{"disktype": "ssd", "rack": "1"}
+	if !reflect.DeepEqual(n.Labels, want) {
+		t.Errorf("labels = %v, want: %v", n.Labels, want)
+	}
+}
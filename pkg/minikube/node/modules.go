@@ -0,0 +1,38 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+// kernelModules are needed by every node: overlay for the storage of the container runtime,
+// and br_netfilter for bridged pod traffic to go through iptables
+var kernelModules = []string{"overlay", "br_netfilter"}
+
+// ipvsKernelModules are needed by kube-proxy in ipvs mode
+var ipvsKernelModules = []string{"ip_vs", "ip_vs_rr", "ip_vs_wrr", "ip_vs_sh", "nf_conntrack"}
+
+// KernelModules returns the kernel modules the nodes of a cluster need
+func KernelModules(cc config.ClusterConfig) []string {
+	ms := append([]string{}, kernelModules...)
+	if cc.KubernetesConfig.ExtraOptions.Get("mode", bsutil.Kubeproxy) == "ipvs" {
+		ms = append(ms, ipvsKernelModules...)
+	}
+	return ms
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestKernelModules(t *testing.T) {
+	var tests = []struct {
+		name  string
+		extra config.ExtraOptionSlice
+		want  []string
+	}{
+		{
+			name: "default",
+			want: []string{"overlay", "br_netfilter"},
+		},
+		{
+			name:  "iptables",
+			extra: config.ExtraOptionSlice{{Component: "kube-proxy", Key: "mode", Value: "iptables"}},
+			want:  []string{"overlay", "br_netfilter"},
+		},
+		{
+			name:  "ipvs",
+			extra: config.ExtraOptionSlice{{Component: "kube-proxy", Key: "mode", Value: "ipvs"}},
+			want:  []string{"overlay", "br_netfilter", "ip_vs", "ip_vs_rr", "ip_vs_wrr", "ip_vs_sh", "nf_conntrack"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cc := config.ClusterConfig{KubernetesConfig: config.KubernetesConfig{ExtraOptions: tc.extra}}
+			if got := KernelModules(cc); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("KernelModules() = %v, want: %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -18,29 +18,66 @@ package node
 
 import (
 	"fmt"
+	"net"
+	"strings"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
+	"k8s.io/apimachinery/pkg/util/validation"
 
+	"k8s.io/minikube/pkg/kapi"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/driver"
 	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+// Roles a node can be added with
+const (
+	RoleWorker       = "worker"
+	RoleControlPlane = "control-plane"
 )
 
 // TODO: Share these between cluster and node packages
 const (
 	mountString = "mount-string"
 	createMount = "mount"
-	cpus        = "cpus"
 )
 
+// AddOptions are the settings of an add which are not persisted with the node
+type AddOptions struct {
+	// Timeout bounds the whole add, 0 waits forever
+	Timeout time.Duration
+	// CNIOnly considers the node started as soon as its CNI pod is Ready, without waiting for the other system pods
+	CNIOnly bool
+	// ReadyTimeout bounds the wait for the node and its system pods to be Ready, 0 uses --wait-timeout
+	ReadyTimeout time.Duration
+	// WaitForPods are the pods which must be Ready on the node before it is considered started
+	WaitForPods []kverify.PodSelector
+	// Progress reports each step of the add along with the share of the add completed, as text only: there is no machine-readable event output
+	Progress bool
+}
+
 // Add adds a new node config to an existing cluster.
 func Add(cc *config.ClusterConfig, n config.Node, delOnFail bool) error {
+	return add(cc, n, delOnFail, AddOptions{}, nil)
+}
+
+func add(cc *config.ClusterConfig, n config.Node, delOnFail bool, opts AddOptions, ph *phase) error {
+	if err := ph.set("saving the node"); err != nil {
+		return err
+	}
 	if err := config.SaveNode(cc, &n); err != nil {
 		return errors.Wrap(err, "save node")
 	}
 
+	if err := ph.set("provisioning the machine"); err != nil {
+		return err
+	}
 	r, p, m, h, err := Provision(cc, &n, false, delOnFail)
 	if err != nil {
 		return err
@@ -53,10 +90,17 @@ func Add(cc *config.ClusterConfig, n config.Node, delOnFail bool) error {
 		Cfg:            cc,
 		Node:           &n,
 		ExistingAddons: nil,
+		phase:          ph,
+		cniOnly:        opts.CNIOnly,
+		readyTimeout:   opts.ReadyTimeout,
+		waitForPods:    opts.WaitForPods,
 	}
 
-	_, err = Start(s, false)
-	return err
+	if _, err := Start(s, false); err != nil {
+		return err
+	}
+	ph.done()
+	return nil
 }
 
 // Delete stops and deletes the given node from the given cluster
@@ -71,11 +115,26 @@ func Delete(cc config.ClusterConfig, name string) (*config.Node, error) {
 		return n, err
 	}
 
-	err = machine.DeleteHost(api, driver.MachineName(cc, *n))
+	machineName := driver.MachineName(cc, *n)
+	kubeNodeName := bsutil.KubeNodeName(cc, *n)
+	err = machine.DeleteHost(api, machineName)
 	if err != nil {
 		return n, err
 	}
 
+	if n.RegisterDNS {
+		if client, err := kapi.Client(cc.Name); err != nil {
+			glog.Warningf("unable to get kubernetes client: %v", err)
+		} else if err := UnregisterDNS(client, kubeNodeName); err != nil {
+			out.WarningT("Unable to remove {{.name}} from CoreDNS: {{.error}}", out.V{"name": kubeNodeName, "error": err})
+		}
+	}
+
+	if cc.KubernetesConfig.ProvisionerNode == kubeNodeName {
+		out.WarningT("storage-provisioner was pinned to {{.name}}, run 'minikube addons enable storage-provisioner' to run it on another node", out.V{"name": kubeNodeName})
+		cc.KubernetesConfig.ProvisionerNode = ""
+	}
+
 	cc.Nodes = append(cc.Nodes[:index], cc.Nodes[index+1:]...)
 	return n, config.SaveProfile(viper.GetString(config.ProfileName), &cc)
 }
@@ -115,7 +174,131 @@ func Save(cfg *config.ClusterConfig, node *config.Node) error {
 	return config.SaveProfile(viper.GetString(config.ProfileName), cfg)
 }
 
-// Name returns the appropriate name for the node given the current number of nodes
+// Name returns the autogenerated name of the node at the given 1-based index
 func Name(index int) string {
 	return fmt.Sprintf("m%02d", index)
 }
+
+// NameFor returns the name of the node at the given 1-based index, preferring the names requested by the user
+func NameFor(index int, requested []string) string {
+	if index > 0 && len(requested) >= index {
+		return requested[index-1]
+	}
+	return Name(index)
+}
+
+// NextName returns the first autogenerated node name which is not yet used by the cluster
+func NextName(cc config.ClusterConfig) string {
+	for i := len(cc.Nodes) + 1; ; i++ {
+		name := Name(i)
+		if _, _, err := Retrieve(cc, name); err != nil {
+			return name
+		}
+	}
+}
+
+// ValidateHostname checks that a node can be added with the given hostname, which must be a valid Kubernetes node name
+// not used by another node of the cluster
+func ValidateHostname(cc config.ClusterConfig, hostname string) error {
+	if errs := validation.IsDNS1123Subdomain(hostname); len(errs) > 0 {
+		return fmt.Errorf("invalid hostname %q: %s", hostname, strings.Join(errs, ", "))
+	}
+	for _, n := range cc.Nodes {
+		if bsutil.KubeNodeName(cc, n) == hostname || driver.MachineName(cc, n) == hostname {
+			return fmt.Errorf("hostname %q is already used by node %s", hostname, n.Name)
+		}
+	}
+	return nil
+}
+
+// ValidateDriver checks that a node requested with the given driver can join the cluster, an empty driver means the cluster's
+func ValidateDriver(cc config.ClusterConfig, name string) error {
+	if name == "" || name == cc.Driver {
+		return nil
+	}
+	if !driver.Supported(name) {
+		return fmt.Errorf("unsupported driver %q, supported drivers are: %s", name, strings.Join(driver.SupportedDrivers(), ", "))
+	}
+	// nodes reach each other over the network of the driver, which is not shared between drivers
+	return fmt.Errorf("cluster %s uses the %s driver, and nodes with a different driver are not supported", cc.Name, cc.Driver)
+}
+
+// ValidateListenAddress checks that the ports of a node can be published on addr, which must be an IPv4 address or 0.0.0.0
+func ValidateListenAddress(addr string) error {
+	ip := net.ParseIP(addr)
+	if ip == nil || ip.To4() == nil {
+		return fmt.Errorf("%q is not an IPv4 address", addr)
+	}
+	return nil
+}
+
+// CheckHostAddress checks that addr is an address of this host or 0.0.0.0. This is only advisory,
+// as the ports are published by the docker daemon, which may run on another host or in a VM.
+func CheckHostAddress(addr string) error {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return fmt.Errorf("%q is not an IP address", addr)
+	}
+	if ip.IsUnspecified() {
+		return nil
+	}
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return errors.Wrap(err, "listing the addresses of the host")
+	}
+	for _, a := range addrs {
+		if n, ok := a.(*net.IPNet); ok && n.IP.Equal(ip) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not an address of this host", addr)
+}
+
+// ValidateSysctls checks that sysctl settings are formatted as KEY=VALUE
+func ValidateSysctls(sysctls []string) error {
+	for _, s := range sysctls {
+		kv := strings.SplitN(s, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return fmt.Errorf("invalid sysctl %q, expected KEY=VALUE", s)
+		}
+	}
+	return nil
+}
+
+// Sysctls returns the effective sysctl settings of a node, node settings take precedence over cluster-wide ones
+func Sysctls(cc config.ClusterConfig, n config.Node) []string {
+	var keys []string
+	values := map[string]string{}
+	for _, s := range append(append([]string{}, cc.Sysctls...), n.Sysctls...) {
+		kv := strings.SplitN(s, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		k := strings.TrimSpace(kv[0])
+		if _, ok := values[k]; !ok {
+			keys = append(keys, k)
+		}
+		values[k] = strings.TrimSpace(kv[1])
+	}
+
+	var effective []string
+	for _, k := range keys {
+		effective = append(effective, k+"="+values[k])
+	}
+	return effective
+}
+
+// ValidateNames checks that user supplied node names are unique DNS labels
+func ValidateNames(names []string) error {
+	seen := map[string]bool{}
+	for _, name := range names {
+		if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+			return fmt.Errorf("invalid node name %q: %s", name, strings.Join(errs, ", "))
+		}
+		if seen[name] {
+			return fmt.Errorf("duplicate node name %q", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
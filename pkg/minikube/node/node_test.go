@@ -0,0 +1,278 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+)
+
+func TestValidateNames(t *testing.T) {
+	var tests = []struct {
+		names     []string
+		shouldErr bool
+	}{
+		{[]string{"cp", "worker-a", "worker-b"}, false},
+		{[]string{"m01"}, false},
+		{[]string{"cp", "cp"}, true},
+		{[]string{"Worker"}, true},
+		{[]string{"worker_a"}, true},
+		{[]string{"-worker"}, true},
+		{[]string{""}, true},
+	}
+	for _, tc := range tests {
+		err := ValidateNames(tc.names)
+		if err != nil && !tc.shouldErr {
+			t.Errorf("ValidateNames(%v) unexpected error: %v", tc.names, err)
+		}
+		if err == nil && tc.shouldErr {
+			t.Errorf("ValidateNames(%v) expected error but got none", tc.names)
+		}
+	}
+}
+
+func TestNameFor(t *testing.T) {
+	var tests = []struct {
+		description string
+		index       int
+		requested   []string
+		want        string
+	}{
+		{"autogenerated", 2, nil, "m02"},
+		{"requested", 2, []string{"cp", "gpu"}, "gpu"},
+		{"more nodes than names", 3, []string{"cp", "gpu"}, "m03"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			got := NameFor(tc.index, tc.requested)
+			if got != tc.want {
+				t.Errorf("NameFor(%d, %v) = %q, want: %q", tc.index, tc.requested, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNextName(t *testing.T) {
+	var tests = []struct {
+		description string
+		nodes       []config.Node
+		want        string
+	}{
+		{"single node", []config.Node{{Name: "", ControlPlane: true}}, "m02"},
+		{"autogenerated", []config.Node{{Name: "", ControlPlane: true}, {Name: "m02"}}, "m03"},
+		{"taken by user", []config.Node{{Name: "cp", ControlPlane: true}, {Name: "m03"}}, "m04"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			cc := config.ClusterConfig{Name: "minikube", Nodes: tc.nodes}
+			got := NextName(cc)
+			if got != tc.want {
+				t.Errorf("NextName() = %q, want: %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidateHostname(t *testing.T) {
+	cc := config.ClusterConfig{Name: "minikube", Driver: driver.Docker, Nodes: []config.Node{
+		{Name: "", ControlPlane: true},
+		{Name: "m02", Worker: true, Hostname: "gpu-worker"},
+	}}
+	var tests = []struct {
+		hostname  string
+		shouldErr bool
+	}{
+		{"custom-worker", false},
+		{"worker.example.com", false},
+		{"gpu-worker", true},
+		{"minikube", true},
+		{"minikube-m02", true},
+		{"Custom_Worker", true},
+		{"-worker", true},
+	}
+	for _, tc := range tests {
+		err := ValidateHostname(cc, tc.hostname)
+		if err != nil && !tc.shouldErr {
+			t.Errorf("ValidateHostname(%q) unexpected error: %v", tc.hostname, err)
+		}
+		if err == nil && tc.shouldErr {
+			t.Errorf("ValidateHostname(%q) expected error but got none", tc.hostname)
+		}
+	}
+}
+
+func TestValidateDriver(t *testing.T) {
+	cc := config.ClusterConfig{Name: "minikube", Driver: driver.Docker}
+	var tests = []struct {
+		driver    string
+		shouldErr bool
+	}{
+		{"", false},
+		{driver.Docker, false},
+		{driver.KVM2, true},
+		{"hyperv2", true},
+	}
+	for _, tc := range tests {
+		err := ValidateDriver(cc, tc.driver)
+		if err != nil && !tc.shouldErr {
+			t.Errorf("ValidateDriver(%q) unexpected error: %v", tc.driver, err)
+		}
+		if err == nil && tc.shouldErr {
+			t.Errorf("ValidateDriver(%q) expected error but got none", tc.driver)
+		}
+	}
+}
+
+func TestValidateListenAddress(t *testing.T) {
+	var tests = []struct {
+		addr      string
+		shouldErr bool
+	}{
+		{"127.0.0.1", false},
+		{"0.0.0.0", false},
+		{"203.0.113.77", false},
+		{"::1", true},
+		{"localhost", true},
+		{"", true},
+	}
+	for _, tc := range tests {
+		err := ValidateListenAddress(tc.addr)
+		if err != nil && !tc.shouldErr {
+			t.Errorf("ValidateListenAddress(%q) unexpected error: %v", tc.addr, err)
+		}
+		if err == nil && tc.shouldErr {
+			t.Errorf("ValidateListenAddress(%q) expected error but got none", tc.addr)
+		}
+	}
+}
+
+func TestCheckHostAddress(t *testing.T) {
+	var tests = []struct {
+		addr      string
+		shouldErr bool
+	}{
+		{"127.0.0.1", false},
+		{"0.0.0.0", false},
+		{"203.0.113.77", true},
+	}
+	for _, tc := range tests {
+		err := CheckHostAddress(tc.addr)
+		if err != nil && !tc.shouldErr {
+			t.Errorf("CheckHostAddress(%q) unexpected error: %v", tc.addr, err)
+		}
+		if err == nil && tc.shouldErr {
+			t.Errorf("CheckHostAddress(%q) expected error but got none", tc.addr)
+		}
+	}
+}
+
+func TestValidateSysctls(t *testing.T) {
+	var tests = []struct {
+		sysctls   []string
+		shouldErr bool
+	}{
+		{nil, false},
+		{[]string{"vm.max_map_count=262144"}, false},
+		{[]string{"net.ipv4.ip_local_port_range=1024 65000"}, false},
+		{[]string{"vm.max_map_count"}, true},
+		{[]string{"=1"}, true},
+	}
+	for _, tc := range tests {
+		err := ValidateSysctls(tc.sysctls)
+		if err != nil && !tc.shouldErr {
+			t.Errorf("ValidateSysctls(%v) unexpected error: %v", tc.sysctls, err)
+		}
+		if err == nil && tc.shouldErr {
+			t.Errorf("ValidateSysctls(%v) expected error but got none", tc.sysctls)
+		}
+	}
+}
+
+func TestValidateMount(t *testing.T) {
+	var tests = []struct {
+		mount     string
+		shouldErr bool
+	}{
+		{"/home/user:/minikube-host", false},
+		{`C:\Users\user:/minikube-host`, false},
+		{"/home/user", true},
+		{"/home/user:minikube-host", true},
+		{"/home/user:", true},
+	}
+	for _, tc := range tests {
+		err := ValidateMount(tc.mount)
+		if err != nil && !tc.shouldErr {
+			t.Errorf("ValidateMount(%q) unexpected error: %v", tc.mount, err)
+		}
+		if err == nil && tc.shouldErr {
+			t.Errorf("ValidateMount(%q) expected error but got none", tc.mount)
+		}
+	}
+}
+
+func TestSysctls(t *testing.T) {
+	var tests = []struct {
+		description string
+		cluster     []string
+		node        []string
+		want        []string
+	}{
+		{"none", nil, nil, nil},
+		{"cluster only", []string{"vm.max_map_count=262144"}, nil, []string{"vm.max_map_count=262144"}},
+		{"node only", nil, []string{"fs.file-max=100000"}, []string{"fs.file-max=100000"}},
+		{"node overrides cluster", []string{"vm.max_map_count=262144", "fs.file-max=100000"}, []string{"vm.max_map_count=524288"}, []string{"vm.max_map_count=524288", "fs.file-max=100000"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			got := Sysctls(config.ClusterConfig{Sysctls: tc.cluster}, config.Node{Sysctls: tc.node})
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Sysctls() = %v, want: %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestJoinWaits(t *testing.T) {
+	var tests = []struct {
+		description    string
+		verify         map[string]bool
+		cniOnly        bool
+		readyTimeout   time.Duration
+		wantNodeReady  bool
+		wantSystemPods bool
+	}{
+		{"default", nil, false, 0, false, true},
+		{"none", kverify.NoComponents, false, 0, false, false},
+		{"node and system pods", map[string]bool{kverify.NodeReadyKey: true, kverify.SystemPodsWaitKey: true}, false, 0, true, true},
+		{"cni only", map[string]bool{kverify.NodeReadyKey: true, kverify.SystemPodsWaitKey: true}, true, 0, false, false},
+		{"ready timeout", nil, false, time.Minute, true, true},
+		{"ready timeout without waits", kverify.NoComponents, false, time.Minute, true, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			nodeReady, systemPods := joinWaits(tc.verify, tc.cniOnly, tc.readyTimeout)
+			if nodeReady != tc.wantNodeReady || systemPods != tc.wantSystemPods {
+				t.Errorf("joinWaits() = %v, %v, want: %v, %v", nodeReady, systemPods, tc.wantNodeReady, tc.wantSystemPods)
+			}
+		})
+	}
+}
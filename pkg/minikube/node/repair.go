@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"github.com/pkg/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+// HalfJoined returns the nodes saved by 'minikube node add' which never registered as Kubernetes nodes,
+// such as when the add was interrupted. Control planes are created by 'minikube start' rather than joined.
+func HalfJoined(cc config.ClusterConfig, client kubernetes.Interface) ([]config.Node, error) {
+	nl, err := client.CoreV1().Nodes().List(meta.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "list nodes")
+	}
+	registered := map[string]bool{}
+	for _, kn := range nl.Items {
+		registered[kn.Name] = true
+	}
+
+	var half []config.Node
+	for _, n := range cc.Nodes {
+		if n.ControlPlane || registered[bsutil.KubeNodeName(cc, n)] {
+			continue
+		}
+		half = append(half, n)
+	}
+	return half, nil
+}
+
+// Repair completes the join of a half joined node, starting or recreating its machine as needed.
+// The join itself starts with a kubeadm reset, which clears whatever state the interrupted join left behind.
+func Repair(cc *config.ClusterConfig, n config.Node, opts AddOptions) error {
+	return AddWithOptions(cc, n, false, opts)
+}
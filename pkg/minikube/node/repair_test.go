@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"reflect"
+	"testing"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestHalfJoined(t *testing.T) {
+	cc := config.ClusterConfig{
+		Name: "minikube",
+		Nodes: []config.Node{
+			{Name: "", ControlPlane: true, Worker: true},
+			{Name: "m02", Worker: true},
+			{Name: "m03", Worker: true},
+			{Name: "m04", Worker: true, Hostname: "edge"},
+		},
+	}
+
+	var tests = []struct {
+		name       string
+		registered []string
+		want       []string
+	}{
+		{"all joined", []string{"minikube", "minikube-m02", "minikube-m03", "edge"}, nil},
+		{"interrupted", []string{"minikube", "minikube-m02", "edge"}, []string{"m03"}},
+		{"control plane not registered", []string{"minikube-m02", "edge"}, []string{"m03"}},
+		{"custom hostname registered under machine name", []string{"minikube", "minikube-m02", "minikube-m03", "minikube-m04"}, []string{"m04"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			for _, name := range tc.registered {
+				if _, err := client.CoreV1().Nodes().Create(&core.Node{ObjectMeta: meta.ObjectMeta{Name: name}}); err != nil {
+					t.Fatalf("create %s: %v", name, err)
+				}
+			}
+
+			half, err := HalfJoined(cc, client)
+			if err != nil {
+				t.Fatalf("HalfJoined() unexpected error: %v", err)
+			}
+			var got []string
+			for _, n := range half {
+				got = append(got, n.Name)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("HalfJoined() = %v, want: %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+// Replacement returns the config of a fresh node replacing old, keeping its settings.
+// It is named after the next free node name, and its hostname is dropped, as both are in use until the old node is deleted.
+func Replacement(cc config.ClusterConfig, old config.Node) config.Node {
+	n := old
+	n.Name = NextName(cc)
+	n.Hostname = ""
+	n.IP = ""
+	n.KubernetesVersion = cc.KubernetesConfig.KubernetesVersion
+	return n
+}
+
+// Taints returns the taints of a Kubernetes node
+func Taints(client kubernetes.Interface, name string) ([]core.Taint, error) {
+	n, err := client.CoreV1().Nodes().Get(name, meta.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "get node %s", name)
+	}
+	return n.Spec.Taints, nil
+}
+
+// CopyTaints sets the taints of the Kubernetes node from on the Kubernetes node to, skipping those managed by Kubernetes itself
+func CopyTaints(client kubernetes.Interface, from string, to string) error {
+	taints, err := Taints(client, from)
+	if err != nil {
+		return err
+	}
+	return ApplyTaints(client, to, taints)
+}
+
+// ApplyTaints sets taints on a Kubernetes node, skipping those managed by Kubernetes itself
+func ApplyTaints(client kubernetes.Interface, to string, taints []core.Taint) error {
+	dst, err := client.CoreV1().Nodes().Get(to, meta.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "get node %s", to)
+	}
+
+	for _, t := range taints {
+		// node.kubernetes.io taints reflect the state of a node, such as being cordoned or not ready
+		if strings.HasPrefix(t.Key, "node.kubernetes.io/") || hasTaint(dst.Spec.Taints, t) {
+			continue
+		}
+		dst.Spec.Taints = append(dst.Spec.Taints, t)
+	}
+	if _, err := client.CoreV1().Nodes().Update(dst); err != nil {
+		return errors.Wrapf(err, "taint node %s", to)
+	}
+	return nil
+}
+
+// hasTaint returns whether taints contains a taint with the key and effect of t
+func hasTaint(taints []core.Taint, t core.Taint) bool {
+	for _, x := range taints {
+		if x.Key == t.Key && x.Effect == t.Effect {
+			return true
+		}
+	}
+	return false
+}
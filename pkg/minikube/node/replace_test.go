@@ -0,0 +1,68 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"reflect"
+	"testing"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestReplacement(t *testing.T) {
+	old := config.Node{Name: "m02", IP: "192.168.39.3", Hostname: "gpu-worker", KubernetesVersion: "v1.18.2", Worker: true, Sysctls: []string{"vm.max_map_count=262144"}, Labels: []string{"disktype=ssd"}}
+	cc := config.ClusterConfig{
+		KubernetesConfig: config.KubernetesConfig{KubernetesVersion: "v1.18.3"},
+		Nodes:            []config.Node{{Name: "", ControlPlane: true}, old, {Name: "m03"}},
+	}
+
+	got := Replacement(cc, old)
+	want := config.Node{Name: "m04", KubernetesVersion: "v1.18.3", Worker: true, Sysctls: []string{"vm.max_map_count=262144"}, Labels: []string{"disktype=ssd"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Replacement() = %+v, want: %+v", got, want)
+	}
+}
+
+func TestCopyTaints(t *testing.T) {
+	dedicated := core.Taint{Key: "dedicated", Value: "gpu", Effect: core.TaintEffectNoSchedule}
+	client := fake.NewSimpleClientset(
+		&core.Node{ObjectMeta: meta.ObjectMeta{Name: "minikube-m02"}, Spec: core.NodeSpec{Taints: []core.Taint{
+			dedicated,
+			{Key: "node.kubernetes.io/unschedulable", Effect: core.TaintEffectNoSchedule},
+		}}},
+		&core.Node{ObjectMeta: meta.ObjectMeta{Name: "minikube-m04"}},
+	)
+	if err := CopyTaints(client, "minikube-m02", "minikube-m04"); err != nil {
+		t.Fatalf("CopyTaints() unexpected error: %v", err)
+	}
+	// copying again must not duplicate the taint
+	if err := CopyTaints(client, "minikube-m02", "minikube-m04"); err != nil {
+		t.Fatalf("CopyTaints() unexpected error: %v", err)
+	}
+
+	got, err := Taints(client, "minikube-m04")
+	if err != nil {
+		t.Fatalf("Taints() unexpected error: %v", err)
+	}
+	if want := []core.Taint{dedicated}; !reflect.DeepEqual(got, want) {
+		t.Errorf("taints = %v, want: %v", got, want)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
+)
+
+// ValidateExtendedResources checks that every extended resource is formatted as NAME=QUANTITY,
+// with a domain prefixed name outside of kubernetes.io and a whole, non-negative quantity
+func ValidateExtendedResources(resources []string) error {
+	for _, r := range resources {
+		kv := strings.SplitN(r, "=", 2)
+		if len(kv) != 2 {
+			return fmt.Errorf("invalid extended resource %q, expected NAME=QUANTITY", r)
+		}
+		name := kv[0]
+		if errs := validation.IsQualifiedName(name); len(errs) > 0 {
+			return fmt.Errorf("invalid extended resource name %q: %s", name, strings.Join(errs, ", "))
+		}
+		if !strings.Contains(name, "/") || strings.HasPrefix(name, "kubernetes.io/") || strings.HasPrefix(name, "requests.") {
+			return fmt.Errorf("invalid extended resource name %q, expected a domain prefix such as example.com/", name)
+		}
+		q, err := resource.ParseQuantity(kv[1])
+		if err != nil {
+			return fmt.Errorf("invalid quantity of extended resource %s: %v", name, err)
+		}
+		if q.Sign() < 0 || q.MilliValue()%1000 != 0 {
+			return fmt.Errorf("invalid quantity of extended resource %s: %s, expected a whole number of 0 or more", name, kv[1])
+		}
+	}
+	return nil
+}
+
+// advertiseExtendedResources sets the capacity of the given NAME=QUANTITY extended resources in the status of a Kubernetes node,
+// from which the kubelet derives their allocatable quantity. The status is patched rather than replaced,
+// as the kubelet keeps updating the rest of it.
+func advertiseExtendedResources(client kubernetes.Interface, name string, resources []string, timeout time.Duration) error {
+	if err := kverify.WaitForNodeRegistered(client, name, timeout); err != nil {
+		return err
+	}
+
+	capacity := core.ResourceList{}
+	for _, r := range resources {
+		kv := strings.SplitN(r, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		q, err := resource.ParseQuantity(kv[1])
+		if err != nil {
+			continue
+		}
+		capacity[core.ResourceName(kv[0])] = q
+	}
+	patch, err := json.Marshal(map[string]interface{}{"status": map[string]interface{}{"capacity": capacity}})
+	if err != nil {
+		return errors.Wrap(err, "marshal patch")
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, err := client.CoreV1().Nodes().Patch(name, types.StrategicMergePatchType, patch, "status")
+		return err
+	})
+	if err != nil {
+		return errors.Wrapf(err, "patch status of node %s", name)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"testing"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestValidateExtendedResources(t *testing.T) {
+	var tests = []struct {
+		resources []string
+		shouldErr bool
+	}{
+		{nil, false},
+		{[]string{"example.com/widget=4"}, false},
+		{[]string{"example.com/widget=0", "example.com/gadget=1k"}, false},
+		{[]string{"example.com/widget"}, true},
+		{[]string{"widget=4"}, true},
+		{[]string{"kubernetes.io/widget=4"}, true},
+		{[]string{"example.com/widget=-1"}, true},
+		{[]string{"example.com/widget=500m"}, true},
+		{[]string{"example.com/widget=lots"}, true},
+		{[]string{"example.com/wid get=4"}, true},
+	}
+	for _, tc := range tests {
+		err := ValidateExtendedResources(tc.resources)
+		if err != nil && !tc.shouldErr {
+			t.Errorf("ValidateExtendedResources(%v) unexpected error: %v", tc.resources, err)
+		}
+		if err == nil && tc.shouldErr {
+			t.Errorf("ValidateExtendedResources(%v) expected error but got none", tc.resources)
+		}
+	}
+}
+
+func TestAdvertiseExtendedResources(t *testing.T) {
+	client := fake.NewSimpleClientset(&core.Node{
+		ObjectMeta: meta.ObjectMeta{Name: "minikube-m02"},
+		Status:     core.NodeStatus{Capacity: core.ResourceList{core.ResourcePods: resource.MustParse("110"), "example.com/widget": resource.MustParse("2")}},
+	})
+	if err := advertiseExtendedResources(client, "minikube-m02", []string{"example.com/widget=4", "example.com/gadget=1"}, time.Second); err != nil {
+		t.Fatalf("advertiseExtendedResources() unexpected error: %v", err)
+	}
+
+	n, err := client.CoreV1().Nodes().Get("minikube-m02", meta.GetOptions{})
+	if err != nil {
+		t.Fatalf("get node: %v", err)
+	}
+	want := map[core.ResourceName]string{core.ResourcePods: "110", "example.com/widget": "4", "example.com/gadget": "1"}
+	if len(n.Status.Capacity) != len(want) {
+		t.Errorf("capacity = %v, want: %v", n.Status.Capacity, want)
+	}
+	for name, q := range want {
+		got := n.Status.Capacity[name]
+		if got.String() != q {
+			t.Errorf("capacity of %s = %s, want: %s", name, got.String(), q)
+		}
+	}
+}
+
+func TestAdvertiseExtendedResourcesUnregistered(t *testing.T) {
+	if err := advertiseExtendedResources(fake.NewSimpleClientset(), "minikube-m02", []string{"example.com/widget=4"}, time.Second); err == nil {
+		t.Errorf("advertiseExtendedResources() of an unregistered node expected error but got none")
+	}
+}
@@ -38,6 +38,8 @@ import (
 	"k8s.io/minikube/pkg/drivers/kic/oci"
 	"k8s.io/minikube/pkg/kapi"
 	"k8s.io/minikube/pkg/minikube/bootstrapper"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil"
+	"k8s.io/minikube/pkg/minikube/bootstrapper/bsutil/kverify"
 	"k8s.io/minikube/pkg/minikube/bootstrapper/images"
 	"k8s.io/minikube/pkg/minikube/cluster"
 	"k8s.io/minikube/pkg/minikube/cni"
@@ -51,6 +53,7 @@ import (
 	"k8s.io/minikube/pkg/minikube/localpath"
 	"k8s.io/minikube/pkg/minikube/logs"
 	"k8s.io/minikube/pkg/minikube/machine"
+	"k8s.io/minikube/pkg/minikube/metrics"
 	"k8s.io/minikube/pkg/minikube/mustload"
 	"k8s.io/minikube/pkg/minikube/out"
 	"k8s.io/minikube/pkg/minikube/proxy"
@@ -74,11 +77,24 @@ type Starter struct {
 	Cfg            *config.ClusterConfig
 	Node           *config.Node
 	ExistingAddons map[string]bool
+	// phase, if set, is updated as the node moves through the steps of starting
+	phase *phase
+	// cniOnly skips waiting for the system pods of a joined node other than the CNI
+	cniOnly bool
+	// readyTimeout bounds the wait for a joined node to be Ready, 0 uses --wait-timeout
+	readyTimeout time.Duration
+	// waitForPods are the pods a joined node waits for on top of its system pods
+	waitForPods []kverify.PodSelector
 }
 
 // Start spins up a guest and starts the Kubernetes node.
 func Start(starter Starter, apiServer bool) (*kubeconfig.Settings, error) {
+	begin := time.Now()
+
 	// wait for preloaded tarball to finish downloading before configuring runtimes
+	if err := starter.phase.set("caching images"); err != nil {
+		return nil, err
+	}
 	waitCacheRequiredImages(&cacheGroup)
 
 	sv, err := util.ParseKubernetesVersion(starter.Node.KubernetesVersion)
@@ -87,7 +103,21 @@ func Start(starter Starter, apiServer bool) (*kubeconfig.Settings, error) {
 	}
 
 	// configure the runtime (docker, containerd, crio)
-	cr := configureRuntimes(starter.Runner, *starter.Cfg, sv)
+	if err := starter.phase.set("configuring the container runtime"); err != nil {
+		return nil, err
+	}
+
+	// Joining nodes are checked up front, as a missing module otherwise shows up later as broken pod networking.
+	// Containers share the kernel of the host, which may provide modules the container can not see, so kic drivers only warn.
+	if !apiServer && !driver.BareMetal(starter.Cfg.Driver) {
+		if missing := machine.LoadKernelModules(starter.Runner, KernelModules(*starter.Cfg)); len(missing) > 0 {
+			if !driver.IsKIC(starter.Cfg.Driver) {
+				return nil, fmt.Errorf("kernel modules required by Kubernetes are not available on node %s and could not be loaded: %s", starter.Node.Name, strings.Join(missing, ", "))
+			}
+			out.WarningT("Kernel modules {{.modules}} could not be found on node {{.name}}, pod networking may not work if the host kernel lacks them", out.V{"modules": strings.Join(missing, ", "), "name": starter.Node.Name})
+		}
+	}
+	cr := configureRuntimes(starter.Runner, *starter.Cfg, *starter.Node, sv)
 	showVersionInfo(starter.Node.KubernetesVersion, cr)
 
 	// Add "host.minikube.internal" DNS alias (intentionally non-fatal)
@@ -98,6 +128,18 @@ func Start(starter Starter, apiServer bool) (*kubeconfig.Settings, error) {
 		glog.Errorf("Unable to add host alias: %v", err)
 	}
 
+	// The provisioner sets the hostname to the machine name, on restarts too
+	if starter.Node.Hostname != "" {
+		if err := machine.SetHostname(starter.Runner, starter.Node.Hostname); err != nil {
+			return nil, errors.Wrap(err, "set hostname")
+		}
+	}
+
+	// Some sysctls can not be set from within a container, so this is intentionally non-fatal
+	if err := machine.ApplySysctls(starter.Runner, Sysctls(*starter.Cfg, *starter.Node)); err != nil {
+		out.WarningT("Unable to apply sysctl settings: {{.error}}", out.V{"error": err})
+	}
+
 	var bs bootstrapper.Bootstrapper
 	var kcs *kubeconfig.Settings
 	if apiServer {
@@ -111,7 +153,7 @@ func Start(starter Starter, apiServer bool) (*kubeconfig.Settings, error) {
 		bs = setupKubeAdm(starter.MachineAPI, *starter.Cfg, *starter.Node, starter.Runner)
 		err = bs.StartCluster(*starter.Cfg)
 		if err != nil {
-			MaybeExitWithAdvice(err)
+			MaybeExitWithAdvice(err, starter.Cfg, starter.Node)
 			out.LogEntries("Error starting cluster", err, logs.FindProblems(cr, bs, *starter.Cfg, starter.Runner))
 			return nil, err
 		}
@@ -133,7 +175,7 @@ func Start(starter Starter, apiServer bool) (*kubeconfig.Settings, error) {
 	}
 
 	var wg sync.WaitGroup
-	go configureMounts(&wg)
+	go configureMounts(&wg, *starter.Cfg, *starter.Node)
 
 	wg.Add(1)
 	go func() {
@@ -143,6 +185,19 @@ func Start(starter Starter, apiServer bool) (*kubeconfig.Settings, error) {
 		wg.Done()
 	}()
 
+	wg.Add(1)
+	go func() {
+		if machine.ForeignArch(*starter.Node) {
+			glog.Infof("Node is %s, skipping pre-pulling images from the cache of the host", starter.Node.Arch)
+			wg.Done()
+			return
+		}
+		if err := loadPrepullImages(starter.Cfg, starter.Runner); err != nil {
+			out.FailureT("Unable to pre-pull images: {{.error}}", out.V{"error": err})
+		}
+		wg.Done()
+	}()
+
 	// enable addons, both old and new!
 	if starter.ExistingAddons != nil {
 		go addons.Start(&wg, starter.Cfg, starter.ExistingAddons, config.AddonList)
@@ -167,6 +222,9 @@ func Start(starter Starter, apiServer bool) (*kubeconfig.Settings, error) {
 		}
 
 	} else {
+		if err := starter.phase.set("updating the node"); err != nil {
+			return nil, err
+		}
 		if err := bs.UpdateNode(*starter.Cfg, *starter.Node, cr); err != nil {
 			return nil, errors.Wrap(err, "update node")
 		}
@@ -177,6 +235,9 @@ func Start(starter Starter, apiServer bool) (*kubeconfig.Settings, error) {
 			return nil, errors.Wrap(err, "getting control plane bootstrapper")
 		}
 
+		if err := starter.phase.set("joining the cluster"); err != nil {
+			return nil, err
+		}
 		joinCmd, err := cpBs.GenerateToken(*starter.Cfg)
 		if err != nil {
 			return nil, errors.Wrap(err, "generating join token")
@@ -186,6 +247,9 @@ func Start(starter Starter, apiServer bool) (*kubeconfig.Settings, error) {
 			return nil, errors.Wrap(err, "joining cluster")
 		}
 
+		if err := starter.phase.set("applying the CNI"); err != nil {
+			return nil, err
+		}
 		cnm, err := cni.New(*starter.Cfg)
 		if err != nil {
 			return nil, errors.Wrap(err, "cni")
@@ -194,17 +258,174 @@ func Start(starter Starter, apiServer bool) (*kubeconfig.Settings, error) {
 		if err := cnm.Apply(cpr); err != nil {
 			return nil, errors.Wrap(err, "cni apply")
 		}
+
+		readyTimeout := viper.GetDuration(waitTimeout)
+		if starter.readyTimeout > 0 {
+			readyTimeout = starter.readyTimeout
+		}
+
+		// A joined node is of little use until pod networking works on it
+		selector := cni.PodSelector(cnm)
+		if selector != "" {
+			if err := starter.phase.set("waiting for pod networking"); err != nil {
+				return nil, err
+			}
+			client, err := kapi.Client(starter.Cfg.Name)
+			if err != nil {
+				return nil, errors.Wrap(err, "kubernetes client")
+			}
+			if err := kverify.WaitForCNIPod(client, bsutil.KubeNodeName(*starter.Cfg, *starter.Node), selector, readyTimeout); err != nil {
+				return nil, &NotReadyError{Err: errors.Wrapf(err, "wait for %s", cnm)}
+			}
+		}
+
+		// Without a CNI pod to wait for, the node is at least registered before it is considered added
+		if starter.cniOnly && selector == "" {
+			if err := starter.phase.set("waiting for the node to be registered"); err != nil {
+				return nil, err
+			}
+			client, err := kapi.Client(starter.Cfg.Name)
+			if err != nil {
+				return nil, errors.Wrap(err, "kubernetes client")
+			}
+			if err := kverify.WaitForNodeRegistered(client, bsutil.KubeNodeName(*starter.Cfg, *starter.Node), readyTimeout); err != nil {
+				return nil, &NotReadyError{Err: errors.Wrap(err, "wait for node")}
+			}
+		}
+
+		nodeReady, systemPods := joinWaits(starter.Cfg.VerifyComponents, starter.cniOnly, starter.readyTimeout)
+		if nodeReady || systemPods {
+			client, err := kapi.Client(starter.Cfg.Name)
+			if err != nil {
+				return nil, errors.Wrap(err, "kubernetes client")
+			}
+			kubeName := bsutil.KubeNodeName(*starter.Cfg, *starter.Node)
+			if nodeReady {
+				if err := starter.phase.set("waiting for the node to be Ready"); err != nil {
+					return nil, err
+				}
+				if err := kverify.WaitForNamedNodeReady(client, kubeName, readyTimeout); err != nil {
+					return nil, &NotReadyError{Err: errors.Wrap(err, "wait for node")}
+				}
+			}
+			if systemPods {
+				if err := starter.phase.set("waiting for system pods"); err != nil {
+					return nil, err
+				}
+				if err := kverify.WaitForNodePods(client, kubeName, readyTimeout); err != nil {
+					return nil, &NotReadyError{Err: errors.Wrap(err, "wait for system pods")}
+				}
+			}
+		}
+
+		if len(starter.waitForPods) > 0 {
+			if err := starter.phase.set("waiting for the selected pods"); err != nil {
+				return nil, err
+			}
+			client, err := kapi.Client(starter.Cfg.Name)
+			if err != nil {
+				return nil, errors.Wrap(err, "kubernetes client")
+			}
+			if err := kverify.WaitForSelectedPods(client, bsutil.KubeNodeName(*starter.Cfg, *starter.Node), starter.waitForPods, readyTimeout); err != nil {
+				return nil, &NotReadyError{Err: errors.Wrap(err, "wait for pods")}
+			}
+		}
+	}
+
+	// Annotations are reapplied on every start, as the Kubernetes node may have been recreated
+	if len(starter.Node.Annotations) > 0 {
+		client, err := kapi.Client(starter.Cfg.Name)
+		if err != nil {
+			return nil, errors.Wrap(err, "kubernetes client")
+		}
+		if err := annotateNode(client, bsutil.KubeNodeName(*starter.Cfg, *starter.Node), starter.Node.Annotations); err != nil {
+			out.WarningT("Unable to annotate {{.name}}: {{.error}}", out.V{"name": starter.Node.Name, "error": err})
+		}
+	}
+
+	// Extended resources are advertised again for the same reason
+	if len(starter.Node.ExtendedResources) > 0 {
+		client, err := kapi.Client(starter.Cfg.Name)
+		if err != nil {
+			return nil, errors.Wrap(err, "kubernetes client")
+		}
+		if err := advertiseExtendedResources(client, bsutil.KubeNodeName(*starter.Cfg, *starter.Node), starter.Node.ExtendedResources, viper.GetDuration(waitTimeout)); err != nil {
+			out.WarningT("Unable to advertise the extended resources of {{.name}}: {{.error}}", out.V{"name": starter.Node.Name, "error": err})
+		}
+	}
+
+	// GPU nodes are labelled on every start as well, as the device plugin is only scheduled on labelled nodes
+	if HasGPU(*starter.Cfg, *starter.Node) {
+		client, err := kapi.Client(starter.Cfg.Name)
+		if err != nil {
+			return nil, errors.Wrap(err, "kubernetes client")
+		}
+		if err := enableGPU(client, starter.Cfg, bsutil.KubeNodeName(*starter.Cfg, *starter.Node)); err != nil {
+			out.WarningT("Unable to schedule the GPU device plugin on {{.name}}: {{.error}}", out.V{"name": starter.Node.Name, "error": err})
+		}
+	}
+
+	// Labels set with 'minikube node set-label' are reapplied for the same reason
+	if len(starter.Node.Labels) > 0 {
+		client, err := kapi.Client(starter.Cfg.Name)
+		if err != nil {
+			return nil, errors.Wrap(err, "kubernetes client")
+		}
+		if err := relabelNode(client, bsutil.KubeNodeName(*starter.Cfg, *starter.Node), starter.Node.Labels, nil); err != nil {
+			out.WarningT("Unable to label {{.name}}: {{.error}}", out.V{"name": starter.Node.Name, "error": err})
+		}
+	}
+
+	// The hostname is registered on every start, as a restarted node may have a new IP
+	if starter.Node.RegisterDNS {
+		client, err := kapi.Client(starter.Cfg.Name)
+		if err != nil {
+			return nil, errors.Wrap(err, "kubernetes client")
+		}
+		if err := RegisterDNS(client, bsutil.KubeNodeName(*starter.Cfg, *starter.Node), starter.Node.IP); err != nil {
+			out.WarningT("Unable to register {{.name}} in CoreDNS: {{.error}}", out.V{"name": starter.Node.Name, "error": err})
+		}
+	}
+
+	// A persisted post-join script is run by node add when the node joins, and here whenever it restarts
+	if starter.PreExists && starter.Node.PostJoinScript != "" {
+		if err := starter.phase.set("running the post-join script"); err != nil {
+			return nil, err
+		}
+		if err := RunPostJoinScript(starter.Runner, *starter.Cfg, *starter.Node, starter.Node.PostJoinScript); err != nil {
+			out.WarningT("The post-join script of {{.name}} failed: {{.error}}", out.V{"name": starter.Node.Name, "error": err})
+		}
 	}
 
 	glog.Infof("waiting for startup goroutines ...")
+	if err := starter.phase.set("waiting for images and addons"); err != nil {
+		return nil, err
+	}
 	wg.Wait()
 
+	metrics.Record(metrics.NodeStartDuration, time.Since(begin), nodeLabels(*starter.Cfg, *starter.Node))
+
 	// Write enabled addons to the config before completion
 	return kcs, config.Write(viper.GetString(config.ProfileName), starter.Cfg)
 }
 
+// joinWaits returns whether a joined node waits to be Ready and for its system pods.
+// Only the per-node components of --wait apply, the control plane waited for the others,
+// and a node waiting for its CNI pod only waits for neither.
+// An explicit readyTimeout always waits for the node to be Ready, as that is what it bounds.
+func joinWaits(verify map[string]bool, cniOnly bool, readyTimeout time.Duration) (nodeReady bool, systemPods bool) {
+	if cniOnly {
+		return false, false
+	}
+	if verify == nil {
+		verify = kverify.DefaultComponents
+	}
+	return verify[kverify.NodeReadyKey] || readyTimeout > 0, verify[kverify.SystemPodsWaitKey]
+}
+
 // Provision provisions the machine/container for the node
 func Provision(cc *config.ClusterConfig, n *config.Node, apiServer bool, delOnFail bool) (command.Runner, bool, libmachine.API, *host.Host, error) {
+	begin := time.Now()
 
 	name := driver.MachineName(*cc, *n)
 	if apiServer {
@@ -227,20 +448,38 @@ func Provision(cc *config.ClusterConfig, n *config.Node, apiServer bool, delOnFa
 		return nil, false, nil, nil, errors.Wrap(err, "Failed to save config")
 	}
 
-	handleDownloadOnly(&cacheGroup, &kicGroup, n.KubernetesVersion)
+	handleDownloadOnly(&cacheGroup, &kicGroup, n.KubernetesVersion, cc.KubernetesConfig.BinaryMirror)
 	waitDownloadKicBaseImage(&kicGroup)
 
-	return startMachine(cc, n, delOnFail)
+	r, p, m, h, err := startMachine(cc, n, delOnFail)
+	if err == nil {
+		metrics.Record(metrics.NodeProvisionDuration, time.Since(begin), nodeLabels(*cc, *n))
+	}
+	return r, p, m, h, err
+}
 
+// nodeLabels returns the metric labels identifying a node
+func nodeLabels(cc config.ClusterConfig, n config.Node) map[string]string {
+	return map[string]string{"profile": cc.Name, "node": driver.MachineName(cc, n)}
 }
 
 // ConfigureRuntimes does what needs to happen to get a runtime going.
-func configureRuntimes(runner cruntime.CommandRunner, cc config.ClusterConfig, kv semver.Version) cruntime.Manager {
+func configureRuntimes(runner cruntime.CommandRunner, cc config.ClusterConfig, n config.Node, kv semver.Version) cruntime.Manager {
 	co := cruntime.Config{
 		Type:              cc.KubernetesConfig.ContainerRuntime,
 		Runner:            runner,
 		ImageRepository:   cc.KubernetesConfig.ImageRepository,
 		KubernetesVersion: kv,
+		RegistryMirrors:   cc.RegistryMirror,
+		PauseImage:        n.PauseImage,
+		ProxyEnv:          machine.ProxyEnv(cc),
+	}
+	if len(n.RuntimeConfig) > 0 {
+		opts, err := cruntime.ParseOptions(cc.KubernetesConfig.ContainerRuntime, n.RuntimeConfig)
+		if err != nil {
+			out.WarningT("Ignoring the runtime config of node {{.name}}: {{.error}}", out.V{"name": n.Name, "error": err})
+		}
+		co.Options = opts
 	}
 	cr, err := cruntime.New(co)
 	if err != nil {
@@ -269,15 +508,32 @@ func configureRuntimes(runner cruntime.CommandRunner, cc config.ClusterConfig, k
 		}
 	}
 
-	err = cr.Enable(disableOthers, forceSystemd())
+	if len(cc.RegistryMirror) > 0 && cr.Name() == "CRI-O" {
+		out.WarningT("{{.runtime}} does not support --registry-mirror yet, images will be pulled without a mirror", out.V{"runtime": cr.Name()})
+	}
+
+	err = cr.Enable(disableOthers, forceSystemd(n))
 	if err != nil {
 		exit.WithError("Failed to enable container runtime", err)
 	}
 
+	// The kubelet follows whatever the runtime reports, so a mismatch here only means the request could not be honored
+	if n.CgroupDriver != "" {
+		if cg, err := cr.CGroupDriver(); err != nil {
+			glog.Warningf("unable to detect %s cgroup driver: %v", cr.Name(), err)
+		} else if cg != n.CgroupDriver {
+			out.WarningT("{{.runtime}} on node {{.name}} is using the {{.actual}} cgroup driver instead of the requested {{.requested}} driver", out.V{"runtime": cr.Name(), "name": n.Name, "actual": cg, "requested": n.CgroupDriver})
+		}
+	}
+
 	return cr
 }
 
-func forceSystemd() bool {
+// forceSystemd returns whether the runtime on the given node should use systemd as its cgroup manager
+func forceSystemd(n config.Node) bool {
+	if n.CgroupDriver != "" {
+		return n.CgroupDriver == "systemd"
+	}
 	return viper.GetBool("force-systemd") || os.Getenv(constants.MinikubeForceSystemdEnv) == "true"
 }
 
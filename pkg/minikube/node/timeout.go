@@ -0,0 +1,151 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/out"
+)
+
+// addPhases are the steps of a node add in the order they run, used to report its progress
+var addPhases = []string{
+	"saving the node",
+	"provisioning the machine",
+	"caching images",
+	"configuring the container runtime",
+	"updating the node",
+	"joining the cluster",
+	"applying the CNI",
+	"waiting for pod networking",
+	"waiting for the node to be registered",
+	"waiting for the node to be Ready",
+	"waiting for system pods",
+	"waiting for the selected pods",
+	"running the post-join script",
+	"waiting for images and addons",
+}
+
+// TimeoutError is returned when adding a node takes longer than allowed
+type TimeoutError struct {
+	// Phase is the step of the add which stalled
+	Phase   string
+	Timeout time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("timed out after %s while %s", e.Timeout, e.Phase)
+}
+
+// phase records the step a node add is currently at, so that a timeout can name it
+type phase struct {
+	mu   sync.Mutex
+	name string
+	// node is the name of the node being added, reported along with the progress
+	node string
+	// progress reports every step along with the share of the add completed
+	progress bool
+	// cancelled is set once the add timed out, stopping it before its next step
+	cancelled bool
+}
+
+// errCancelled is returned by an add which timed out, once the step it was at has finished
+var errCancelled = errors.New("node add cancelled")
+
+// set moves the add to the given step, or returns errCancelled if it timed out and must not go any further
+func (p *phase) set(name string) error {
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.cancelled {
+		glog.Infof("node %s: cancelled before %s", p.node, name)
+		return errCancelled
+	}
+	p.name = name
+
+	pct := progressPercent(name)
+	glog.Infof("node %s: %d%% - %s", p.node, pct, name)
+	if p.progress {
+		out.T(out.Waiting, "node {{.name}}: {{.percent}}% - {{.phase}}", out.V{"name": p.node, "percent": pct, "phase": name})
+	}
+	return nil
+}
+
+// done reports the add as complete
+func (p *phase) done() {
+	if p == nil {
+		return
+	}
+	glog.Infof("node %s: 100%% - added", p.node)
+	if p.progress {
+		out.T(out.Ready, "node {{.name}}: 100% - added", out.V{"name": p.node})
+	}
+}
+
+// cancel stops the add before its next step
+func (p *phase) cancel() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cancelled = true
+}
+
+func (p *phase) get() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.name
+}
+
+// progressPercent returns the share of a node add completed once it reaches the given step
+func progressPercent(name string) int {
+	for i, p := range addPhases {
+		if p == name {
+			return i * 100 / len(addPhases)
+		}
+	}
+	return 0
+}
+
+// AddWithOptions adds a node like Add, giving up once opts.Timeout has passed.
+// On timeout it returns right away: the add is abandoned, and cancelled before its next step should it still be running.
+func AddWithOptions(cc *config.ClusterConfig, n config.Node, delOnFail bool, opts AddOptions) error {
+	p := &phase{node: n.Name, progress: opts.Progress}
+	if opts.Timeout <= 0 {
+		return add(cc, n, delOnFail, opts, p)
+	}
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- add(cc, n, delOnFail, opts, p)
+	}()
+
+	select {
+	case err := <-errc:
+		return err
+	case <-time.After(opts.Timeout):
+		stalled := p.get()
+		p.cancel()
+		glog.Infof("node %s: abandoning the add, which timed out while %s", n.Name, stalled)
+		return &TimeoutError{Phase: stalled, Timeout: opts.Timeout}
+	}
+}
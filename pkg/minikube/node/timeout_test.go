@@ -0,0 +1,78 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeoutError(t *testing.T) {
+	var p *phase
+	// a nil phase is used by Add and must be safe to update
+	if err := p.set("joining the cluster"); err != nil {
+		t.Fatalf("set() on a nil phase unexpected error: %v", err)
+	}
+
+	p = &phase{}
+	for _, name := range []string{"provisioning the machine", "joining the cluster"} {
+		if err := p.set(name); err != nil {
+			t.Fatalf("set(%q) unexpected error: %v", name, err)
+		}
+	}
+
+	err := &TimeoutError{Phase: p.get(), Timeout: 8 * time.Minute}
+	want := "timed out after 8m0s while joining the cluster"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want: %q", got, want)
+	}
+}
+
+func TestPhaseCancel(t *testing.T) {
+	p := &phase{}
+	if err := p.set("joining the cluster"); err != nil {
+		t.Fatalf("set() unexpected error: %v", err)
+	}
+	p.cancel()
+	if err := p.set("applying the CNI"); err != errCancelled {
+		t.Errorf("set() after cancel = %v, want: %v", err, errCancelled)
+	}
+	// the step which stalled is still the one reported
+	if got := p.get(); got != "joining the cluster" {
+		t.Errorf("get() = %q, want: %q", got, "joining the cluster")
+	}
+}
+
+func TestProgressPercent(t *testing.T) {
+	var tests = []struct {
+		phase string
+		want  int
+	}{
+		{phase: "saving the node", want: 0},
+		{phase: "joining the cluster", want: 35},
+		{phase: "waiting for the node to be registered", want: 57},
+		{phase: "waiting for images and addons", want: 92},
+		{phase: "unknown", want: 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.phase, func(t *testing.T) {
+			if got := progressPercent(tc.phase); got != tc.want {
+				t.Errorf("progressPercent(%q) = %d, want: %d", tc.phase, got, tc.want)
+			}
+		})
+	}
+}
@@ -152,6 +152,55 @@ func UpdateTransport(cfg *rest.Config) *rest.Config {
 	return cfg
 }
 
+// AddNoProxy adds entries to the NO_PROXY variable of env, a list of KEY=VALUE pairs, if it sets a proxy
+func AddNoProxy(env []string, entries ...string) []string {
+	proxied := false
+	noProxy := -1
+	for i, e := range env {
+		switch strings.ToUpper(strings.SplitN(e, "=", 2)[0]) {
+		case "HTTP_PROXY", "HTTPS_PROXY":
+			proxied = true
+		case "NO_PROXY":
+			noProxy = i
+		}
+	}
+	if !proxied {
+		return env
+	}
+
+	key := "NO_PROXY"
+	var values []string
+	if noProxy >= 0 {
+		kv := strings.SplitN(env[noProxy], "=", 2)
+		key = kv[0]
+		if len(kv) == 2 && kv[1] != "" {
+			values = strings.Split(kv[1], ",")
+		}
+	}
+	for _, e := range entries {
+		if e == "" {
+			continue
+		}
+		seen := false
+		for _, v := range values {
+			if v == e {
+				seen = true
+				break
+			}
+		}
+		if !seen {
+			values = append(values, e)
+		}
+	}
+
+	merged := fmt.Sprintf("%s=%s", key, strings.Join(values, ","))
+	if noProxy >= 0 {
+		env[noProxy] = merged
+		return env
+	}
+	return append(env, merged)
+}
+
 // SetDockerEnv sets the proxy environment variables in the docker environment.
 func SetDockerEnv() []string {
 	for _, k := range EnvVars {
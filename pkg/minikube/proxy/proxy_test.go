@@ -258,3 +258,24 @@ func TestUpdateTransport(t *testing.T) {
 		}
 	})
 }
+
+func TestAddNoProxy(t *testing.T) {
+	var testCases = []struct {
+		env     []string
+		entries []string
+		want    []string
+	}{
+		{[]string{"FOO=BAR"}, []string{"10.96.0.0/12"}, []string{"FOO=BAR"}},
+		{[]string{"HTTP_PROXY=http://proxy:3128"}, []string{"10.96.0.0/12", ""}, []string{"HTTP_PROXY=http://proxy:3128", "NO_PROXY=10.96.0.0/12"}},
+		{[]string{"HTTPS_PROXY=http://proxy:3128", "NO_PROXY=localhost,10.96.0.0/12"}, []string{"10.96.0.0/12", "10.244.0.0/16"}, []string{"HTTPS_PROXY=http://proxy:3128", "NO_PROXY=localhost,10.96.0.0/12,10.244.0.0/16"}},
+		{[]string{"no_proxy=", "http_proxy=http://proxy:3128"}, []string{"192.168.39.3"}, []string{"no_proxy=192.168.39.3", "http_proxy=http://proxy:3128"}},
+	}
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("%v", tc.env), func(t *testing.T) {
+			got := AddNoProxy(tc.env, tc.entries...)
+			if fmt.Sprintf("%q", got) != fmt.Sprintf("%q", tc.want) {
+				t.Errorf("AddNoProxy(%v, %v) = %q, want: %q", tc.env, tc.entries, got, tc.want)
+			}
+		})
+	}
+}
@@ -59,6 +59,9 @@ func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 		APIServerPort:     cc.Nodes[0].Port,
 		KubernetesVersion: cc.KubernetesConfig.KubernetesVersion,
 		ContainerRuntime:  cc.KubernetesConfig.ContainerRuntime,
+		GPUs:              n.GPUs,
+		Arch:              n.Arch,
+		ListenAddress:     n.ListenAddress,
 	}), nil
 }
 
@@ -29,6 +29,8 @@ import (
 
 	"github.com/docker/machine/libmachine/drivers"
 
+	pkgdrivers "k8s.io/minikube/pkg/drivers"
+
 	"k8s.io/minikube/pkg/minikube/config"
 	"k8s.io/minikube/pkg/minikube/download"
 	"k8s.io/minikube/pkg/minikube/driver"
@@ -66,10 +68,19 @@ type kvmDriver struct {
 	GPU            bool
 	Hidden         bool
 	ConnectionURI  string
+	ExtraDisks     int
+	ExtraDiskSize  int
 }
 
 func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 	name := driver.MachineName(cc, n)
+	if n.ExtraDisks > pkgdrivers.MaxExtraDisks {
+		return nil, fmt.Errorf("node %s has %d extra disks, at most %d are supported", name, n.ExtraDisks, pkgdrivers.MaxExtraDisks)
+	}
+	iso := cc.MinikubeISO
+	if n.MinikubeISO != "" {
+		iso = n.MinikubeISO
+	}
 	return kvmDriver{
 		BaseDriver: &drivers.BaseDriver{
 			MachineName: name,
@@ -80,13 +91,15 @@ func configure(cc config.ClusterConfig, n config.Node) (interface{}, error) {
 		CPU:            cc.CPUs,
 		Network:        cc.KVMNetwork,
 		PrivateNetwork: "minikube-net",
-		Boot2DockerURL: download.LocalISOResource(cc.MinikubeISO),
+		Boot2DockerURL: download.LocalISOResource(iso),
 		DiskSize:       cc.DiskSize,
 		DiskPath:       filepath.Join(localpath.MiniPath(), "machines", name, fmt.Sprintf("%s.rawdisk", name)),
 		ISO:            filepath.Join(localpath.MiniPath(), "machines", name, "boot2docker.iso"),
-		GPU:            cc.KVMGPU,
+		GPU:            cc.KVMGPU || n.GPUs != "",
 		Hidden:         cc.KVMHidden,
 		ConnectionURI:  cc.KVMQemuURI,
+		ExtraDisks:     n.ExtraDisks,
+		ExtraDiskSize:  n.ExtraDiskSize,
 	}, nil
 }
 
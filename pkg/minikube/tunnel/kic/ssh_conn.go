@@ -18,7 +18,9 @@ package kic
 
 import (
 	"fmt"
+	"net"
 	"os/exec"
+	"strconv"
 
 	"github.com/phayes/freeport"
 	v1 "k8s.io/api/core/v1"
@@ -33,16 +35,27 @@ type sshConn struct {
 	ports   []int
 }
 
-func createSSHConn(name, sshPort, sshKey string, svc *v1.Service) *sshConn {
+// SSHNode is a node services can be tunneled through
+type SSHNode struct {
+	// Name is the name of the Kubernetes node
+	Name string
+	// Host and Port are where the SSH server of the node is published on the host
+	Host string
+	Port string
+	// Key is the private key to log in with
+	Key string
+}
+
+func createSSHConn(name string, node SSHNode, bindAddress string, svc *v1.Service) *sshConn {
 	// extract sshArgs
 	sshArgs := []string{
 		// TODO: document the options here
 		"-o", "UserKnownHostsFile=/dev/null",
 		"-o", "StrictHostKeyChecking no",
 		"-N",
-		"docker@127.0.0.1",
-		"-p", sshPort,
-		"-i", sshKey,
+		"docker@" + node.Host,
+		"-p", node.Port,
+		"-i", node.Key,
 	}
 
 	askForSudo := false
@@ -54,6 +67,10 @@ func createSSHConn(name, sshPort, sshKey string, svc *v1.Service) *sshConn {
 			svc.Spec.ClusterIP,
 			port.Port,
 		)
+		if bindAddress != "" {
+			// IPv6 addresses are bracketed, as ssh splits the forwarding on colons
+			arg = fmt.Sprintf("-L %s:%s:%d", net.JoinHostPort(bindAddress, strconv.Itoa(int(port.Port))), svc.Spec.ClusterIP, port.Port)
+		}
 
 		// check if any port is privileged
 		if port.Port < 1024 {
@@ -0,0 +1,104 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kic
+
+import (
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCreateSSHConnBindAddress(t *testing.T) {
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "nginx"},
+		Spec: v1.ServiceSpec{
+			ClusterIP: "10.96.0.10",
+			Ports:     []v1.ServicePort{{Port: 8080}},
+		},
+	}
+
+	var tests = []struct {
+		bindAddress string
+		want        string
+	}{
+		{"", "-L 8080:10.96.0.10:8080"},
+		{"0.0.0.0", "-L 0.0.0.0:8080:10.96.0.10:8080"},
+		{"192.168.1.5", "-L 192.168.1.5:8080:10.96.0.10:8080"},
+		{"::1", "-L [::1]:8080:10.96.0.10:8080"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.bindAddress, func(t *testing.T) {
+			c := createSSHConn("nginx", SSHNode{Name: "minikube", Host: "127.0.0.1", Port: "22", Key: "id_rsa"}, tc.bindAddress, svc)
+			got := strings.Join(c.cmd.Args, " ")
+			if !strings.Contains(got, tc.want) {
+				t.Errorf("createSSHConn(%q) args = %q, want to contain: %q", tc.bindAddress, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIngressIP(t *testing.T) {
+	var tests = []struct {
+		bindAddress string
+		want        string
+	}{
+		{"", "127.0.0.1"},
+		{"0.0.0.0", "127.0.0.1"},
+		{"::", "127.0.0.1"},
+		{"192.168.1.5", "192.168.1.5"},
+	}
+	for _, tc := range tests {
+		got := ingressIP(tc.bindAddress)
+		if got != tc.want {
+			t.Errorf("ingressIP(%q) = %q, want: %q", tc.bindAddress, got, tc.want)
+		}
+	}
+}
+
+func TestRouteNode(t *testing.T) {
+	nodes := []SSHNode{{Name: "minikube", Port: "32771"}, {Name: "minikube-m02", Port: "32775"}}
+	nodeName := func(name string) *string { return &name }
+	endpoints := func(names ...*string) *v1.Endpoints {
+		var addrs []v1.EndpointAddress
+		for _, n := range names {
+			addrs = append(addrs, v1.EndpointAddress{IP: "10.244.1.2", NodeName: n})
+		}
+		return &v1.Endpoints{Subsets: []v1.EndpointSubset{{Addresses: addrs}}}
+	}
+
+	var tests = []struct {
+		description string
+		endpoints   *v1.Endpoints
+		want        string
+	}{
+		{"no endpoints", nil, "minikube"},
+		{"no ready endpoints", &v1.Endpoints{}, "minikube"},
+		{"endpoint on worker", endpoints(nodeName("minikube-m02")), "minikube-m02"},
+		{"endpoint without node", endpoints(nil, nodeName("minikube-m02")), "minikube-m02"},
+		{"endpoint on unknown node", endpoints(nodeName("other")), "minikube"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			got := routeNode(nodes, tc.endpoints)
+			if got.Name != tc.want {
+				t.Errorf("routeNode() = %q, want: %q", got.Name, tc.want)
+			}
+		})
+	}
+}
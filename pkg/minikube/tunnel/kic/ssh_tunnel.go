@@ -19,6 +19,7 @@ package kic
 import (
 	"context"
 	"fmt"
+	"net"
 	"strings"
 	"time"
 
@@ -33,8 +34,8 @@ import (
 // SSHTunnel ...
 type SSHTunnel struct {
 	ctx                  context.Context
-	sshPort              string
-	sshKey               string
+	nodes                []SSHNode
+	bindAddress          string
 	v1Core               typed_core.CoreV1Interface
 	LoadBalancerEmulator tunnel.LoadBalancerEmulator
 	conns                map[string]*sshConn
@@ -42,11 +43,13 @@ type SSHTunnel struct {
 }
 
 // NewSSHTunnel ...
-func NewSSHTunnel(ctx context.Context, sshPort, sshKey string, v1Core typed_core.CoreV1Interface) *SSHTunnel {
+// Services are tunneled through the node running their pods, or else through the first of nodes, the primary control plane.
+// bindAddress is the local address the tunnels listen on, empty means the ssh default (localhost)
+func NewSSHTunnel(ctx context.Context, nodes []SSHNode, bindAddress string, v1Core typed_core.CoreV1Interface) *SSHTunnel {
 	return &SSHTunnel{
 		ctx:                  ctx,
-		sshPort:              sshPort,
-		sshKey:               sshKey,
+		nodes:                nodes,
+		bindAddress:          bindAddress,
 		v1Core:               v1Core,
 		LoadBalancerEmulator: tunnel.NewLoadBalancerEmulator(v1Core),
 		conns:                make(map[string]*sshConn),
@@ -104,7 +107,13 @@ func (t *SSHTunnel) startConnection(svc v1.Service) {
 	}
 
 	// create new ssh conn
-	newSSHConn := createSSHConn(uniqName, t.sshPort, t.sshKey, &svc)
+	var endpoints *v1.Endpoints
+	if ep, err := t.v1Core.Endpoints(svc.Namespace).Get(svc.Name, metav1.GetOptions{}); err != nil {
+		glog.Infof("unable to get the endpoints of %s, tunneling through %s: %v", svc.Name, t.nodes[0].Name, err)
+	} else {
+		endpoints = ep
+	}
+	newSSHConn := createSSHConn(uniqName, routeNode(t.nodes, endpoints), t.bindAddress, &svc)
 	t.conns[newSSHConn.name] = newSSHConn
 
 	go func() {
@@ -114,7 +123,7 @@ func (t *SSHTunnel) startConnection(svc v1.Service) {
 		}
 	}()
 
-	err := t.LoadBalancerEmulator.PatchServiceIP(t.v1Core.RESTClient(), svc, "127.0.0.1")
+	err := t.LoadBalancerEmulator.PatchServiceIP(t.v1Core.RESTClient(), svc, ingressIP(t.bindAddress))
 	if err != nil {
 		glog.Errorf("error patching service: %v", err)
 	}
@@ -147,3 +156,29 @@ func sshConnUniqName(service v1.Service) string {
 
 	return strings.Join(n, "")
 }
+
+// routeNode returns the node to tunnel a service through: the first node running one of its ready endpoints,
+// or else the first node
+func routeNode(nodes []SSHNode, endpoints *v1.Endpoints) SSHNode {
+	if endpoints != nil {
+		for _, s := range endpoints.Subsets {
+			for _, a := range s.Addresses {
+				for _, n := range nodes {
+					if a.NodeName != nil && *a.NodeName == n.Name {
+						return n
+					}
+				}
+			}
+		}
+	}
+	return nodes[0]
+}
+
+// ingressIP returns the IP to advertise for services listening on bindAddress
+func ingressIP(bindAddress string) string {
+	ip := net.ParseIP(bindAddress)
+	if ip == nil || ip.IsUnspecified() {
+		return "127.0.0.1"
+	}
+	return ip.String()
+}
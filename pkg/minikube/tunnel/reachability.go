@@ -0,0 +1,59 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/golang/glog"
+
+	"k8s.io/minikube/pkg/minikube/config"
+	"k8s.io/minikube/pkg/minikube/driver"
+)
+
+// reachTimeout is how long connecting to a node may take before it is reported unreachable
+const reachTimeout = 3 * time.Second
+
+// UnreachableNodes returns the names of the nodes whose ssh port can not be connected to from the host.
+// Services routed through the tunnel may be backed by pods on any node, so every node should be reachable.
+func UnreachableNodes(cc config.ClusterConfig) []string {
+	return unreachableNodes(cc, func(addr string) error {
+		conn, err := net.DialTimeout("tcp", addr, reachTimeout)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	})
+}
+
+func unreachableNodes(cc config.ClusterConfig, dial func(addr string) error) []string {
+	var unreachable []string
+	for _, n := range cc.Nodes {
+		name := driver.MachineName(cc, n)
+		if n.IP == "" {
+			glog.Infof("%s has no IP, not checking whether it is reachable", name)
+			continue
+		}
+		if err := dial(net.JoinHostPort(n.IP, strconv.Itoa(22))); err != nil {
+			glog.Warningf("%s is not reachable: %v", name, err)
+			unreachable = append(unreachable, name)
+		}
+	}
+	return unreachable
+}
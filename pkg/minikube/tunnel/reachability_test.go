@@ -0,0 +1,51 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"k8s.io/minikube/pkg/minikube/config"
+)
+
+func TestUnreachableNodes(t *testing.T) {
+	cc := config.ClusterConfig{
+		Name: "minikube",
+		Nodes: []config.Node{
+			{Name: "", IP: "192.168.39.2", ControlPlane: true},
+			{Name: "m02", IP: "192.168.39.3"},
+			{Name: "m03"},
+		},
+	}
+	var dialed []string
+	got := unreachableNodes(cc, func(addr string) error {
+		dialed = append(dialed, addr)
+		if addr == "192.168.39.3:22" {
+			return errors.New("no route to host")
+		}
+		return nil
+	})
+
+	if want := []string{"minikube-m02"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unreachableNodes() = %v, want: %v", got, want)
+	}
+	if want := []string{"192.168.39.2:22", "192.168.39.3:22"}; !reflect.DeepEqual(dialed, want) {
+		t.Errorf("dialed %v, want: %v", dialed, want)
+	}
+}
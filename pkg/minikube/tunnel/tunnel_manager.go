@@ -37,6 +37,8 @@ type Manager struct {
 	delay    time.Duration
 	registry *persistentRegistry
 	router   router
+	// keepRoutes leaves the routes and patched services in place when the tunnel stops
+	keepRoutes bool
 }
 
 // stateCheckInterval defines how frequently the cluster and route states are checked
@@ -123,9 +125,40 @@ func (mgr *Manager) run(ctx context.Context, t controller, ready, check, done ch
 }
 
 func (mgr *Manager) cleanup(t controller) {
+	if mgr.keepRoutes {
+		glog.Infof("keeping the routes of the tunnel in place")
+		return
+	}
 	t.cleanup()
 }
 
+// KeepRoutesOnExit leaves the routes of the tunnel in place when it stops, until a later tunnel cleans them up
+func (mgr *Manager) KeepRoutesOnExit() {
+	mgr.keepRoutes = true
+}
+
+// CleanupOwnTunnels removes the routes registered by this process, such as ones left over when stopping the tunnel failed
+func (mgr *Manager) CleanupOwnTunnels() error {
+	tunnels, err := mgr.registry.List()
+	if err != nil {
+		return fmt.Errorf("error listing tunnels from registry: %s", err)
+	}
+
+	for _, tunnel := range tunnels {
+		if tunnel.Pid != getPid() {
+			continue
+		}
+		glog.Infof("cleaning up leftover %v", tunnel)
+		if err := mgr.router.Cleanup(tunnel.Route); err != nil {
+			return err
+		}
+		if err := mgr.registry.Remove(tunnel.Route); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // CleanupNotRunningTunnels cleans up tunnels that are not running
 func (mgr *Manager) CleanupNotRunningTunnels() error {
 	tunnels, err := mgr.registry.List()
@@ -283,6 +283,68 @@ func TestTunnelManagerCleanup(t *testing.T) {
 
 }
 
+func TestTunnelManagerCleanupOwnTunnels(t *testing.T) {
+	reg, cleanup := createTestRegistry(t)
+	defer cleanup()
+
+	own := &ID{
+		Route:       unsafeParseRoute("1.2.3.4", "5.6.7.8/9"),
+		Pid:         os.Getpid(),
+		MachineName: "minikube",
+	}
+	if err := reg.Register(own); err != nil {
+		t.Errorf("expected no error got: %v", err)
+	}
+	other := &ID{
+		Route:       unsafeParseRoute("150.2.3.4", "30.6.7.8/9"),
+		Pid:         os.Getpid() + 1,
+		MachineName: "minikube",
+	}
+	if err := reg.Register(other); err != nil {
+		t.Errorf("expected no error got: %v", err)
+	}
+
+	router := &fakeRouter{}
+	if err := router.EnsureRouteIsAdded(own.Route); err != nil {
+		t.Errorf("expected no error got: %v", err)
+	}
+	if err := router.EnsureRouteIsAdded(other.Route); err != nil {
+		t.Errorf("expected no error got: %v", err)
+	}
+
+	manager := NewManager()
+	manager.router = router
+	manager.registry = reg
+
+	if err := manager.CleanupOwnTunnels(); err != nil {
+		t.Errorf("expected no error got: %v", err)
+	}
+
+	if len(router.rt) != 1 || !router.rt[0].route.Equal(other.Route) {
+		t.Errorf("expected only the route of the other process to stay, got: %s", router.rt.String())
+	}
+
+	tunnels, err := reg.List()
+	if err != nil {
+		t.Errorf("expected no error got: %v", err)
+	}
+	if len(tunnels) != 1 || !tunnels[0].Equal(other) {
+		t.Errorf("expected only the tunnel of the other process to stay, got: %v", tunnels)
+	}
+}
+
+func TestTunnelManagerKeepRoutes(t *testing.T) {
+	tunnelManager := &Manager{}
+	tunnelManager.KeepRoutesOnExit()
+	tunnel := &tunnelStub{tunnelExists: true}
+
+	tunnelManager.cleanup(tunnel)
+
+	if !tunnel.tunnelExists {
+		t.Errorf("expected the tunnel to be kept")
+	}
+}
+
 type tunnelStub struct {
 	mockClusterInfo *Status
 	tunnelExists    bool
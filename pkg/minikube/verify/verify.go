@@ -0,0 +1,403 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package verify runs post-start checks against a running cluster
+package verify
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// namespace is where the objects created by the checks live
+	namespace = "default"
+	// image is used by every pod created by the checks
+	image = "busybox:1.28.4-glibc"
+	// pollInterval is how often the state of created objects is polled
+	pollInterval = 2 * time.Second
+	// servicePort is the port of the service created by ServiceConnectivity, and of the pod behind it
+	servicePort = 8080
+)
+
+// Check is a single verification of a running cluster
+type Check struct {
+	Name string
+	Run  func(kubernetes.Interface, time.Duration) (string, error)
+}
+
+// Result is the outcome of a Check
+type Result struct {
+	Name    string
+	Skipped string
+	Err     error
+}
+
+// Checks returns the checks run by default, in order
+func Checks() []Check {
+	return []Check{
+		{Name: "Nodes are Ready", Run: NodesReady},
+		{Name: "CoreDNS resolves cluster names", Run: DNSResolution},
+		{Name: "Pods on different nodes can reach each other", Run: PodConnectivity},
+		{Name: "Storage can be provisioned", Run: StorageProvisioning},
+	}
+}
+
+// NetworkChecks returns the checks run by start --verify-network, in order
+func NetworkChecks() []Check {
+	return []Check{
+		{Name: "Nodes are Ready", Run: NodesReady},
+		{Name: "Pods on every node can reach each other and the service network", Run: NetworkMesh},
+	}
+}
+
+// CNIChecks returns the checks run by verify-cni, in order
+func CNIChecks() []Check {
+	return []Check{
+		{Name: "Nodes are Ready", Run: NodesReady},
+		{Name: "Pods on every node can reach each other and the service network", Run: NetworkMesh},
+		{Name: "Pods on every node can reach a service", Run: ServiceConnectivity},
+	}
+}
+
+// Run runs every check, giving each of them up to timeout to complete
+func Run(client kubernetes.Interface, checks []Check, timeout time.Duration) []Result {
+	var results []Result
+	for _, c := range checks {
+		glog.Infof("running check %q ...", c.Name)
+		skipped, err := c.Run(client, timeout)
+		results = append(results, Result{Name: c.Name, Skipped: skipped, Err: err})
+	}
+	return results
+}
+
+// Failed returns the number of failed results
+func Failed(results []Result) int {
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+		}
+	}
+	return failed
+}
+
+// NodesReady checks that every node reports the Ready condition
+func NodesReady(client kubernetes.Interface, _ time.Duration) (string, error) {
+	ns, err := client.CoreV1().Nodes().List(meta.ListOptions{})
+	if err != nil {
+		return "", errors.Wrap(err, "listing nodes")
+	}
+	if len(ns.Items) == 0 {
+		return "", fmt.Errorf("no nodes found")
+	}
+
+	var notReady []string
+	for _, n := range ns.Items {
+		if !nodeReady(n) {
+			notReady = append(notReady, n.Name)
+		}
+	}
+	if len(notReady) > 0 {
+		return "", fmt.Errorf("nodes not Ready: %s", strings.Join(notReady, ", "))
+	}
+	return "", nil
+}
+
+// DNSResolution checks that a pod is able to resolve the kubernetes service through CoreDNS
+func DNSResolution(client kubernetes.Interface, timeout time.Duration) (string, error) {
+	p := pod("minikube-verify-dns", "", "nslookup", "kubernetes.default")
+	return "", runPod(client, p, timeout)
+}
+
+// PodConnectivity checks that a pod is able to ping a pod on another node
+func PodConnectivity(client kubernetes.Interface, timeout time.Duration) (string, error) {
+	nodes, err := schedulableNodes(client)
+	if err != nil {
+		return "", err
+	}
+	if len(nodes) < 2 {
+		return "needs at least two schedulable nodes", nil
+	}
+
+	server := pod("minikube-verify-server", nodes[0], "sleep", "3600")
+	if _, err := client.CoreV1().Pods(namespace).Create(server); err != nil {
+		return "", errors.Wrap(err, "creating server pod")
+	}
+	defer deletePod(client, server.Name)
+
+	ip, err := waitForPodIP(client, server.Name, timeout)
+	if err != nil {
+		return "", errors.Wrapf(err, "waiting for %s on %s", server.Name, nodes[0])
+	}
+
+	pinger := pod("minikube-verify-client", nodes[1], "ping", "-c", "3", ip)
+	return "", runPod(client, pinger, timeout)
+}
+
+// NetworkMesh checks that a pod on every schedulable node is able to ping a pod on every other node,
+// and to reach the service network by resolving the kubernetes service through the cluster DNS service
+func NetworkMesh(client kubernetes.Interface, timeout time.Duration) (string, error) {
+	nodes, err := schedulableNodes(client)
+	if err != nil {
+		return "", err
+	}
+	if len(nodes) < 2 {
+		return "needs at least two schedulable nodes", nil
+	}
+
+	ips := map[string]string{}
+	var targets []string
+	for i, n := range nodes {
+		target := pod(fmt.Sprintf("minikube-verify-target-%d", i), n, "sleep", "3600")
+		if _, err := client.CoreV1().Pods(namespace).Create(target); err != nil {
+			return "", errors.Wrapf(err, "creating target pod on %s", n)
+		}
+		defer deletePod(client, target.Name)
+		targets = append(targets, target.Name)
+	}
+	for i, n := range nodes {
+		ip, err := waitForPodIP(client, targets[i], timeout)
+		if err != nil {
+			return "", errors.Wrapf(err, "waiting for %s on %s", targets[i], n)
+		}
+		ips[n] = ip
+	}
+
+	var failed []string
+	for i, n := range nodes {
+		probe := pod(fmt.Sprintf("minikube-verify-probe-%d", i), n, "sh", "-c", meshScript(n, nodes, ips))
+		if err := runPod(client, probe, timeout); err != nil {
+			glog.Warningf("network check from %s failed: %v", n, err)
+			failed = append(failed, n)
+		}
+	}
+	if len(failed) > 0 {
+		return "", fmt.Errorf("pods on %s could not reach every other node or the service network", strings.Join(failed, ", "))
+	}
+	return "", nil
+}
+
+// meshScript returns the shell script run on node, pinging the pod on every other node and resolving the kubernetes service
+func meshScript(node string, nodes []string, ips map[string]string) string {
+	var steps []string
+	for _, n := range nodes {
+		if n == node {
+			continue
+		}
+		steps = append(steps, fmt.Sprintf("ping -c 3 %s", ips[n]))
+	}
+	steps = append(steps, "nslookup kubernetes.default")
+	return strings.Join(steps, " && ")
+}
+
+// ServiceConnectivity checks that a pod on every schedulable node is able to reach a pod on the first node through its service
+func ServiceConnectivity(client kubernetes.Interface, timeout time.Duration) (string, error) {
+	nodes, err := schedulableNodes(client)
+	if err != nil {
+		return "", err
+	}
+	if len(nodes) == 0 {
+		return "", fmt.Errorf("no schedulable nodes")
+	}
+
+	// the service and the label selecting its backend are unique to this run, so leftovers of an interrupted run are never selected
+	run := uniqueName("minikube-verify-svc")
+	backend := pod("minikube-verify-backend", nodes[0], "sh", "-c", fmt.Sprintf("echo minikube > /tmp/index.html && httpd -f -p %d -h /tmp", servicePort))
+	backend.Labels["minikube-verify-run"] = run
+	if _, err := client.CoreV1().Pods(namespace).Create(backend); err != nil {
+		return "", errors.Wrap(err, "creating backend pod")
+	}
+	defer deletePod(client, backend.Name)
+
+	svc := &core.Service{
+		ObjectMeta: meta.ObjectMeta{Name: run},
+		Spec: core.ServiceSpec{
+			Selector: map[string]string{"minikube-verify-run": run},
+			Ports:    []core.ServicePort{{Port: servicePort, TargetPort: intstr.FromInt(servicePort)}},
+		},
+	}
+	svc, err = client.CoreV1().Services(namespace).Create(svc)
+	if err != nil {
+		return "", errors.Wrap(err, "creating service")
+	}
+	defer func() {
+		if err := client.CoreV1().Services(namespace).Delete(svc.Name, &meta.DeleteOptions{}); err != nil {
+			glog.Warningf("unable to delete %s: %v", svc.Name, err)
+		}
+	}()
+
+	if _, err := waitForPodIP(client, backend.Name, timeout); err != nil {
+		return "", errors.Wrapf(err, "waiting for %s on %s", backend.Name, nodes[0])
+	}
+
+	var failed []string
+	for i, n := range nodes {
+		probe := pod(fmt.Sprintf("minikube-verify-svc-probe-%d", i), n, "sh", "-c", serviceScript(svc.Spec.ClusterIP, servicePort))
+		if err := runPod(client, probe, timeout); err != nil {
+			glog.Warningf("service check from %s failed: %v", n, err)
+			failed = append(failed, n)
+		}
+	}
+	if len(failed) > 0 {
+		return "", fmt.Errorf("pods on %s could not reach service %s", strings.Join(failed, ", "), svc.Name)
+	}
+	return "", nil
+}
+
+// serviceScript returns the shell script fetching the page served behind a service,
+// retrying for a while as kube-proxy may not have programmed the service yet
+func serviceScript(ip string, port int) string {
+	return fmt.Sprintf("for i in 1 2 3 4 5; do wget -q -T 5 -O - http://%s && exit 0; sleep 2; done; exit 1", net.JoinHostPort(ip, strconv.Itoa(port)))
+}
+
+// StorageProvisioning checks that a claim against the default storage class is bound and writable
+func StorageProvisioning(client kubernetes.Interface, timeout time.Duration) (string, error) {
+	pvc := &core.PersistentVolumeClaim{
+		ObjectMeta: meta.ObjectMeta{Name: uniqueName("minikube-verify-pvc")},
+		Spec: core.PersistentVolumeClaimSpec{
+			AccessModes: []core.PersistentVolumeAccessMode{core.ReadWriteOnce},
+			Resources: core.ResourceRequirements{
+				Requests: core.ResourceList{core.ResourceStorage: resource.MustParse("1Mi")},
+			},
+		},
+	}
+	if _, err := client.CoreV1().PersistentVolumeClaims(namespace).Create(pvc); err != nil {
+		return "", errors.Wrap(err, "creating claim")
+	}
+	defer func() {
+		if err := client.CoreV1().PersistentVolumeClaims(namespace).Delete(pvc.Name, &meta.DeleteOptions{}); err != nil {
+			glog.Warningf("unable to delete %s: %v", pvc.Name, err)
+		}
+	}()
+
+	p := pod("minikube-verify-storage", "", "sh", "-c", "echo minikube > /data/verify && cat /data/verify")
+	p.Spec.Volumes = []core.Volume{{
+		Name:         "data",
+		VolumeSource: core.VolumeSource{PersistentVolumeClaim: &core.PersistentVolumeClaimVolumeSource{ClaimName: pvc.Name}},
+	}}
+	p.Spec.Containers[0].VolumeMounts = []core.VolumeMount{{Name: "data", MountPath: "/data"}}
+	return "", runPod(client, p, timeout)
+}
+
+// schedulableNodes returns the names of the Ready nodes which accept pods
+func schedulableNodes(client kubernetes.Interface) ([]string, error) {
+	ns, err := client.CoreV1().Nodes().List(meta.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing nodes")
+	}
+
+	var nodes []string
+	for _, n := range ns.Items {
+		if nodeReady(n) && !n.Spec.Unschedulable {
+			nodes = append(nodes, n.Name)
+		}
+	}
+	return nodes, nil
+}
+
+// waitForPodIP waits for a pod to be running and returns its IP
+func waitForPodIP(client kubernetes.Interface, name string, timeout time.Duration) (string, error) {
+	var ip string
+	err := wait.PollImmediate(pollInterval, timeout, func() (bool, error) {
+		p, err := client.CoreV1().Pods(namespace).Get(name, meta.GetOptions{})
+		if err != nil {
+			glog.Infof("unable to get %s, will retry: %v", name, err)
+			return false, nil
+		}
+		ip = p.Status.PodIP
+		return p.Status.Phase == core.PodRunning && ip != "", nil
+	})
+	return ip, err
+}
+
+func nodeReady(n core.Node) bool {
+	for _, c := range n.Status.Conditions {
+		if c.Type == core.NodeReady {
+			return c.Status == core.ConditionTrue
+		}
+	}
+	return false
+}
+
+// uniqueName returns name with a random suffix, so objects left behind by an interrupted run do not clash with the next one
+func uniqueName(name string) string {
+	return fmt.Sprintf("%s-%s", name, rand.String(5))
+}
+
+// pod returns a pod which runs command to completion, optionally on a specific node, named after name
+func pod(name string, node string, command ...string) *core.Pod {
+	return &core.Pod{
+		ObjectMeta: meta.ObjectMeta{
+			Name:   uniqueName(name),
+			Labels: map[string]string{"app": "minikube-verify"},
+		},
+		Spec: core.PodSpec{
+			NodeName:      node,
+			RestartPolicy: core.RestartPolicyNever,
+			Containers: []core.Container{{
+				Name:    "verify",
+				Image:   image,
+				Command: command,
+			}},
+		},
+	}
+}
+
+// runPod creates a pod and waits for it to run to completion, always deleting it afterwards
+func runPod(client kubernetes.Interface, p *core.Pod, timeout time.Duration) error {
+	if _, err := client.CoreV1().Pods(namespace).Create(p); err != nil {
+		return errors.Wrapf(err, "creating %s", p.Name)
+	}
+	defer deletePod(client, p.Name)
+
+	var phase core.PodPhase
+	err := wait.PollImmediate(pollInterval, timeout, func() (bool, error) {
+		got, err := client.CoreV1().Pods(namespace).Get(p.Name, meta.GetOptions{})
+		if err != nil {
+			glog.Infof("unable to get %s, will retry: %v", p.Name, err)
+			return false, nil
+		}
+		phase = got.Status.Phase
+		return phase == core.PodSucceeded || phase == core.PodFailed, nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "waiting for %s to complete (phase %q)", p.Name, phase)
+	}
+	if phase == core.PodFailed {
+		return fmt.Errorf("%s failed: %s", p.Name, strings.Join(p.Spec.Containers[0].Command, " "))
+	}
+	return nil
+}
+
+func deletePod(client kubernetes.Interface, name string) {
+	if err := client.CoreV1().Pods(namespace).Delete(name, &meta.DeleteOptions{}); err != nil {
+		glog.Warningf("unable to delete %s: %v", name, err)
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2020 The Kubernetes Authors All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verify
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func node(name string, ready core.ConditionStatus) *core.Node {
+	return &core.Node{
+		ObjectMeta: meta.ObjectMeta{Name: name},
+		Status: core.NodeStatus{
+			Conditions: []core.NodeCondition{{Type: core.NodeReady, Status: ready}},
+		},
+	}
+}
+
+func TestNodesReady(t *testing.T) {
+	var tests = []struct {
+		description string
+		nodes       []runtime.Object
+		shouldErr   bool
+	}{
+		{"no nodes", nil, true},
+		{"single ready node", []runtime.Object{node("minikube", core.ConditionTrue)}, false},
+		{"all ready", []runtime.Object{node("minikube", core.ConditionTrue), node("minikube-m02", core.ConditionTrue)}, false},
+		{"worker not ready", []runtime.Object{node("minikube", core.ConditionTrue), node("minikube-m02", core.ConditionFalse)}, true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			_, err := NodesReady(fake.NewSimpleClientset(tc.nodes...), time.Second)
+			if err != nil && !tc.shouldErr {
+				t.Errorf("NodesReady() unexpected error: %v", err)
+			}
+			if err == nil && tc.shouldErr {
+				t.Errorf("NodesReady() expected error but got none")
+			}
+		})
+	}
+}
+
+func TestPodConnectivitySkipsSingleNode(t *testing.T) {
+	client := fake.NewSimpleClientset(node("minikube", core.ConditionTrue), node("minikube-m02", core.ConditionFalse))
+	skipped, err := PodConnectivity(client, time.Second)
+	if err != nil {
+		t.Fatalf("PodConnectivity() unexpected error: %v", err)
+	}
+	if skipped == "" {
+		t.Errorf("PodConnectivity() was not skipped with a single Ready node")
+	}
+}
+
+func TestNetworkMeshSkipsSingleNode(t *testing.T) {
+	unschedulable := node("minikube-m02", core.ConditionTrue)
+	unschedulable.Spec.Unschedulable = true
+	client := fake.NewSimpleClientset(node("minikube", core.ConditionTrue), unschedulable)
+	skipped, err := NetworkMesh(client, time.Second)
+	if err != nil {
+		t.Fatalf("NetworkMesh() unexpected error: %v", err)
+	}
+	if skipped == "" {
+		t.Errorf("NetworkMesh() was not skipped with a single schedulable node")
+	}
+}
+
+func TestMeshScript(t *testing.T) {
+	nodes := []string{"minikube", "minikube-m02", "minikube-m03"}
+	ips := map[string]string{"minikube": "10.244.0.4", "minikube-m02": "10.244.1.2", "minikube-m03": "10.244.2.2"}
+	var tests = []struct {
+		node string
+		want string
+	}{
+		{"minikube", "ping -c 3 10.244.1.2 && ping -c 3 10.244.2.2 && nslookup kubernetes.default"},
+		{"minikube-m02", "ping -c 3 10.244.0.4 && ping -c 3 10.244.2.2 && nslookup kubernetes.default"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.node, func(t *testing.T) {
+			if got := meshScript(tc.node, nodes, ips); got != tc.want {
+				t.Errorf("meshScript(%s) = %q, want: %q", tc.node, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestServiceScript(t *testing.T) {
+	want := "for i in 1 2 3 4 5; do wget -q -T 5 -O - http://10.96.12.34:8080 && exit 0; sleep 2; done; exit 1"
+	if got := serviceScript("10.96.12.34", 8080); got != want {
+		t.Errorf("serviceScript() = %q, want: %q", got, want)
+	}
+}
+
+func TestFailed(t *testing.T) {
+	results := Run(fake.NewSimpleClientset(node("minikube", core.ConditionFalse)), []Check{{Name: "Nodes are Ready", Run: NodesReady}}, time.Second)
+	if got := Failed(results); got != 1 {
+		t.Errorf("Failed() = %d, want: 1", got)
+	}
+}
+
+func TestPodNamesAreUnique(t *testing.T) {
+	a := pod("minikube-verify-dns", "", "nslookup", "kubernetes.default")
+	b := pod("minikube-verify-dns", "", "nslookup", "kubernetes.default")
+	if !strings.HasPrefix(a.Name, "minikube-verify-dns-") {
+		t.Errorf("pod() name = %q, want prefix: %q", a.Name, "minikube-verify-dns-")
+	}
+	if a.Name == b.Name {
+		t.Errorf("pod() returned the same name %q twice", a.Name)
+	}
+}